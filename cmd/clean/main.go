@@ -18,6 +18,11 @@ import (
 const (
 	DefaultConfigFile = "config.yml"
 	shutdownTimeout   = 30 * time.Second
+
+	// RebuildProjectionsCmd recreates the read models from the event store and exits.
+	RebuildProjectionsCmd = "rebuild-projections"
+	// BackfillSnapshotsCmd snapshots the games created before the event store and exits.
+	BackfillSnapshotsCmd = "backfill-snapshots"
 )
 
 func main() {
@@ -43,8 +48,31 @@ func main() {
 	appLogger.Init()
 	appLogger.Infof("Version: %s, LogLevel: %s, SSL: %v", cfg.Server.Version, cfg.Logger.Level, cfg.Server.SSL)
 
-	// Run our service
 	srv := infrastructure.NewApp(ctx, cfg, appLogger, lock)
+
+	if len(os.Args) > 1 && os.Args[1] == RebuildProjectionsCmd {
+		err = srv.RebuildProjections(ctx)
+		cancel()
+		srv.Shutdown()
+		if err != nil {
+			log.Fatalf("Rebuilding projections: %v", err)
+		}
+
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == BackfillSnapshotsCmd {
+		err = srv.BackfillSnapshots(ctx)
+		cancel()
+		srv.Shutdown()
+		if err != nil {
+			log.Fatalf("Backfilling snapshots: %v", err)
+		}
+
+		return
+	}
+
+	// Run our service
 	if err = srv.Run(ctx); err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS game_rounds CASCADE;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE TABLE game_rounds (
+    id           VARCHAR(36) PRIMARY KEY,
+    game_id      VARCHAR(36) REFERENCES games (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    topic        TEXT                        NOT NULL   CHECK ( topic <> '' ),
+    status       SMALLINT                    NOT NULL,
+    estimate     VARCHAR(16)                 NOT NULL   DEFAULT '',
+    created_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW(),
+    updated_at   TIMESTAMP WITH TIME ZONE               DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX game_rounds_game_id_idx ON game_rounds (game_id, created_at);
+
+COMMENT ON COLUMN game_rounds.id IS 'Round uniq id';
+COMMENT ON COLUMN game_rounds.game_id IS 'Game uniq id';
+COMMENT ON COLUMN game_rounds.topic IS 'Estimated topic';
+COMMENT ON COLUMN game_rounds.status IS 'Round status';
+COMMENT ON COLUMN game_rounds.estimate IS 'Final estimate';
+COMMENT ON COLUMN game_rounds.created_at IS 'Round created date';
+COMMENT ON COLUMN game_rounds.updated_at IS 'Round modified date';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS game_votes CASCADE;
\ No newline at end of file
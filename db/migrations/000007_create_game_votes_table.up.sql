@@ -0,0 +1,12 @@
+CREATE TABLE game_votes (
+    round_id     VARCHAR(36) REFERENCES game_rounds (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    user_id      VARCHAR(36) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    value        VARCHAR(16)                 NOT NULL   CHECK ( value <> '' ),
+    created_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW(),
+    CONSTRAINT game_votes_pkey PRIMARY KEY (round_id, user_id)
+);
+
+COMMENT ON COLUMN game_votes.round_id IS 'Round uniq id';
+COMMENT ON COLUMN game_votes.user_id IS 'User uniq id';
+COMMENT ON COLUMN game_votes.value IS 'Card value';
+COMMENT ON COLUMN game_votes.created_at IS 'Vote created date';
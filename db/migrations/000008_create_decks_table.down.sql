@@ -0,0 +1 @@
+DROP TABLE IF EXISTS decks CASCADE;
\ No newline at end of file
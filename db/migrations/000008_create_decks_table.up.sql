@@ -0,0 +1,29 @@
+CREATE TABLE decks (
+    id           VARCHAR(36) PRIMARY KEY,
+    name         VARCHAR(255)                NOT NULL   CHECK ( name <> '' ),
+    cards        JSONB                       NOT NULL,
+    owner_id     VARCHAR(36) REFERENCES users (id) ON DELETE CASCADE,
+    is_preset    BOOLEAN                     NOT NULL   DEFAULT FALSE,
+    created_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW(),
+    updated_at   TIMESTAMP WITH TIME ZONE               DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX decks_owner_id_idx ON decks (owner_id);
+
+COMMENT ON COLUMN decks.id IS 'Deck uniq id';
+COMMENT ON COLUMN decks.name IS 'Deck name';
+COMMENT ON COLUMN decks.cards IS 'Ordered list of card values';
+COMMENT ON COLUMN decks.owner_id IS 'User uniq id, empty for preset decks';
+COMMENT ON COLUMN decks.is_preset IS 'Built-in deck flag';
+COMMENT ON COLUMN decks.created_at IS 'Deck created date';
+COMMENT ON COLUMN decks.updated_at IS 'Deck modified date';
+
+INSERT INTO decks (id, name, cards, is_preset)
+VALUES ('00000000-0000-4000-8000-000000000001', 'Fibonacci',
+        '["0", "1", "2", "3", "5", "8", "13", "21", "34", "55", "89", "?", "☕"]', TRUE),
+       ('00000000-0000-4000-8000-000000000002', 'Modified Fibonacci',
+        '["0", "½", "1", "2", "3", "5", "8", "13", "20", "40", "100", "?", "☕"]', TRUE),
+       ('00000000-0000-4000-8000-000000000003', 'T-shirt',
+        '["XS", "S", "M", "L", "XL", "XXL", "?", "☕"]', TRUE),
+       ('00000000-0000-4000-8000-000000000004', 'Powers of two',
+        '["0", "1", "2", "4", "8", "16", "32", "64", "?", "☕"]', TRUE);
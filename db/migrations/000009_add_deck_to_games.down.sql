@@ -0,0 +1 @@
+ALTER TABLE games DROP COLUMN IF EXISTS deck_id;
\ No newline at end of file
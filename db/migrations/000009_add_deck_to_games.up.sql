@@ -0,0 +1,5 @@
+ALTER TABLE games
+    ADD COLUMN deck_id VARCHAR(36) NOT NULL DEFAULT '00000000-0000-4000-8000-000000000001'
+        REFERENCES decks (id) ON UPDATE CASCADE;
+
+COMMENT ON COLUMN games.deck_id IS 'Deck uniq id';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS game_join_links CASCADE;
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE game_join_links (
+    code         VARCHAR(16) PRIMARY KEY,
+    game_id      VARCHAR(36) REFERENCES games (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    created_by   VARCHAR(36) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    expires_at   TIMESTAMP WITH TIME ZONE    NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW()
+);
+
+CREATE INDEX game_join_links_game_id_idx ON game_join_links (game_id);
+
+COMMENT ON COLUMN game_join_links.code IS 'Join code';
+COMMENT ON COLUMN game_join_links.game_id IS 'Game uniq id';
+COMMENT ON COLUMN game_join_links.created_by IS 'User uniq id';
+COMMENT ON COLUMN game_join_links.expires_at IS 'Join link expiration date';
+COMMENT ON COLUMN game_join_links.created_at IS 'Join link created date';
@@ -0,0 +1,6 @@
+ALTER TABLE games
+    ADD COLUMN archived_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX games_active_created_at_idx ON games (created_at) WHERE archived_at IS NULL;
+
+COMMENT ON COLUMN games.archived_at IS 'Game archived date';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS game_estimation_votes CASCADE;
+DROP TABLE IF EXISTS game_estimations CASCADE;
\ No newline at end of file
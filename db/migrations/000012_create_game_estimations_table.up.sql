@@ -0,0 +1,31 @@
+CREATE TABLE game_estimations (
+    id           VARCHAR(36) PRIMARY KEY,
+    game_id      VARCHAR(36) REFERENCES games (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    round_id     VARCHAR(36) REFERENCES game_rounds (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    topic        TEXT                        NOT NULL,
+    estimate     VARCHAR(16)                 NOT NULL   DEFAULT '',
+    revealed_at  TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW()
+);
+
+CREATE INDEX game_estimations_game_id_idx ON game_estimations (game_id, revealed_at);
+CREATE INDEX game_estimations_round_id_idx ON game_estimations (round_id, revealed_at);
+
+COMMENT ON COLUMN game_estimations.id IS 'Estimation uniq id';
+COMMENT ON COLUMN game_estimations.game_id IS 'Game uniq id';
+COMMENT ON COLUMN game_estimations.round_id IS 'Round uniq id';
+COMMENT ON COLUMN game_estimations.topic IS 'Estimated topic';
+COMMENT ON COLUMN game_estimations.estimate IS 'Final estimate';
+COMMENT ON COLUMN game_estimations.revealed_at IS 'Votes revealed date';
+
+CREATE TABLE game_estimation_votes (
+    estimation_id VARCHAR(36) REFERENCES game_estimations (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    user_id       VARCHAR(36)                 NOT NULL,
+    name          VARCHAR(255)                NOT NULL   DEFAULT '',
+    value         VARCHAR(16)                 NOT NULL,
+    CONSTRAINT game_estimation_votes_pkey PRIMARY KEY (estimation_id, user_id)
+);
+
+COMMENT ON COLUMN game_estimation_votes.estimation_id IS 'Estimation uniq id';
+COMMENT ON COLUMN game_estimation_votes.user_id IS 'User uniq id';
+COMMENT ON COLUMN game_estimation_votes.name IS 'User name at reveal time';
+COMMENT ON COLUMN game_estimation_votes.value IS 'Card value';
@@ -0,0 +1,28 @@
+CREATE TABLE game_stories (
+    id           VARCHAR(36) PRIMARY KEY,
+    game_id      VARCHAR(36) REFERENCES games (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    key          VARCHAR(32)                 NOT NULL   DEFAULT '',
+    title        VARCHAR(255)                NOT NULL   CHECK ( title <> '' ),
+    description  TEXT                        NOT NULL   DEFAULT '',
+    link         VARCHAR(2048)               NOT NULL   DEFAULT '',
+    position     INTEGER                     NOT NULL,
+    status       SMALLINT                    NOT NULL,
+    round_id     VARCHAR(36) REFERENCES game_rounds (id) ON UPDATE CASCADE ON DELETE SET NULL,
+    estimate     VARCHAR(16)                 NOT NULL   DEFAULT '',
+    created_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW(),
+    updated_at   TIMESTAMP WITH TIME ZONE               DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT game_stories_position_key UNIQUE (game_id, position)
+);
+
+COMMENT ON COLUMN game_stories.id IS 'Story uniq id';
+COMMENT ON COLUMN game_stories.game_id IS 'Game uniq id';
+COMMENT ON COLUMN game_stories.key IS 'Story key in the tracker';
+COMMENT ON COLUMN game_stories.title IS 'Story title';
+COMMENT ON COLUMN game_stories.description IS 'Story description';
+COMMENT ON COLUMN game_stories.link IS 'Story link in the tracker';
+COMMENT ON COLUMN game_stories.position IS 'Story position in the game queue';
+COMMENT ON COLUMN game_stories.status IS 'Story status';
+COMMENT ON COLUMN game_stories.round_id IS 'Round uniq id';
+COMMENT ON COLUMN game_stories.estimate IS 'Final estimate';
+COMMENT ON COLUMN game_stories.created_at IS 'Story created date';
+COMMENT ON COLUMN game_stories.updated_at IS 'Story modified date';
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS game_deadlines CASCADE;
+
+ALTER TABLE game_rounds
+    DROP COLUMN IF EXISTS deadline,
+    DROP COLUMN IF EXISTS timer;
\ No newline at end of file
@@ -0,0 +1,25 @@
+ALTER TABLE game_rounds
+    ADD COLUMN timer    INTEGER                  NOT NULL DEFAULT 0,
+    ADD COLUMN deadline TIMESTAMP WITH TIME ZONE;
+
+COMMENT ON COLUMN game_rounds.timer IS 'Voting countdown in seconds';
+COMMENT ON COLUMN game_rounds.deadline IS 'Automatic reveal date';
+
+CREATE TABLE game_deadlines (
+    id           VARCHAR(36) PRIMARY KEY,
+    game_id      VARCHAR(36) REFERENCES games (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    round_id     VARCHAR(36) REFERENCES game_rounds (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    kind         SMALLINT                    NOT NULL,
+    due_at       TIMESTAMP WITH TIME ZONE    NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW()
+);
+
+CREATE INDEX game_deadlines_due_at_idx ON game_deadlines (due_at);
+CREATE INDEX game_deadlines_round_id_idx ON game_deadlines (round_id);
+
+COMMENT ON COLUMN game_deadlines.id IS 'Deadline uniq id';
+COMMENT ON COLUMN game_deadlines.game_id IS 'Game uniq id';
+COMMENT ON COLUMN game_deadlines.round_id IS 'Round uniq id';
+COMMENT ON COLUMN game_deadlines.kind IS 'Action executed when the deadline is due';
+COMMENT ON COLUMN game_deadlines.due_at IS 'Deadline due date';
+COMMENT ON COLUMN game_deadlines.created_at IS 'Deadline created date';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS game_snapshots CASCADE;
+DROP TABLE IF EXISTS game_events CASCADE;
\ No newline at end of file
@@ -0,0 +1,26 @@
+CREATE TABLE game_events (
+    game_id      VARCHAR(36)                 NOT NULL,
+    version      BIGINT                      NOT NULL,
+    kind         VARCHAR(64)                 NOT NULL,
+    payload      JSONB                       NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW(),
+    PRIMARY KEY (game_id, version)
+);
+
+COMMENT ON COLUMN game_events.game_id IS 'Game uniq id, kept after the game is deleted';
+COMMENT ON COLUMN game_events.version IS 'Event number in the game history starting from 1';
+COMMENT ON COLUMN game_events.kind IS 'Event kind';
+COMMENT ON COLUMN game_events.payload IS 'Event data';
+COMMENT ON COLUMN game_events.created_at IS 'Event recorded date';
+
+CREATE TABLE game_snapshots (
+    game_id      VARCHAR(36) PRIMARY KEY,
+    version      BIGINT                      NOT NULL,
+    payload      JSONB                       NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW()
+);
+
+COMMENT ON COLUMN game_snapshots.game_id IS 'Game uniq id';
+COMMENT ON COLUMN game_snapshots.version IS 'Number of game events the snapshot is built from';
+COMMENT ON COLUMN game_snapshots.payload IS 'Game state';
+COMMENT ON COLUMN game_snapshots.created_at IS 'Snapshot created date';
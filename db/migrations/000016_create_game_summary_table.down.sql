@@ -0,0 +1 @@
+DROP TABLE IF EXISTS game_summary CASCADE;
\ No newline at end of file
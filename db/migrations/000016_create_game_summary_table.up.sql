@@ -0,0 +1,45 @@
+CREATE TABLE game_summary (
+    game_id           VARCHAR(36) PRIMARY KEY,
+    name              VARCHAR(255)                NOT NULL,
+    owner_id          VARCHAR(36)                 NOT NULL,
+    owner_name        TEXT                        NOT NULL   DEFAULT '',
+    member_count      INTEGER                     NOT NULL   DEFAULT 0,
+    round_status      VARCHAR(16),
+    archived_at       TIMESTAMP WITH TIME ZONE,
+    last_activity_at  TIMESTAMP WITH TIME ZONE    NOT NULL,
+    created_at        TIMESTAMP WITH TIME ZONE    NOT NULL,
+    updated_at        TIMESTAMP WITH TIME ZONE    NOT NULL
+);
+
+CREATE INDEX game_summary_created_at_idx ON game_summary (created_at);
+
+COMMENT ON COLUMN game_summary.game_id IS 'Game uniq id';
+COMMENT ON COLUMN game_summary.name IS 'Game name';
+COMMENT ON COLUMN game_summary.owner_id IS 'Game owner uniq id';
+COMMENT ON COLUMN game_summary.owner_name IS 'Game owner full name';
+COMMENT ON COLUMN game_summary.member_count IS 'Number of game members';
+COMMENT ON COLUMN game_summary.round_status IS 'Status of the current round, empty before the first round';
+COMMENT ON COLUMN game_summary.archived_at IS 'Game archived date';
+COMMENT ON COLUMN game_summary.last_activity_at IS 'Date of the latest game event';
+COMMENT ON COLUMN game_summary.created_at IS 'Game created date';
+COMMENT ON COLUMN game_summary.updated_at IS 'Game modified date';
+
+INSERT INTO game_summary (game_id, name, owner_id, owner_name, member_count, round_status, archived_at,
+                          last_activity_at, created_at, updated_at)
+SELECT g.id,
+       g.name,
+       g.owner_id,
+       CONCAT(COALESCE(u.surname, ''), ' ', u.name, ' ', COALESCE(u.middlename, '')),
+       (SELECT COUNT(*) FROM game_user gu WHERE gu.game_id = g.id),
+       (SELECT CASE r.status WHEN 1 THEN 'voting' WHEN 2 THEN 'revealed' WHEN 3 THEN 'closed' END
+        FROM game_rounds r
+        WHERE r.game_id = g.id
+        ORDER BY r.created_at DESC
+        LIMIT 1),
+       g.archived_at,
+       GREATEST(COALESCE(g.updated_at, g.created_at),
+                (SELECT MAX(e.created_at) FROM game_events e WHERE e.game_id = g.id)),
+       g.created_at,
+       COALESCE(g.updated_at, g.created_at)
+FROM games g
+         JOIN users u ON u.id = g.owner_id;
@@ -0,0 +1,4 @@
+ALTER TABLE game_summary DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE games DROP COLUMN IF EXISTS organization_id;
+DROP TABLE IF EXISTS organization_members CASCADE;
+DROP TABLE IF EXISTS organizations CASCADE;
\ No newline at end of file
@@ -0,0 +1,90 @@
+CREATE TABLE organizations (
+    id           VARCHAR(36) PRIMARY KEY,
+    name         VARCHAR(255)                NOT NULL   CHECK ( name <> '' ),
+    created_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW(),
+    updated_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW()
+);
+
+COMMENT ON COLUMN organizations.id IS 'Organization uniq id';
+COMMENT ON COLUMN organizations.name IS 'Organization name';
+COMMENT ON COLUMN organizations.created_at IS 'Organization created date';
+COMMENT ON COLUMN organizations.updated_at IS 'Organization modified date';
+
+CREATE TABLE organization_members (
+    organization_id  VARCHAR(36) REFERENCES organizations (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    user_id          VARCHAR(36) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    role             SMALLINT                    NOT NULL,
+    joined_at        TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW(),
+    PRIMARY KEY (organization_id, user_id)
+);
+
+CREATE INDEX organization_members_user_id_idx ON organization_members (user_id, joined_at);
+
+COMMENT ON COLUMN organization_members.organization_id IS 'Organization uniq id';
+COMMENT ON COLUMN organization_members.user_id IS 'User uniq id';
+COMMENT ON COLUMN organization_members.role IS 'User role in the organization';
+COMMENT ON COLUMN organization_members.joined_at IS 'Membership created date';
+
+-- Every existing user gets a personal organization owning their games
+CREATE TEMPORARY TABLE personal_organizations AS
+SELECT gen_random_uuid()::text AS id,
+       id                      AS user_id,
+       name,
+       created_at
+FROM users;
+
+INSERT INTO organizations (id, name, created_at, updated_at)
+SELECT id, name || '''s team', created_at, created_at
+FROM personal_organizations;
+
+INSERT INTO organization_members (organization_id, user_id, role, joined_at)
+SELECT id, user_id, 1, created_at
+FROM personal_organizations;
+
+ALTER TABLE games
+    ADD COLUMN organization_id VARCHAR(36) REFERENCES organizations (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+UPDATE games g
+SET organization_id = p.id
+FROM personal_organizations p
+WHERE p.user_id = g.owner_id;
+
+ALTER TABLE games
+    ALTER COLUMN organization_id SET NOT NULL;
+
+CREATE INDEX games_organization_id_idx ON games (organization_id);
+
+COMMENT ON COLUMN games.organization_id IS 'Organization owning the game';
+
+DROP TABLE personal_organizations;
+
+ALTER TABLE game_summary
+    ADD COLUMN organization_id VARCHAR(36);
+
+UPDATE game_summary s
+SET organization_id = g.organization_id
+FROM games g
+WHERE g.id = s.game_id;
+
+DELETE
+FROM game_summary
+WHERE organization_id IS NULL;
+
+ALTER TABLE game_summary
+    ALTER COLUMN organization_id SET NOT NULL;
+
+CREATE INDEX game_summary_organization_id_idx ON game_summary (organization_id, created_at);
+
+COMMENT ON COLUMN game_summary.organization_id IS 'Organization owning the game';
+
+-- The game history records the organization from the game creation
+UPDATE game_events e
+SET payload = jsonb_set(e.payload, '{OrganizationID}', to_jsonb(g.organization_id))
+FROM games g
+WHERE g.id = e.game_id
+  AND e.kind = 'GameCreated';
+
+UPDATE game_snapshots s
+SET payload = jsonb_set(s.payload, '{game,OrganizationID}', to_jsonb(g.organization_id))
+FROM games g
+WHERE g.id = s.game_id;
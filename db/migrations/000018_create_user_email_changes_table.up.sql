@@ -0,0 +1,13 @@
+CREATE TABLE user_email_changes (
+    id           VARCHAR(36) PRIMARY KEY,
+    user_id      VARCHAR(36) UNIQUE REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    email        VARCHAR(255)                NOT NULL   CHECK ( email <> '' ),
+    expires_at   TIMESTAMP WITH TIME ZONE    NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW()
+);
+
+COMMENT ON COLUMN user_email_changes.id IS 'Email change uniq id, sent as the confirmation token';
+COMMENT ON COLUMN user_email_changes.user_id IS 'User uniq id';
+COMMENT ON COLUMN user_email_changes.email IS 'New user email waiting for confirmation';
+COMMENT ON COLUMN user_email_changes.expires_at IS 'Date after which the change can not be confirmed';
+COMMENT ON COLUMN user_email_changes.created_at IS 'Email change requested date';
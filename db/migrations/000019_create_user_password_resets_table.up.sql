@@ -0,0 +1,11 @@
+CREATE TABLE user_password_resets (
+    id           VARCHAR(36) PRIMARY KEY,
+    user_id      VARCHAR(36) UNIQUE REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    expires_at   TIMESTAMP WITH TIME ZONE    NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW()
+);
+
+COMMENT ON COLUMN user_password_resets.id IS 'Password reset uniq id, sent as the reset token';
+COMMENT ON COLUMN user_password_resets.user_id IS 'User uniq id';
+COMMENT ON COLUMN user_password_resets.expires_at IS 'Date after which the password can not be reset with the token';
+COMMENT ON COLUMN user_password_resets.created_at IS 'Password reset requested date';
@@ -0,0 +1,17 @@
+CREATE TABLE user_two_factors (
+    user_id        VARCHAR(36) PRIMARY KEY REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    secret         VARCHAR(64)                 NOT NULL,
+    enabled        BOOLEAN                     NOT NULL   DEFAULT FALSE,
+    recovery_codes JSONB                       NOT NULL   DEFAULT '[]',
+    last_step      BIGINT                      NOT NULL   DEFAULT 0,
+    created_at     TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW(),
+    updated_at     TIMESTAMP WITH TIME ZONE    NOT NULL   DEFAULT NOW()
+);
+
+COMMENT ON COLUMN user_two_factors.user_id IS 'User uniq id';
+COMMENT ON COLUMN user_two_factors.secret IS 'Base32 encoded TOTP secret of the authenticator app';
+COMMENT ON COLUMN user_two_factors.enabled IS 'Enrollment confirmed with a first code flag';
+COMMENT ON COLUMN user_two_factors.recovery_codes IS 'SHA-256 hashes of the unused recovery codes';
+COMMENT ON COLUMN user_two_factors.last_step IS 'Time step of the latest accepted code, older codes are refused';
+COMMENT ON COLUMN user_two_factors.created_at IS 'Enrollment started date';
+COMMENT ON COLUMN user_two_factors.updated_at IS 'Two-factor modified date';
@@ -0,0 +1 @@
+DELETE FROM organizations;
\ No newline at end of file
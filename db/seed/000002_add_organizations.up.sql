@@ -0,0 +1,6 @@
+BEGIN;
+INSERT INTO organizations (id, name) VALUES ('6f1d2c4e-8a7b-4c3d-9e2f-1a0b9c8d7e6f', 'Ivan''s team');
+INSERT INTO organization_members (organization_id, user_id, role) VALUES
+    ('6f1d2c4e-8a7b-4c3d-9e2f-1a0b9c8d7e6f', '2b0c8791-2136-46b6-bc38-b33038ca2e80', 1),
+    ('6f1d2c4e-8a7b-4c3d-9e2f-1a0b9c8d7e6f', 'c56ace69-ae54-4ecf-beb5-d3f314d3ee03', 3);
+COMMIT;
\ No newline at end of file
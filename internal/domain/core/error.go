@@ -13,3 +13,6 @@ var ErrAlreadyExist = errors.New("already exist")
 
 // ErrNoChanges no changes.
 var ErrNoChanges = errors.New("entity has not changes")
+
+// ErrConcurrentModification entity was changed by another transaction.
+var ErrConcurrentModification = errors.New("entity was modified concurrently")
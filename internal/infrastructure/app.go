@@ -12,8 +12,13 @@ import (
 
 	"github.com/KyKyPy3/clean/internal/infrastructure/config"
 	"github.com/KyKyPy3/clean/internal/infrastructure/queue"
+	"github.com/KyKyPy3/clean/internal/modules/deck"
+	deck_postgres "github.com/KyKyPy3/clean/internal/modules/deck/infrastructure/gateway/postgres"
 	"github.com/KyKyPy3/clean/internal/modules/game"
 	game_postgres "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/gateway/postgres"
+	game_redis "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/gateway/redis"
+	"github.com/KyKyPy3/clean/internal/modules/organization"
+	org_postgres "github.com/KyKyPy3/clean/internal/modules/organization/infrastructure/gateway/postgres"
 	"github.com/KyKyPy3/clean/internal/modules/registration"
 	email_gateway "github.com/KyKyPy3/clean/internal/modules/registration/infrastructure/gateway/email"
 	reg_postgres "github.com/KyKyPy3/clean/internal/modules/registration/infrastructure/gateway/postgres"
@@ -22,8 +27,11 @@ import (
 	"github.com/KyKyPy3/clean/internal/modules/session/infrastructure/controller/middleware"
 	session_redis "github.com/KyKyPy3/clean/internal/modules/session/infrastructure/gateway/redis"
 	"github.com/KyKyPy3/clean/internal/modules/user"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
 	user_postgres "github.com/KyKyPy3/clean/internal/modules/user/infrastructure/gateway/postgres"
+	"github.com/KyKyPy3/clean/pkg/breached"
 	"github.com/KyKyPy3/clean/pkg/email"
+	"github.com/KyKyPy3/clean/pkg/hasher"
 	"github.com/KyKyPy3/clean/pkg/jwt"
 	kafkaClient "github.com/KyKyPy3/clean/pkg/kafka"
 	"github.com/KyKyPy3/clean/pkg/latch"
@@ -33,22 +41,26 @@ import (
 	"github.com/KyKyPy3/clean/pkg/outbox"
 	"github.com/KyKyPy3/clean/pkg/postgres"
 	redisClient "github.com/KyKyPy3/clean/pkg/redis"
+	"github.com/KyKyPy3/clean/pkg/totp"
 	"github.com/KyKyPy3/clean/pkg/tracing"
 )
 
 const heartbeatInterval = time.Second * 15
 
 type App struct {
-	cfg         *config.Config
-	pgClient    *sqlx.DB
-	redisClient *redis.Client
-	kafkaClient *kafka.Conn
-	web         *Web
-	jwt         *jwt.JWT
-	consumer    *queue.Consumer
-	producer    kafkaClient.Producer
-	lock        *latch.CountDownLatch
-	logger      logger.Logger
+	cfg            *config.Config
+	pgClient       *sqlx.DB
+	redisClient    *redis.Client
+	kafkaClient    *kafka.Conn
+	web            *Web
+	jwt            *jwt.JWT
+	passwordPolicy vo.PasswordPolicy
+	hasher         *hasher.Hasher
+	totp           *totp.TOTP
+	consumer       *queue.Consumer
+	producer       kafkaClient.Producer
+	lock           *latch.CountDownLatch
+	logger         logger.Logger
 }
 
 func NewApp(
@@ -108,26 +120,60 @@ func NewApp(
 	}
 
 	// Init JWT manager
-	jwtManager, err := jwt.NewJWT(cfg.Certs.PrivateKey, cfg.Certs.PublicKey)
+	jwtManager, err := newJWT(cfg.Certs)
 	if err != nil {
 		logger.Fatalf("Can't parse certs: %s", err)
 	}
 
+	// Init password policy
+	passwordPolicy := vo.PasswordPolicy{
+		MinLength:     cfg.Password.MinLength,
+		MaxLength:     cfg.Password.MaxLength,
+		RequireUpper:  cfg.Password.RequireUpper,
+		RequireLower:  cfg.Password.RequireLower,
+		RequireDigit:  cfg.Password.RequireDigit,
+		RequireSymbol: cfg.Password.RequireSymbol,
+	}
+	if cfg.Password.BreachedFile != "" {
+		var list *breached.List
+		list, err = breached.Load(cfg.Password.BreachedFile)
+		if err != nil {
+			logger.Fatalf("Can't load breached passwords: %s", err)
+		}
+		passwordPolicy.Breached = list
+		logger.Infof("Loaded %d breached passwords", list.Len())
+	}
+
+	// Init password hasher
+	passwordHasher := hasher.New(hasher.Params{
+		Memory:      cfg.Password.Hash.Memory,
+		Iterations:  cfg.Password.Hash.Iterations,
+		Parallelism: cfg.Password.Hash.Parallelism,
+		SaltLength:  cfg.Password.Hash.SaltLength,
+		KeyLength:   cfg.Password.Hash.KeyLength,
+	})
+
+	// Init TOTP of the two-factor authentication
+	oneTimePassword := totp.New(cfg.TwoFactor.Issuer)
+
 	web := NewWeb(cfg, logger, lock)
 	kafkaProducer := kafkaClient.NewProducer(logger, cfg.Kafka.Brokers)
 	consumer := queue.NewConsumer(cfg, lock, logger)
 
 	return &App{
-		cfg:         cfg,
-		logger:      logger,
-		lock:        lock,
-		pgClient:    pgClient,
-		kafkaClient: kfClient,
-		jwt:         jwtManager,
-		redisClient: rdClient,
-		producer:    kafkaProducer,
-		web:         web,
-		consumer:    consumer,
+		cfg:            cfg,
+		logger:         logger,
+		lock:           lock,
+		pgClient:       pgClient,
+		kafkaClient:    kfClient,
+		jwt:            jwtManager,
+		passwordPolicy: passwordPolicy,
+		hasher:         passwordHasher,
+		totp:           oneTimePassword,
+		redisClient:    rdClient,
+		producer:       kafkaProducer,
+		web:            web,
+		consumer:       consumer,
 	}
 }
 
@@ -195,11 +241,35 @@ func (a *App) Run(ctx context.Context) error {
 	return nil
 }
 
+// RebuildProjections recreates the read models from the event store.
+func (a *App) RebuildProjections(ctx context.Context) error {
+	return game.RebuildProjections(
+		ctx,
+		game_postgres.NewGamePgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger),
+		game_postgres.NewGameSummaryPgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger),
+		user_postgres.NewUserPgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger),
+		manager.Must(trmsqlx.NewDefaultFactory(a.pgClient)),
+		a.logger,
+	)
+}
+
+// BackfillSnapshots snapshots the games created before the event store.
+func (a *App) BackfillSnapshots(ctx context.Context) error {
+	return game.BackfillSnapshots(
+		ctx,
+		game_postgres.NewGamePgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger),
+		manager.Must(trmsqlx.NewDefaultFactory(a.pgClient)),
+		a.logger,
+	)
+}
+
 func (a *App) connectHandlers(ctx context.Context) {
 	mountPoint := a.web.mountPoint()
 
 	// Health endpoint
 	NewHealthHandlers(mountPoint, a.logger, a.pgClient, a.redisClient, a.kafkaClient)
+	// Public keys of the tokens
+	NewJWKSHandlers(mountPoint, a.jwt)
 
 	// Init core systems
 	pubsub := mediator.New(a.logger)
@@ -211,14 +281,29 @@ func (a *App) connectHandlers(ctx context.Context) {
 	emailGateway := email_gateway.New(emailClient, a.logger)
 
 	userPgStorage := user_postgres.NewUserPgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger)
+	emailChangePgStorage := user_postgres.NewEmailChangePgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger)
+	passwordResetPgStorage := user_postgres.NewPasswordResetPgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger)
+	twoFactorPgStorage := user_postgres.NewTwoFactorPgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger)
 	regPgStorage := reg_postgres.NewRegistrationPgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger)
+	orgPgStorage := org_postgres.NewOrganizationPgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger)
 	sessionStorage := session_redis.NewSessionRedisStorage(a.redisClient, a.logger)
+	challengeStorage := session_redis.NewChallengeRedisStorage(a.redisClient, a.logger)
+	loginAttemptStorage := session_redis.NewLoginAttemptRedisStorage(a.redisClient, a.logger)
+	accountLockout := session.NewAccountLockout(loginAttemptStorage, pubsub, a.cfg, a.logger)
 
 	authMiddleware := middleware.NewAuthMiddleware(a.jwt, sessionStorage, a.logger)
 	publicMountPoint := mountPoint.Group("/api/v1")
 	privateMountPoint := mountPoint.Group("/api/v1", authMiddleware.Process)
+	gameMountPoint := mountPoint.Group("/api/v1", authMiddleware.ProcessGame)
 
 	gamePgStorage := game_postgres.NewGamePgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger)
+	gameSummaryStorage := game_postgres.NewGameSummaryPgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger)
+	deckPgStorage := deck_postgres.NewDeckPgStorage(a.pgClient, trmsqlx.DefaultCtxGetter, a.logger)
+	roomHub := game_redis.NewRoomHub(a.redisClient, a.logger)
+	presenceStorage := game_redis.NewPresenceStorage(a.redisClient, a.logger)
+	if err := roomHub.Start(ctx, a.lock); err != nil {
+		a.logger.Fatalf("Can't subscribe to game rooms: %s", err)
+	}
 
 	////////////////////////////////
 	// Init user layout
@@ -226,10 +311,21 @@ func (a *App) connectHandlers(ctx context.Context) {
 	user.InitHandlers(
 		ctx,
 		userPgStorage,
+		emailChangePgStorage,
+		passwordResetPgStorage,
+		twoFactorPgStorage,
+		orgPgStorage,
+		sessionStorage,
+		accountLockout,
+		publicMountPoint,
 		privateMountPoint,
 		pubsub,
 		trManager,
+		outboxMngr,
 		a.jwt,
+		a.passwordPolicy,
+		a.hasher,
+		a.totp,
 		a.logger,
 	)
 
@@ -238,17 +334,43 @@ func (a *App) connectHandlers(ctx context.Context) {
 	////////////////////////////////
 	session.InitHandlers(
 		userPgStorage,
+		gamePgStorage,
+		orgPgStorage,
+		twoFactorPgStorage,
 		sessionStorage,
+		challengeStorage,
+		loginAttemptStorage,
 		publicMountPoint,
 		privateMountPoint,
+		pubsub,
+		a.hasher,
+		a.totp,
 		a.cfg,
 		a.jwt,
 		a.logger,
 	)
 
+	////////////////////////////////
+	// Init organization layout
+	////////////////////////////////
+	organization.InitHandlers(
+		ctx,
+		orgPgStorage,
+		userPgStorage,
+		privateMountPoint,
+		pubsub,
+		trManager,
+		a.logger,
+	)
+
 	////////////////////////////////
 	// Init registration layout
 	////////////////////////////////
+	// The links of the emails fall back to the local web client
+	webURL := a.cfg.Server.WebURL
+	if webURL == "" {
+		webURL = allowedOrigins[0]
+	}
 	registration.InitHandlers(
 		ctx,
 		userPgStorage,
@@ -259,6 +381,21 @@ func (a *App) connectHandlers(ctx context.Context) {
 		trManager,
 		emailGateway,
 		outboxMngr,
+		webURL,
+		a.passwordPolicy,
+		a.hasher,
+		a.logger,
+	)
+
+	////////////////////////////////
+	// Init decks layout
+	////////////////////////////////
+	deck.InitHandlers(
+		ctx,
+		deckPgStorage,
+		privateMountPoint,
+		pubsub,
+		trManager,
 		a.logger,
 	)
 
@@ -268,10 +405,35 @@ func (a *App) connectHandlers(ctx context.Context) {
 	game.InitHandlers(
 		ctx,
 		gamePgStorage,
+		gameSummaryStorage,
 		userPgStorage,
-		privateMountPoint,
+		deckPgStorage,
+		roomHub,
+		presenceStorage,
+		gameMountPoint,
 		pubsub,
 		trManager,
+		allowedOrigins,
+		a.lock,
 		a.logger,
 	)
 }
+
+// newJWT creates the key ring of the tokens, the single key pair is used without configured keys.
+func newJWT(cfg config.CertsConfig) (*jwt.JWT, error) {
+	if len(cfg.Keys) == 0 {
+		return jwt.NewJWT(cfg.PrivateKey, cfg.PublicKey)
+	}
+
+	keys := make([]jwt.Key, 0, len(cfg.Keys))
+	for _, key := range cfg.Keys {
+		keys = append(keys, jwt.Key{
+			ID:         key.ID,
+			PrivateKey: key.PrivateKey,
+			PublicKey:  key.PublicKey,
+			Retired:    key.Retired,
+		})
+	}
+
+	return jwt.NewKeyRing(keys, cfg.SigningKey)
+}
@@ -9,33 +9,100 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig
-	Certs    CertsConfig
-	Jwt      JwtConfig
-	Logger   LoggerConfig
-	Postgres PostgresConfig
-	Redis    RedisConfig
-	Kafka    KafkaConfig
+	Server    ServerConfig
+	Certs     CertsConfig
+	Jwt       JwtConfig
+	Password  PasswordConfig
+	TwoFactor TwoFactorConfig
+	Lockout   LockoutConfig
+	Logger    LoggerConfig
+	Postgres  PostgresConfig
+	Redis     RedisConfig
+	Kafka     KafkaConfig
 }
 
+// ServerConfig configures the web server. TrustedProxies are the CIDR ranges of the
+// proxies allowed to pass the client IP in X-Forwarded-For, without them the IP of
+// the connection is used and the headers are ignored. WebURL is the address of the
+// web client, the links of the emails open its pages.
 type ServerConfig struct {
-	Host         string
-	Port         string
-	SSL          bool
-	Version      string
-	ReadTimeout  time.Duration
-	WriteTimeout time.Duration
-	Name         string
+	Host           string
+	Port           string
+	SSL            bool
+	Version        string
+	ReadTimeout    time.Duration
+	WriteTimeout   time.Duration
+	Name           string
+	TrustedProxies []string
+	WebURL         string
 }
 
+// CertsConfig holds the RSA key pairs of the tokens, the PEM keys are base64
+// encoded. Keys form a key ring: the tokens are signed by SigningKey and verified
+// by every key which is not retired. PrivateKey and PublicKey are the single key
+// pair used when Keys is empty.
 type CertsConfig struct {
 	PrivateKey string
 	PublicKey  string
+	SigningKey string
+	Keys       []CertKeyConfig
+}
+
+type CertKeyConfig struct {
+	ID         string
+	PrivateKey string
+	PublicKey  string
+	Retired    bool
 }
 
 type JwtConfig struct {
 	AccessTokenMaxAge  time.Duration
 	RefreshTokenMaxAge time.Duration
+	GuestTokenMaxAge   time.Duration
+}
+
+// PasswordConfig is the policy for new passwords. BreachedFile is a list of
+// SHA-1 hashes of breached passwords, the check is skipped when it is empty.
+type PasswordConfig struct {
+	MinLength     int
+	MaxLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	BreachedFile  string
+	Hash          PasswordHashConfig
+}
+
+// PasswordHashConfig are the argon2id parameters of new password hashes. Stored
+// hashes made with other parameters are rehashed on the next login.
+type PasswordHashConfig struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// TwoFactorConfig configures the TOTP two-factor authentication. Issuer is the name
+// the authenticator app shows. A login of a user with two-factor authentication
+// returns a challenge, it must be answered with a code within ChallengeMaxAge
+// and ChallengeAttempts tries.
+type TwoFactorConfig struct {
+	Issuer            string
+	ChallengeMaxAge   time.Duration
+	ChallengeAttempts int
+}
+
+// LockoutConfig throttles the failed logins. The failures of an account or an IP
+// address are forgotten Window after the latest one. From the threshold on every
+// failure locks the logins for Delay, doubled by each further failure up to MaxDelay.
+type LockoutConfig struct {
+	AccountThreshold int64
+	IPThreshold      int64
+	Window           time.Duration
+	Delay            time.Duration
+	MaxDelay         time.Duration
 }
 
 type LoggerConfig struct {
@@ -0,0 +1,36 @@
+package infrastructure
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/KyKyPy3/clean/pkg/jwt"
+)
+
+// jwksMaxAge lets the verifying services cache the keys, a new key is published
+// before it signs tokens.
+const jwksMaxAge = "public, max-age=300"
+
+type JWKSHandlers struct {
+	jwt *jwt.JWT
+}
+
+func NewJWKSHandlers(mount *echo.Group, jwt *jwt.JWT) {
+	handlers := &JWKSHandlers{jwt: jwt}
+
+	mount.GET("/.well-known/jwks.json", handlers.jwksHandler)
+}
+
+// jwksHandler godoc
+// @Summary Token public keys
+// @Description Publish the public keys verifying the tokens as a JSON Web Key Set
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} jwt.JSONWebKeySet
+// @Router /.well-known/jwks.json [get]
+func (h *JWKSHandlers) jwksHandler(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, jwksMaxAge)
+
+	return c.JSON(http.StatusOK, h.jwt.JWKS())
+}
@@ -2,6 +2,7 @@ package queue
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"github.com/segmentio/kafka-go"
@@ -38,6 +39,7 @@ func NewConsumer(cfg *config.Config, lock *latch.CountDownLatch, logger logger.L
 //nolint:gocognit // Can't make it simpler
 func (c *Consumer) Start(ctx context.Context) error {
 	kafkaConsumer := kafkaClient.NewConsumer(c.cfg.Kafka.Brokers, c.cfg.Kafka.GroupID, c.logger)
+	topics := c.topics()
 
 	c.lock.Add(1)
 	go func() {
@@ -45,7 +47,7 @@ func (c *Consumer) Start(ctx context.Context) error {
 
 		err := kafkaConsumer.ConsumeTopic(
 			ctx,
-			[]string{"registration"},
+			topics,
 			poolSize,
 			func(ctx context.Context, r *kafka.Reader, workerID int) error {
 				for {
@@ -87,6 +89,20 @@ func (c *Consumer) Start(ctx context.Context) error {
 	return nil
 }
 
+// topics returns the topics which have a subscribed handler.
+func (c *Consumer) topics() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	topics := make([]string, 0, len(c.handlers))
+	for topic := range c.handlers {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	return topics
+}
+
 func (c *Consumer) Subscribe(eventType string, handler EventHandler) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
@@ -22,6 +23,9 @@ const (
 	shutdownTimeout = 10 * time.Second
 )
 
+// allowedOrigins lists the web client origins allowed for CORS and WebSocket requests.
+var allowedOrigins = []string{"http://localhost:5173"} //nolint:gochecknoglobals // shared by CORS and WebSocket
+
 type CustomValidator struct {
 	validator *validator.Validate
 }
@@ -52,11 +56,15 @@ func NewWeb(cfg *config.Config, logger logger.Logger, lock *latch.CountDownLatch
 func (w *Web) Start() error {
 	w.echo.Validator = &CustomValidator{validator: validator.New()}
 
+	if err := w.useIPExtractor(); err != nil {
+		return err
+	}
+
 	w.echo.Use(middleware.Logger())
 	w.echo.Use(middleware.Recover())
 	// w.echo.Use(middleware.CSRF())
 	w.echo.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins:     []string{"http://localhost:5173"},
+		AllowOrigins:     allowedOrigins,
 		AllowCredentials: true,
 	}))
 	w.echo.Use(otelecho.Middleware("clean"))
@@ -84,6 +92,31 @@ func (w *Web) Start() error {
 	return nil
 }
 
+// useIPExtractor reads the client IP from X-Forwarded-For only behind the trusted
+// proxies, the header is set by the client otherwise.
+func (w *Web) useIPExtractor() error {
+	if len(w.cfg.Server.TrustedProxies) == 0 {
+		w.echo.IPExtractor = echo.ExtractIPDirect()
+		return nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range w.cfg.Server.TrustedProxies {
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return fmt.Errorf("trusted proxy %q: %w", proxy, err)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+	w.echo.IPExtractor = echo.ExtractIPFromXFFHeader(options...)
+
+	return nil
+}
+
 func (w *Web) mountPoint() *echo.Group {
 	return w.echo.Group("")
 }
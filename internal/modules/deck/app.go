@@ -0,0 +1,51 @@
+package deck
+
+import (
+	"context"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/labstack/echo/v4"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/modules/deck/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/deck/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/deck/application/query"
+	handlers "github.com/KyKyPy3/clean/internal/modules/deck/infrastructure/controller/http/v1"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+func InitHandlers(
+	_ context.Context,
+	deckStorage ports.DeckPgStorage,
+	mountPoint *echo.Group,
+	pubsub *mediator.Mediator,
+	trManager *manager.Manager,
+	logger logger.Logger,
+) {
+	deckCmdBus := core.NewCommandBus()
+	deckCmdBus.Register(
+		command.CreateDeckKind,
+		command.NewCreateDeck(deckStorage, trManager, pubsub, logger),
+	)
+	deckCmdBus.Register(
+		command.UpdateDeckKind,
+		command.NewUpdateDeck(deckStorage, trManager, pubsub, logger),
+	)
+	deckCmdBus.Register(
+		command.DeleteDeckKind,
+		command.NewDeleteDeck(deckStorage, trManager, pubsub, logger),
+	)
+
+	deckQueryBus := core.NewQueryBus()
+	deckQueryBus.Register(
+		query.FetchDecksKind,
+		query.NewFetchDecks(deckStorage, logger),
+	)
+	deckQueryBus.Register(
+		query.FetchDeckKind,
+		query.NewFetchDeck(deckStorage, logger),
+	)
+
+	handlers.NewDeckHandlers(mountPoint, deckCmdBus, deckQueryBus, logger)
+}
@@ -0,0 +1,93 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/deck/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const CreateDeckKind = "CreateDeck"
+
+type CreateDeckCommand struct {
+	Name   string
+	Cards  []string
+	UserID string
+}
+
+func NewCreateDeckCommand(name string, cards []string, userID string) CreateDeckCommand {
+	return CreateDeckCommand{
+		Name:   name,
+		Cards:  cards,
+		UserID: userID,
+	}
+}
+
+func (c CreateDeckCommand) Type() core.CommandType {
+	return CreateDeckKind
+}
+
+var _ core.Command = (*CreateDeckCommand)(nil)
+
+type CreateDeck struct {
+	storage  ports.DeckPgStorage
+	manager  ports.TrManager
+	mediator ports.Mediator
+	logger   logger.Logger
+}
+
+func NewCreateDeck(
+	storage ports.DeckPgStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) CreateDeck {
+	return CreateDeck{
+		storage:  storage,
+		manager:  manager,
+		mediator: mediator,
+		logger:   logger,
+	}
+}
+
+func (c CreateDeck) Handle(ctx context.Context, command core.Command) (any, error) {
+	createCommand, ok := command.(CreateDeckCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	userID, err := common.ParseUID(createCommand.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	deck, err := entity.NewDeck(createCommand.Name, createCommand.Cards, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		err = c.storage.Create(ctx, deck)
+		if err != nil {
+			return err
+		}
+
+		err = c.mediator.Publish(ctx, deck.Events()...)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deck.ID().String(), nil
+}
+
+var _ core.CommandHandler = (*CreateDeck)(nil)
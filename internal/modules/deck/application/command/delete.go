@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/deck/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const DeleteDeckKind = "DeleteDeck"
+
+type DeleteDeckCommand struct {
+	ID     string
+	UserID string
+}
+
+func NewDeleteDeckCommand(id, userID string) DeleteDeckCommand {
+	return DeleteDeckCommand{
+		ID:     id,
+		UserID: userID,
+	}
+}
+
+func (c DeleteDeckCommand) Type() core.CommandType {
+	return DeleteDeckKind
+}
+
+var _ core.Command = (*DeleteDeckCommand)(nil)
+
+type DeleteDeck struct {
+	storage  ports.DeckPgStorage
+	manager  ports.TrManager
+	mediator ports.Mediator
+	logger   logger.Logger
+}
+
+func NewDeleteDeck(
+	storage ports.DeckPgStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) DeleteDeck {
+	return DeleteDeck{
+		storage:  storage,
+		manager:  manager,
+		mediator: mediator,
+		logger:   logger,
+	}
+}
+
+func (c DeleteDeck) Handle(ctx context.Context, command core.Command) (any, error) {
+	deleteCommand, ok := command.(DeleteDeckCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	id, err := common.ParseUID(deleteCommand.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(deleteCommand.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var deck entity.Deck
+		deck, err = c.storage.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = deck.Delete(userID)
+		if err != nil {
+			return err
+		}
+
+		var used bool
+		used, err = c.storage.IsUsed(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if used {
+			return entity.ErrDeckInUse
+		}
+
+		err = c.storage.Delete(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return c.mediator.Publish(ctx, deck.Events()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*DeleteDeck)(nil)
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/deck/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const UpdateDeckKind = "UpdateDeck"
+
+type UpdateDeckCommand struct {
+	ID     string
+	Name   string
+	Cards  []string
+	UserID string
+}
+
+func NewUpdateDeckCommand(id, name string, cards []string, userID string) UpdateDeckCommand {
+	return UpdateDeckCommand{
+		ID:     id,
+		Name:   name,
+		Cards:  cards,
+		UserID: userID,
+	}
+}
+
+func (c UpdateDeckCommand) Type() core.CommandType {
+	return UpdateDeckKind
+}
+
+var _ core.Command = (*UpdateDeckCommand)(nil)
+
+type UpdateDeck struct {
+	storage  ports.DeckPgStorage
+	manager  ports.TrManager
+	mediator ports.Mediator
+	logger   logger.Logger
+}
+
+func NewUpdateDeck(
+	storage ports.DeckPgStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) UpdateDeck {
+	return UpdateDeck{
+		storage:  storage,
+		manager:  manager,
+		mediator: mediator,
+		logger:   logger,
+	}
+}
+
+func (c UpdateDeck) Handle(ctx context.Context, command core.Command) (any, error) {
+	updateCommand, ok := command.(UpdateDeckCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	id, err := common.ParseUID(updateCommand.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(updateCommand.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var deck entity.Deck
+		deck, err = c.storage.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = deck.Update(userID, updateCommand.Name, updateCommand.Cards)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.Update(ctx, deck)
+		if err != nil {
+			return err
+		}
+
+		return c.mediator.Publish(ctx, deck.Events()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*UpdateDeck)(nil)
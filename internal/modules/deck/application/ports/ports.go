@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+type Mediator interface {
+	Publish(ctx context.Context, events ...mediator.Event) error
+}
+
+type TrManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type DeckPgStorage interface {
+	Fetch(ctx context.Context, ownerID common.UID, limit, offset int64) ([]entity.Deck, error)
+	GetByID(ctx context.Context, id common.UID) (entity.Deck, error)
+	Create(ctx context.Context, deck entity.Deck) error
+	Update(ctx context.Context, deck entity.Deck) error
+	Delete(ctx context.Context, id common.UID) error
+	IsUsed(ctx context.Context, id common.UID) (bool, error)
+}
@@ -0,0 +1,75 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/deck/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/deck/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const FetchDecksKind = "FetchDecks"
+
+type FetchDecksQuery struct {
+	UserID string
+	Limit  int64
+	Offset int64
+}
+
+func (f FetchDecksQuery) Type() core.QueryType {
+	return FetchDecksKind
+}
+
+var _ core.Query = (*FetchDecksQuery)(nil)
+
+type FetchDecks struct {
+	storage ports.DeckPgStorage
+	logger  logger.Logger
+}
+
+func NewFetchDecks(storage ports.DeckPgStorage, logger logger.Logger) FetchDecks {
+	return FetchDecks{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Handle returns preset decks followed by the decks owned by the user.
+func (f FetchDecks) Handle(ctx context.Context, query core.Query) (any, error) {
+	fetchQuery, ok := query.(FetchDecksQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	userID, err := common.ParseUID(fetchQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	decks, err := f.storage.Fetch(ctx, userID, fetchQuery.Limit, fetchQuery.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("fetch decks error: %w", err)
+	}
+
+	decksDto := make([]dto.DeckDTO, 0, len(decks))
+	for _, deck := range decks {
+		decksDto = append(decksDto, toDTO(deck))
+	}
+
+	return decksDto, nil
+}
+
+func toDTO(deck entity.Deck) dto.DeckDTO {
+	return dto.DeckDTO{
+		ID:        deck.ID().String(),
+		Name:      deck.Name(),
+		Cards:     deck.Cards(),
+		Preset:    deck.IsPreset(),
+		CreatedAt: deck.CreatedAt().String(),
+		UpdatedAt: deck.UpdatedAt().String(),
+	}
+}
@@ -0,0 +1,66 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/deck/application/ports"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const FetchDeckKind = "FetchDeck"
+
+type FetchDeckQuery struct {
+	ID     string
+	UserID string
+}
+
+func (f FetchDeckQuery) Type() core.QueryType {
+	return FetchDeckKind
+}
+
+var _ core.Query = (*FetchDeckQuery)(nil)
+
+type FetchDeck struct {
+	storage ports.DeckPgStorage
+	logger  logger.Logger
+}
+
+func NewFetchDeck(storage ports.DeckPgStorage, logger logger.Logger) FetchDeck {
+	return FetchDeck{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+func (f FetchDeck) Handle(ctx context.Context, query core.Query) (any, error) {
+	fetchQuery, ok := query.(FetchDeckQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	id, err := common.ParseUID(fetchQuery.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(fetchQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	deck, err := f.storage.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Other users' decks are reported as missing.
+	if !deck.CanUse(userID) {
+		return nil, domain_core.ErrNotFound
+	}
+
+	return toDTO(deck), nil
+}
@@ -0,0 +1,231 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/deck/domain/event"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+const (
+	minCards      = 2
+	maxCards      = 32
+	maxCardLength = 16
+	maxNameLength = 255
+)
+
+var (
+	ErrEmptyDeckName  = errors.New("deck name cannot be empty")
+	ErrDeckNameLength = errors.New("deck name is too long")
+	ErrTooFewCards    = errors.New("deck must contain at least two cards")
+	ErrTooManyCards   = errors.New("deck contains too many cards")
+	ErrInvalidCard    = errors.New("deck card value is invalid")
+	ErrDuplicateCard  = errors.New("deck contains duplicate cards")
+	ErrPresetReadOnly = errors.New("preset deck cannot be modified")
+	ErrNotDeckOwner   = errors.New("user is not the deck owner")
+	ErrDeckInUse      = errors.New("deck is used by games")
+)
+
+// Deck struct.
+type Deck struct {
+	*core.BaseAggregateRoot
+
+	id        common.UID
+	name      string
+	cards     []string
+	ownerID   common.UID
+	preset    bool
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewDeck - creates a new user-defined Deck with the provided name and cards.
+func NewDeck(name string, cards []string, ownerID common.UID) (Deck, error) {
+	name, cards, err := validate(name, cards)
+	if err != nil {
+		return Deck{}, err
+	}
+
+	deck := Deck{
+		BaseAggregateRoot: &core.BaseAggregateRoot{},
+		id:                common.NewUID(),
+		name:              name,
+		cards:             cards,
+		ownerID:           ownerID,
+	}
+
+	deck.BaseAggregateRoot.AddEvent(event.DeckCreatedEvent{ID: deck.ID().String(), Name: name, Cards: cards})
+
+	return deck, nil
+}
+
+func Hydrate(
+	id common.UID,
+	name string,
+	cards []string,
+	ownerID common.UID,
+	preset bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Deck {
+	return Deck{
+		BaseAggregateRoot: &core.BaseAggregateRoot{},
+		id:                id,
+		name:              name,
+		cards:             cards,
+		ownerID:           ownerID,
+		preset:            preset,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+	}
+}
+
+func (d *Deck) ID() common.UID {
+	return d.id
+}
+
+func (d *Deck) Name() string {
+	return d.name
+}
+
+func (d *Deck) Cards() []string {
+	return d.cards
+}
+
+// OwnerID returns the deck owner. It is empty for preset decks.
+func (d *Deck) OwnerID() common.UID {
+	return d.ownerID
+}
+
+func (d *Deck) IsPreset() bool {
+	return d.preset
+}
+
+func (d *Deck) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *Deck) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// HasCard reports whether the value is one of the deck cards.
+func (d *Deck) HasCard(value string) bool {
+	value = strings.TrimSpace(value)
+	for _, card := range d.cards {
+		if card == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanUse reports whether the user may attach the deck to a game.
+func (d *Deck) CanUse(userID common.UID) bool {
+	return d.preset || d.ownerID == userID
+}
+
+// Update changes the name and cards of a user-defined deck.
+func (d *Deck) Update(actorID common.UID, name string, cards []string) error {
+	if err := d.checkOwner(actorID); err != nil {
+		return err
+	}
+
+	name, cards, err := validate(name, cards)
+	if err != nil {
+		return err
+	}
+
+	d.name = name
+	d.cards = cards
+	d.updatedAt = time.Now().UTC()
+
+	d.BaseAggregateRoot.AddEvent(event.DeckUpdatedEvent{ID: d.ID().String(), Name: name, Cards: cards})
+
+	return nil
+}
+
+// Delete marks a user-defined deck as deleted.
+func (d *Deck) Delete(actorID common.UID) error {
+	if err := d.checkOwner(actorID); err != nil {
+		return err
+	}
+
+	d.BaseAggregateRoot.AddEvent(event.DeckDeletedEvent{ID: d.ID().String()})
+
+	return nil
+}
+
+func (d *Deck) checkOwner(actorID common.UID) error {
+	if d.preset {
+		return ErrPresetReadOnly
+	}
+
+	if d.ownerID != actorID {
+		return ErrNotDeckOwner
+	}
+
+	return nil
+}
+
+// IsEmpty checks if deck is empty.
+func (d *Deck) IsEmpty() bool {
+	return d.id.IsEmpty()
+}
+
+// String returns the string representation of the deck.
+func (d *Deck) String() string {
+	return fmt.Sprintf(
+		"Deck{ID: %s, Name: %s, Cards: %v}",
+		d.ID(),
+		d.Name(),
+		d.Cards(),
+	)
+}
+
+func (d *Deck) Events() []mediator.Event {
+	return d.BaseAggregateRoot.Events()
+}
+
+func validate(name string, cards []string) (string, []string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", nil, ErrEmptyDeckName
+	}
+
+	if len(name) > maxNameLength {
+		return "", nil, ErrDeckNameLength
+	}
+
+	if len(cards) < minCards {
+		return "", nil, ErrTooFewCards
+	}
+
+	if len(cards) > maxCards {
+		return "", nil, ErrTooManyCards
+	}
+
+	seen := make(map[string]struct{}, len(cards))
+	result := make([]string, 0, len(cards))
+	for _, card := range cards {
+		card = strings.TrimSpace(card)
+		if card == "" || len(card) > maxCardLength {
+			return "", nil, ErrInvalidCard
+		}
+
+		if _, ok := seen[card]; ok {
+			return "", nil, ErrDuplicateCard
+		}
+
+		seen[card] = struct{}{}
+		result = append(result, card)
+	}
+
+	return name, result, nil
+}
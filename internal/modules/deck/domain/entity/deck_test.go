@@ -0,0 +1,65 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
+)
+
+func TestNewDeck(t *testing.T) {
+	ownerID := common.NewUID()
+
+	deck, err := entity.NewDeck(" Hours ", []string{" 1", "2", "4 ", "8"}, ownerID)
+	require.NoError(t, err)
+	assert.Equal(t, "Hours", deck.Name())
+	assert.Equal(t, []string{"1", "2", "4", "8"}, deck.Cards())
+	assert.True(t, deck.HasCard("4"))
+	assert.False(t, deck.HasCard("3"))
+	assert.True(t, deck.CanUse(ownerID))
+	assert.False(t, deck.CanUse(common.NewUID()))
+	assert.Len(t, deck.Events(), 1)
+}
+
+func TestDeckValidation(t *testing.T) {
+	ownerID := common.NewUID()
+
+	_, err := entity.NewDeck("", []string{"1", "2"}, ownerID)
+	assert.ErrorIs(t, err, entity.ErrEmptyDeckName)
+
+	_, err = entity.NewDeck("Hours", []string{"1"}, ownerID)
+	assert.ErrorIs(t, err, entity.ErrTooFewCards)
+
+	_, err = entity.NewDeck("Hours", []string{"1", "1"}, ownerID)
+	assert.ErrorIs(t, err, entity.ErrDuplicateCard)
+
+	_, err = entity.NewDeck("Hours", []string{"1", " "}, ownerID)
+	assert.ErrorIs(t, err, entity.ErrInvalidCard)
+}
+
+func TestDeckOwnership(t *testing.T) {
+	ownerID := common.NewUID()
+
+	deck, err := entity.NewDeck("Hours", []string{"1", "2"}, ownerID)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, deck.Update(common.NewUID(), "Days", []string{"1", "2"}), entity.ErrNotDeckOwner)
+	require.NoError(t, deck.Update(ownerID, "Days", []string{"1", "2", "3"}))
+	assert.Equal(t, "Days", deck.Name())
+
+	preset := entity.Hydrate(
+		entity.FibonacciDeckID,
+		"Fibonacci",
+		[]string{"1", "2"},
+		common.UID{},
+		true,
+		deck.CreatedAt(),
+		deck.UpdatedAt(),
+	)
+	assert.True(t, preset.CanUse(ownerID))
+	assert.ErrorIs(t, preset.Update(ownerID, "Mine", []string{"1", "2"}), entity.ErrPresetReadOnly)
+	assert.ErrorIs(t, preset.Delete(ownerID), entity.ErrPresetReadOnly)
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+// Built-in deck identifiers. They are seeded by the decks migration and must not change.
+var (
+	FibonacciDeckID         = common.NewWithSpecifiedID(uuid.MustParse("00000000-0000-4000-8000-000000000001"))
+	ModifiedFibonacciDeckID = common.NewWithSpecifiedID(uuid.MustParse("00000000-0000-4000-8000-000000000002"))
+	TShirtDeckID            = common.NewWithSpecifiedID(uuid.MustParse("00000000-0000-4000-8000-000000000003"))
+	PowersOfTwoDeckID       = common.NewWithSpecifiedID(uuid.MustParse("00000000-0000-4000-8000-000000000004"))
+)
+
+// DefaultDeckID is used for games created without an explicit deck.
+var DefaultDeckID = FibonacciDeckID
@@ -0,0 +1,13 @@
+package event
+
+const DeckCreated = "DeckCreated"
+
+type DeckCreatedEvent struct {
+	ID    string
+	Name  string
+	Cards []string
+}
+
+func (e DeckCreatedEvent) Kind() string {
+	return DeckCreated
+}
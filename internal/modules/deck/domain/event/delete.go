@@ -0,0 +1,11 @@
+package event
+
+const DeckDeleted = "DeckDeleted"
+
+type DeckDeletedEvent struct {
+	ID string
+}
+
+func (e DeckDeletedEvent) Kind() string {
+	return DeckDeleted
+}
@@ -0,0 +1,13 @@
+package event
+
+const DeckUpdated = "DeckUpdated"
+
+type DeckUpdatedEvent struct {
+	ID    string
+	Name  string
+	Cards []string
+}
+
+func (e DeckUpdatedEvent) Kind() string {
+	return DeckUpdated
+}
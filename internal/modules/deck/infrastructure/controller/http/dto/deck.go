@@ -0,0 +1,25 @@
+package dto
+
+type DeckDTO struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Cards     []string `json:"cards"`
+	Preset    bool     `json:"preset"`
+	CreatedAt string   `json:"createdAt"`
+	UpdatedAt string   `json:"updateAt"`
+}
+
+type CreateDeckDTO struct {
+	Name  string   `json:"name" validate:"required,max=255"`
+	Cards []string `json:"cards" validate:"required,min=2,max=32,dive,required,max=16"`
+}
+
+type UpdateDeckDTO struct {
+	Name  string   `json:"name" validate:"required,max=255"`
+	Cards []string `json:"cards" validate:"required,min=2,max=32,dive,required,max=16"`
+}
+
+type FetchDecksDTO struct {
+	Limit  int64 `query:"limit" validate:"gte=0,lte=1000"`
+	Offset int64 `query:"offset" validate:"gte=0,lte=1000"`
+}
@@ -0,0 +1,448 @@
+//nolint:godot // file has comments for swagger doc
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	http_dto "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
+	"github.com/KyKyPy3/clean/internal/modules/deck/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/deck/application/query"
+	"github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/deck/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const (
+	requestTimeout  = 10 * time.Second
+	defaultPageSize = 50
+)
+
+type CommandBus interface {
+	Dispatch(context.Context, core.Command) (any, error)
+}
+
+type QueryBus interface {
+	Ask(context.Context, core.Query) (any, error)
+}
+
+type DeckHandlers struct {
+	Commands CommandBus
+	Queries  QueryBus
+	tracer   trace.Tracer
+	Logger   logger.Logger
+}
+
+func NewDeckHandlers(v1 *echo.Group, commands CommandBus, queries QueryBus, logger logger.Logger) {
+	handlers := &DeckHandlers{
+		Commands: commands,
+		Queries:  queries,
+		Logger:   logger,
+		tracer:   otel.Tracer(""),
+	}
+
+	v1.GET("/deck", handlers.Fetch)
+	v1.POST("/deck", handlers.Create)
+	v1.GET("/deck/:id", handlers.GetByID)
+	v1.PUT("/deck/:id", handlers.Update)
+	v1.DELETE("/deck/:id", handlers.Delete)
+}
+
+// Fetch godoc
+// @Summary Fetch decks
+// @Description Fetch preset decks and decks of the current user
+// @Tags Deck
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.DeckDTO
+// @Router /deck [get]
+func (d *DeckHandlers) Fetch(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := d.tracer.Start(ctx, "DeckHandlers.Fetch")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	opts := dto.FetchDecksDTO{
+		Limit:  defaultPageSize,
+		Offset: 0,
+	}
+
+	// Parse given params
+	errs := echo.QueryParamsBinder(c).
+		FailFast(false).
+		Int64("limit", &opts.Limit).
+		Int64("offset", &opts.Offset).
+		BindErrors()
+	if errs != nil {
+		for _, err := range errs {
+			var bindingError *echo.BindingError
+			if errors.As(err, &bindingError) {
+				errorList = append(errorList, &http_dto.ValidationError{
+					Field:  bindingError.Field,
+					Value:  bindingError.Values,
+					Reason: "parse",
+				})
+			}
+		}
+
+		d.Logger.Errorf("failed to decode request params: %#v", errorList)
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	err := c.Validate(opts)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	q := query.FetchDecksQuery{
+		UserID: userID,
+		Offset: opts.Offset,
+		Limit:  opts.Limit,
+	}
+	decks, err := d.Queries.Ask(ctx, q)
+	if err != nil {
+		return d.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data: map[string]interface{}{
+				"decks": decks,
+			},
+		},
+	)
+}
+
+// GetByID godoc
+// @Summary Get deck by id
+// @Description Get deck by id handler
+// @Tags Deck
+// @Accept json
+// @Produce json
+// @Param id path string true "Deck ID"
+// @Success 200 {object} dto.DeckDTO
+// @Router /deck/{id} [get]
+func (d *DeckHandlers) GetByID(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := d.tracer.Start(ctx, "DeckHandlers.GetByID")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	deck, err := d.Queries.Ask(ctx, query.FetchDeckQuery{ID: c.Param("id"), UserID: userID})
+	if err != nil {
+		return d.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data:    deck,
+		},
+	)
+}
+
+// Create godoc
+// @Summary Create deck
+// @Description Create user-defined deck handler
+// @Tags Deck
+// @Accept json
+// @Produce json
+// @Param body body dto.CreateDeckDTO true "Deck"
+// @Success 201
+// @Router /deck [post]
+func (d *DeckHandlers) Create(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := d.tracer.Start(ctx, "DeckHandlers.Create")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.CreateDeckDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	id, err := d.Commands.Dispatch(ctx, command.NewCreateDeckCommand(params.Name, params.Cards, userID))
+	if err != nil {
+		return d.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusCreated,
+		http_dto.ResponseDTO{
+			Status:  http.StatusCreated,
+			Message: "success",
+			Data: map[string]interface{}{
+				"id": id,
+			},
+		},
+	)
+}
+
+// Update godoc
+// @Summary Update deck
+// @Description Update user-defined deck handler
+// @Tags Deck
+// @Accept json
+// @Produce json
+// @Param id path string true "Deck ID"
+// @Param body body dto.UpdateDeckDTO true "Deck"
+// @Success 200
+// @Router /deck/{id} [put]
+func (d *DeckHandlers) Update(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := d.tracer.Start(ctx, "DeckHandlers.Update")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.UpdateDeckDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err = d.Commands.Dispatch(ctx, command.NewUpdateDeckCommand(c.Param("id"), params.Name, params.Cards, userID))
+	if err != nil {
+		return d.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// Delete godoc
+// @Summary Delete deck
+// @Description Delete user-defined deck handler
+// @Tags Deck
+// @Accept json
+// @Produce json
+// @Param id path string true "Deck ID"
+// @Success 204
+// @Router /deck/{id} [delete]
+func (d *DeckHandlers) Delete(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := d.tracer.Start(ctx, "DeckHandlers.Delete")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err := d.Commands.Dispatch(ctx, command.NewDeleteDeckCommand(c.Param("id"), userID))
+	if err != nil {
+		return d.errorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// errorResponse maps domain errors to http status codes.
+func (d *DeckHandlers) errorResponse(c echo.Context, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain_core.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, entity.ErrNotDeckOwner), errors.Is(err, entity.ErrPresetReadOnly):
+		status = http.StatusForbidden
+	case errors.Is(err, entity.ErrDeckInUse):
+		status = http.StatusConflict
+	case errors.Is(err, entity.ErrEmptyDeckName),
+		errors.Is(err, entity.ErrDeckNameLength),
+		errors.Is(err, entity.ErrTooFewCards),
+		errors.Is(err, entity.ErrTooManyCards),
+		errors.Is(err, entity.ErrInvalidCard),
+		errors.Is(err, entity.ErrDuplicateCard):
+		status = http.StatusBadRequest
+	default:
+		d.Logger.Errorf("deck request failed: %v", err)
+	}
+
+	return c.JSON(
+		status,
+		http_dto.ResponseDTO{
+			Status:  status,
+			Message: "error",
+			Error:   err.Error(),
+		},
+	)
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
+)
+
+// DBDeck Database deck representation.
+type DBDeck struct {
+	ID        string    `db:"id"`
+	Name      string    `db:"name"`
+	Cards     string    `db:"cards"`
+	OwnerID   string    `db:"owner_id"`
+	IsPreset  bool      `db:"is_preset"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// DeckFromDB Convert database deck model to domain model.
+func DeckFromDB(dbDeck DBDeck) (entity.Deck, error) {
+	entityID, err := common.ParseUID(dbDeck.ID)
+	if err != nil {
+		return entity.Deck{}, err
+	}
+
+	var ownerID common.UID
+	if dbDeck.OwnerID != "" {
+		ownerID, err = common.ParseUID(dbDeck.OwnerID)
+		if err != nil {
+			return entity.Deck{}, err
+		}
+	}
+
+	cards := make([]string, 0)
+	if err = json.Unmarshal([]byte(dbDeck.Cards), &cards); err != nil {
+		return entity.Deck{}, err
+	}
+
+	deck := entity.Hydrate(
+		entityID,
+		dbDeck.Name,
+		cards,
+		ownerID,
+		dbDeck.IsPreset,
+		dbDeck.CreatedAt,
+		dbDeck.UpdatedAt,
+	)
+
+	return deck, nil
+}
+
+// DeckToDB Convert domain deck model to database model.
+func DeckToDB(deck entity.Deck) (DBDeck, error) {
+	cards, err := json.Marshal(deck.Cards())
+	if err != nil {
+		return DBDeck{}, err
+	}
+
+	return DBDeck{
+		ID:       deck.ID().String(),
+		Name:     deck.Name(),
+		Cards:    string(cards),
+		OwnerID:  deck.OwnerID().String(),
+		IsPreset: deck.IsPreset(),
+	}, nil
+}
@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/deck/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+type deckPgStorage struct {
+	db     *sqlx.DB
+	logger logger.Logger
+	tracer trace.Tracer
+	getter *trmsqlx.CtxGetter
+}
+
+func NewDeckPgStorage(db *sqlx.DB, getter *trmsqlx.CtxGetter, logger logger.Logger) ports.DeckPgStorage {
+	return &deckPgStorage{
+		db:     db,
+		logger: logger,
+		getter: getter,
+		tracer: otel.Tracer(""),
+	}
+}
+
+// Fetch preset decks and decks owned by the user.
+func (d *deckPgStorage) Fetch(ctx context.Context, ownerID common.UID, limit, offset int64) ([]entity.Deck, error) {
+	ctx, span := d.tracer.Start(ctx, "deckPgStorage.Fetch")
+	defer span.End()
+
+	decks := make([]DBDeck, 0)
+	err := d.getter.DefaultTrOrDB(ctx, d.db).SelectContext(ctx, &decks, FetchSQL, ownerID.String(), limit, offset)
+	if err != nil {
+		d.logger.Errorf("Can't fetch decks with limit %d and offset %d, err: %v", limit, offset, err)
+		return nil, errors.Wrap(err, "Fetch.SelectContext")
+	}
+
+	result := make([]entity.Deck, 0, len(decks))
+	for _, dbDeck := range decks {
+		deck, deckErr := DeckFromDB(dbDeck)
+		if deckErr != nil {
+			d.logger.Errorf("Can't convert deck data to domain entity. err: %v", deckErr)
+			return nil, errors.Wrap(deckErr, "Fetch.DeckFromDB")
+		}
+
+		result = append(result, deck)
+	}
+
+	return result, nil
+}
+
+// GetByID Get deck by id.
+func (d *deckPgStorage) GetByID(ctx context.Context, id common.UID) (entity.Deck, error) {
+	ctx, span := d.tracer.Start(ctx, "deckPgStorage.GetByID")
+	defer span.End()
+
+	decks := make([]DBDeck, 0)
+	err := d.getter.DefaultTrOrDB(ctx, d.db).SelectContext(ctx, &decks, GetByIDSQL, id.String())
+	if err != nil {
+		d.logger.Errorf("Can't fetch deck by id, err: %v", err)
+		return entity.Deck{}, errors.Wrap(err, "GetByID.SelectContext")
+	}
+
+	if len(decks) == 0 {
+		return entity.Deck{}, core.ErrNotFound
+	}
+
+	deck, err := DeckFromDB(decks[0])
+	if err != nil {
+		d.logger.Errorf("Can't convert deck data to domain entity. err: %v", err)
+		return entity.Deck{}, errors.Wrap(err, "GetByID.DeckFromDB")
+	}
+
+	return deck, nil
+}
+
+// Create new user-defined deck.
+func (d *deckPgStorage) Create(ctx context.Context, deck entity.Deck) error {
+	ctx, span := d.tracer.Start(ctx, "deckPgStorage.Create")
+	defer span.End()
+
+	dbDeck, err := DeckToDB(deck)
+	if err != nil {
+		return errors.Wrap(err, "Create.DeckToDB")
+	}
+
+	if _, err = d.getter.DefaultTrOrDB(ctx, d.db).ExecContext(
+		ctx,
+		CreateSQL,
+		dbDeck.ID,
+		dbDeck.Name,
+		dbDeck.Cards,
+		dbDeck.OwnerID,
+	); err != nil {
+		return errors.Wrap(err, "Create.ExecContext")
+	}
+
+	return nil
+}
+
+// Update name and cards of user-defined deck.
+func (d *deckPgStorage) Update(ctx context.Context, deck entity.Deck) error {
+	ctx, span := d.tracer.Start(ctx, "deckPgStorage.Update")
+	defer span.End()
+
+	dbDeck, err := DeckToDB(deck)
+	if err != nil {
+		return errors.Wrap(err, "Update.DeckToDB")
+	}
+
+	res, err := d.getter.DefaultTrOrDB(ctx, d.db).ExecContext(
+		ctx,
+		UpdateSQL,
+		dbDeck.ID,
+		dbDeck.Name,
+		dbDeck.Cards,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Update.ExecContext")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "Update.RowsAffected")
+	}
+
+	if rowsAffected != 1 {
+		return fmt.Errorf("update operation affected %d row", rowsAffected)
+	}
+
+	return nil
+}
+
+// Delete user-defined deck.
+func (d *deckPgStorage) Delete(ctx context.Context, id common.UID) error {
+	ctx, span := d.tracer.Start(ctx, "deckPgStorage.Delete")
+	defer span.End()
+
+	res, err := d.getter.DefaultTrOrDB(ctx, d.db).ExecContext(ctx, DeleteSQL, id.String())
+	if err != nil {
+		return errors.Wrap(err, "Delete.ExecContext")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "Delete.RowsAffected")
+	}
+
+	if rowsAffected != 1 {
+		return fmt.Errorf("delete operation affected %d row", rowsAffected)
+	}
+
+	return nil
+}
+
+// IsUsed reports whether any game references the deck.
+func (d *deckPgStorage) IsUsed(ctx context.Context, id common.UID) (bool, error) {
+	ctx, span := d.tracer.Start(ctx, "deckPgStorage.IsUsed")
+	defer span.End()
+
+	var used bool
+	err := d.getter.DefaultTrOrDB(ctx, d.db).GetContext(ctx, &used, IsUsedSQL, id.String())
+	if err != nil {
+		return false, errors.Wrap(err, "IsUsed.GetContext")
+	}
+
+	return used, nil
+}
@@ -0,0 +1,23 @@
+package postgres
+
+import _ "embed"
+
+var (
+	//go:embed query/fetch.sql
+	FetchSQL string
+
+	//go:embed query/getByID.sql
+	GetByIDSQL string
+
+	//go:embed query/create.sql
+	CreateSQL string
+
+	//go:embed query/update.sql
+	UpdateSQL string
+
+	//go:embed query/delete.sql
+	DeleteSQL string
+
+	//go:embed query/isUsed.sql
+	IsUsedSQL string
+)
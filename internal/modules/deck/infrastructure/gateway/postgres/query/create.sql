@@ -0,0 +1,2 @@
+INSERT INTO decks (id, name, cards, owner_id)
+VALUES ($1, $2, $3::jsonb, $4)
\ No newline at end of file
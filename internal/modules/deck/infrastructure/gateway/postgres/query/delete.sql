@@ -0,0 +1,4 @@
+DELETE
+FROM decks
+WHERE id = $1
+  AND NOT is_preset
\ No newline at end of file
@@ -0,0 +1,11 @@
+SELECT id,
+       name,
+       cards::text AS cards,
+       COALESCE(owner_id, '') AS owner_id,
+       is_preset,
+       created_at,
+       updated_at
+FROM decks
+WHERE is_preset OR owner_id = $1
+ORDER BY is_preset DESC, created_at
+LIMIT $2 OFFSET $3
\ No newline at end of file
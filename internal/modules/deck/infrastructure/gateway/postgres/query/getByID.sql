@@ -0,0 +1,9 @@
+SELECT id,
+       name,
+       cards::text AS cards,
+       COALESCE(owner_id, '') AS owner_id,
+       is_preset,
+       created_at,
+       updated_at
+FROM decks
+WHERE id = $1
\ No newline at end of file
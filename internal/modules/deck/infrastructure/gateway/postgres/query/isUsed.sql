@@ -0,0 +1 @@
+SELECT EXISTS(SELECT 1 FROM games WHERE deck_id = $1)
\ No newline at end of file
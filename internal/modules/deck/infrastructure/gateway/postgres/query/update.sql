@@ -0,0 +1,6 @@
+UPDATE decks
+SET name       = $2,
+    cards      = $3::jsonb,
+    updated_at = NOW()
+WHERE id = $1
+  AND NOT is_preset
\ No newline at end of file
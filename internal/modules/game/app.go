@@ -2,39 +2,231 @@ package game
 
 import (
 	"context"
+	"time"
 
 	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 	"github.com/labstack/echo/v4"
 
 	"github.com/KyKyPy3/clean/internal/application/core"
 	"github.com/KyKyPy3/clean/internal/modules/game/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/event"
 	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/presence"
 	"github.com/KyKyPy3/clean/internal/modules/game/application/query"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/scheduler"
+	game_event "github.com/KyKyPy3/clean/internal/modules/game/domain/event"
 	handlers "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/v1"
+	sse_handlers "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/sse/v1"
+	ws_handlers "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/ws/v1"
+	"github.com/KyKyPy3/clean/pkg/aftercommit"
+	"github.com/KyKyPy3/clean/pkg/latch"
 	"github.com/KyKyPy3/clean/pkg/logger"
 	"github.com/KyKyPy3/clean/pkg/mediator"
+	"github.com/KyKyPy3/clean/pkg/tenant"
 )
 
+// deadlinesInterval is how often due game deadlines, like round timers, are checked.
+const deadlinesInterval = time.Second
+
 func InitHandlers(
-	_ context.Context,
+	ctx context.Context,
 	gameStorage ports.GamePgStorage,
+	summaryStorage ports.GameSummaryStorage,
 	userStorage ports.UserViewStorage,
+	deckStorage ports.DeckViewStorage,
+	room ports.Room,
+	presenceStorage ports.PresenceStorage,
 	mountPoint *echo.Group,
 	pubsub *mediator.Mediator,
 	trManager *manager.Manager,
+	allowedOrigins []string,
+	lock *latch.CountDownLatch,
 	logger logger.Logger,
 ) {
+	summaryProjector := event.NewGameSummaryProjector(gameStorage, summaryStorage, userStorage, logger)
+	// The game events reach the rooms once the transaction recording them commits
+	txManager := aftercommit.New(trManager, logger)
+
 	gameCmdBus := core.NewCommandBus()
 	gameCmdBus.Register(
 		command.CreateGameKind,
-		command.NewCreateGame(gameStorage, trManager, pubsub, logger),
+		command.NewCreateGame(gameStorage, summaryProjector, userStorage, deckStorage, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.StartRoundKind,
+		command.NewStartRound(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.StartNextRoundKind,
+		command.NewStartNextRound(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.ImportStoriesKind,
+		command.NewImportStories(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.CastVoteKind,
+		command.NewCastVote(gameStorage, summaryProjector, deckStorage, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.RevealRoundKind,
+		command.NewRevealRound(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.AutoRevealRoundKind,
+		command.NewAutoRevealRound(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.ResetRoundKind,
+		command.NewResetRound(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.CloseRoundKind,
+		command.NewCloseRound(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.InviteMemberKind,
+		command.NewInviteMember(gameStorage, summaryProjector, userStorage, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.CreateJoinLinkKind,
+		command.NewCreateJoinLink(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.JoinGameKind,
+		command.NewJoinGame(gameStorage, summaryProjector, userStorage, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.LeaveGameKind,
+		command.NewLeaveGame(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.RemoveMemberKind,
+		command.NewRemoveMember(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.ChangeMemberRoleKind,
+		command.NewChangeMemberRole(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.TransferOwnershipKind,
+		command.NewTransferOwnership(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.UpdateGameKind,
+		command.NewUpdateGame(gameStorage, summaryProjector, deckStorage, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.ArchiveGameKind,
+		command.NewArchiveGame(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.DeleteGameKind,
+		command.NewDeleteGame(gameStorage, summaryProjector, txManager, pubsub, logger),
+	)
+	gameCmdBus.Register(
+		command.RebuildProjectionsKind,
+		command.NewRebuildProjections([]ports.Projection{summaryProjector}, txManager, logger),
+	)
+	gameCmdBus.Register(
+		command.BackfillSnapshotsKind,
+		command.NewBackfillSnapshots(gameStorage, txManager, logger),
 	)
 
 	gameQueryBus := core.NewQueryBus()
 	gameQueryBus.Register(
 		query.FetchGamesKind,
-		query.NewFetchGames(gameStorage, userStorage, logger),
+		query.NewFetchGames(summaryStorage, logger),
+	)
+	gameQueryBus.Register(
+		query.FetchGameKind,
+		query.NewFetchGame(gameStorage, userStorage, logger),
+	)
+	gameQueryBus.Register(
+		query.FetchRoundKind,
+		query.NewFetchRound(gameStorage, logger),
+	)
+	gameQueryBus.Register(
+		query.FetchStoriesKind,
+		query.NewFetchStories(gameStorage, logger),
+	)
+	gameQueryBus.Register(
+		query.FetchHistoryKind,
+		query.NewFetchHistory(gameStorage, logger),
+	)
+	gameQueryBus.Register(
+		query.FetchRoundStatsKind,
+		query.NewFetchRoundStats(gameStorage, logger),
+	)
+	gameQueryBus.Register(
+		query.ExportGameKind,
+		query.NewExportGame(gameStorage, logger),
+	)
+	gameQueryBus.Register(
+		query.FetchPresenceKind,
+		query.NewFetchPresence(gameStorage, presenceStorage, logger),
 	)
+	gameQueryBus.Register(
+		query.CheckMembershipKind,
+		query.NewCheckMembership(gameStorage, logger),
+	)
+
+	roomBroadcast := event.NewRoomBroadcast(room, logger)
+	for _, kind := range []string{
+		game_event.MemberJoined,
+		game_event.MemberLeft,
+		game_event.MemberOnline,
+		game_event.MemberOffline,
+		game_event.MemberRemoved,
+		game_event.MemberRoleChanged,
+		game_event.OwnershipTransferred,
+		game_event.GameUpdated,
+		game_event.StoriesImported,
+		game_event.GameArchived,
+		game_event.GameDeleted,
+		game_event.RoundStarted,
+		game_event.VoteCast,
+		game_event.RoundRevealed,
+		game_event.RoundReset,
+		game_event.RoundClosed,
+	} {
+		pubsub.Subscribe(kind, roomBroadcast.Handle)
+	}
+
+	scheduler.NewDeadlines(gameStorage, txManager, gameCmdBus, logger).Start(ctx, lock, deadlinesInterval)
 
 	handlers.NewGameHandlers(mountPoint, gameCmdBus, gameQueryBus, logger)
+	tracker := presence.NewTracker(presenceStorage, pubsub, logger)
+	ws_handlers.NewRoomHandlers(mountPoint, gameQueryBus, room, tracker, allowedOrigins, logger)
+	sse_handlers.NewEventsHandlers(mountPoint, gameQueryBus, room, tracker, logger)
+}
+
+// RebuildProjections recreates the game read models of all organizations from the event store.
+func RebuildProjections(
+	ctx context.Context,
+	gameStorage ports.GamePgStorage,
+	summaryStorage ports.GameSummaryStorage,
+	userStorage ports.UserViewStorage,
+	trManager *manager.Manager,
+	logger logger.Logger,
+) error {
+	summaryProjector := event.NewGameSummaryProjector(gameStorage, summaryStorage, userStorage, logger)
+
+	_, err := command.NewRebuildProjections([]ports.Projection{summaryProjector}, trManager, logger).
+		Handle(tenant.Unscoped(ctx), command.RebuildProjectionsCommand{})
+
+	return err
+}
+
+// BackfillSnapshots snapshots the games of all organizations created before the event store.
+func BackfillSnapshots(
+	ctx context.Context,
+	gameStorage ports.GamePgStorage,
+	trManager *manager.Manager,
+	logger logger.Logger,
+) error {
+	_, err := command.NewBackfillSnapshots(gameStorage, trManager, logger).
+		Handle(tenant.Unscoped(ctx), command.BackfillSnapshotsCommand{})
+
+	return err
 }
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const ArchiveGameKind = "ArchiveGame"
+
+type ArchiveGameCommand struct {
+	GameID string
+	UserID string
+}
+
+func NewArchiveGameCommand(gameID, userID string) ArchiveGameCommand {
+	return ArchiveGameCommand{
+		GameID: gameID,
+		UserID: userID,
+	}
+}
+
+func (c ArchiveGameCommand) Type() core.CommandType {
+	return ArchiveGameKind
+}
+
+var _ core.Command = (*ArchiveGameCommand)(nil)
+
+type ArchiveGame struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewArchiveGame(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) ArchiveGame {
+	return ArchiveGame{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (c ArchiveGame) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(ArchiveGameCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = game.Archive(userID)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.Update(ctx, game)
+		if err != nil {
+			return err
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*ArchiveGame)(nil)
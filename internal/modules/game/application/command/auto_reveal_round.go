@@ -0,0 +1,133 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const AutoRevealRoundKind = "AutoRevealRound"
+
+// AutoRevealRoundCommand reveals the votes of a round whose timer has expired.
+// It is dispatched by the deadline scheduler, not by users.
+type AutoRevealRoundCommand struct {
+	GameID  string
+	RoundID string
+	Now     time.Time
+}
+
+func NewAutoRevealRoundCommand(gameID, roundID string, now time.Time) AutoRevealRoundCommand {
+	return AutoRevealRoundCommand{
+		GameID:  gameID,
+		RoundID: roundID,
+		Now:     now,
+	}
+}
+
+func (c AutoRevealRoundCommand) Type() core.CommandType {
+	return AutoRevealRoundKind
+}
+
+var _ core.Command = (*AutoRevealRoundCommand)(nil)
+
+type AutoRevealRound struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewAutoRevealRound(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) AutoRevealRound {
+	return AutoRevealRound{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+// Handle reveals the round votes and returns true, or false when the deadline is stale
+// because the round was revealed, reset or replaced before it was due.
+func (c AutoRevealRound) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(AutoRevealRoundCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	roundID, err := common.ParseUID(cmd.RoundID)
+	if err != nil {
+		return nil, err
+	}
+
+	revealed := false
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = game.RevealExpiredRound(roundID, cmd.Now)
+		if err != nil {
+			return err
+		}
+
+		revealed = true
+
+		return saveRevealedRound(ctx, c.storage, c.projector, c.mediator, game)
+	})
+	if isStaleDeadline(err) {
+		c.logger.Debugf("Skip stale reveal deadline of round %s: %v", cmd.RoundID, err)
+		return false, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return revealed, nil
+}
+
+var _ core.CommandHandler = (*AutoRevealRound)(nil)
+
+func isStaleDeadline(err error) bool {
+	return errors.Is(err, domain_core.ErrNotFound) ||
+		errors.Is(err, entity.ErrRoundNotFound) ||
+		errors.Is(err, entity.ErrRoundNotVoting) ||
+		errors.Is(err, entity.ErrRoundTimerNotExpired)
+}
+
+// scheduleRoundTimer replaces the pending deadlines of the round with its current countdown.
+func scheduleRoundTimer(ctx context.Context, storage ports.GamePgStorage, round entity.Round) error {
+	err := storage.CancelDeadlines(ctx, round.ID())
+	if err != nil {
+		return err
+	}
+
+	deadline, ok := entity.NewRoundRevealDeadline(round)
+	if !ok {
+		return nil
+	}
+
+	return storage.ScheduleDeadline(ctx, deadline)
+}
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const BackfillSnapshotsKind = "BackfillSnapshots"
+
+// backfillBatchSize is how many games are fetched at once.
+const backfillBatchSize = 100
+
+type BackfillSnapshotsCommand struct{}
+
+func (c BackfillSnapshotsCommand) Type() core.CommandType {
+	return BackfillSnapshotsKind
+}
+
+var _ core.Command = (*BackfillSnapshotsCommand)(nil)
+
+type BackfillSnapshots struct {
+	storage ports.GamePgStorage
+	manager ports.TrManager
+	logger  logger.Logger
+}
+
+func NewBackfillSnapshots(
+	storage ports.GamePgStorage,
+	manager ports.TrManager,
+	logger logger.Logger,
+) BackfillSnapshots {
+	return BackfillSnapshots{
+		storage: storage,
+		manager: manager,
+		logger:  logger,
+	}
+}
+
+// Handle snapshots the games created before the event store from their tables, so
+// their new events are replayed onto the snapshot. Every game is snapshotted in its
+// own transaction and the games already snapshotted are skipped, so the backfill
+// can be run again after a failure.
+func (c BackfillSnapshots) Handle(ctx context.Context, command core.Command) (any, error) {
+	if _, ok := command.(BackfillSnapshotsCommand); !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	var backfilled int
+	afterID := ""
+	for {
+		ids, err := c.storage.FetchUntrackedGameIDs(ctx, afterID, backfillBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids {
+			err = c.manager.Do(ctx, func(ctx context.Context) error {
+				var game entity.Game
+				game, err = c.storage.GetByID(ctx, id)
+				if err != nil {
+					return err
+				}
+
+				return c.storage.SaveSnapshot(ctx, game)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("backfill game %s: %w", id, err)
+			}
+		}
+
+		backfilled += len(ids)
+		if len(ids) < backfillBatchSize {
+			break
+		}
+		afterID = ids[len(ids)-1].String()
+	}
+
+	c.logger.Infof("Game snapshots are backfilled, %d games processed", backfilled)
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*BackfillSnapshots)(nil)
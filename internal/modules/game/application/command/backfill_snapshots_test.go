@@ -0,0 +1,86 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+type untrackedStorageStub struct {
+	ports.GamePgStorage
+
+	games       map[common.UID]entity.Game
+	snapshotted []common.UID
+}
+
+func (s *untrackedStorageStub) FetchUntrackedGameIDs(
+	_ context.Context,
+	afterID string,
+	limit int64,
+) ([]common.UID, error) {
+	ids := make([]common.UID, 0)
+	for id := range s.games {
+		if id.String() > afterID && !s.isSnapshotted(id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids[:min(int64(len(ids)), limit)], nil
+}
+
+func (s *untrackedStorageStub) GetByID(_ context.Context, id common.UID) (entity.Game, error) {
+	return s.games[id], nil
+}
+
+func (s *untrackedStorageStub) SaveSnapshot(_ context.Context, game entity.Game) error {
+	s.snapshotted = append(s.snapshotted, game.ID())
+	return nil
+}
+
+func (s *untrackedStorageStub) isSnapshotted(id common.UID) bool {
+	for _, snapshotted := range s.snapshotted {
+		if snapshotted == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+type trManagerStub struct{}
+
+func (trManagerStub) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestBackfillSnapshots(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	storage := &untrackedStorageStub{games: map[common.UID]entity.Game{}}
+	for range 2 {
+		game, err := entity.NewGame(common.NewUID(), "Sprint 42", common.NewUID(), "Owner", common.NewUID())
+		require.NoError(t, err)
+		storage.games[game.ID()] = game
+	}
+
+	handler := command.NewBackfillSnapshots(storage, trManagerStub{}, log)
+	_, err := handler.Handle(context.Background(), command.BackfillSnapshotsCommand{})
+	require.NoError(t, err)
+	assert.Len(t, storage.snapshotted, 2)
+
+	// The snapshotted games are skipped when the backfill runs again
+	_, err = handler.Handle(context.Background(), command.BackfillSnapshotsCommand{})
+	require.NoError(t, err)
+	assert.Len(t, storage.snapshotted, 2)
+}
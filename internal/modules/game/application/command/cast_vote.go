@@ -0,0 +1,114 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	deck_entity "github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const CastVoteKind = "CastVote"
+
+type CastVoteCommand struct {
+	GameID string
+	UserID string
+	Value  string
+}
+
+func NewCastVoteCommand(gameID, userID, value string) CastVoteCommand {
+	return CastVoteCommand{
+		GameID: gameID,
+		UserID: userID,
+		Value:  value,
+	}
+}
+
+func (c CastVoteCommand) Type() core.CommandType {
+	return CastVoteKind
+}
+
+var _ core.Command = (*CastVoteCommand)(nil)
+
+type CastVote struct {
+	storage     ports.GamePgStorage
+	projector   ports.Projector
+	deckStorage ports.DeckViewStorage
+	manager     ports.TrManager
+	mediator    ports.Mediator
+	logger      logger.Logger
+}
+
+func NewCastVote(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	deckStorage ports.DeckViewStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) CastVote {
+	return CastVote{
+		storage:     storage,
+		projector:   projector,
+		deckStorage: deckStorage,
+		manager:     manager,
+		mediator:    mediator,
+		logger:      logger,
+	}
+}
+
+func (c CastVote) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(CastVoteCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		var deck deck_entity.Deck
+		deck, err = c.deckStorage.GetByID(ctx, game.DeckID())
+		if err != nil {
+			return err
+		}
+
+		err = game.CastVote(userID, cmd.Value, &deck)
+		if err != nil {
+			return err
+		}
+
+		round, _ := game.CurrentRound()
+		err = c.storage.SaveRound(ctx, round)
+		if err != nil {
+			return err
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*CastVote)(nil)
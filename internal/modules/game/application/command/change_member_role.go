@@ -0,0 +1,115 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const ChangeMemberRoleKind = "ChangeMemberRole"
+
+type ChangeMemberRoleCommand struct {
+	GameID   string
+	UserID   string
+	MemberID string
+	Role     string
+}
+
+func NewChangeMemberRoleCommand(gameID, userID, memberID, role string) ChangeMemberRoleCommand {
+	return ChangeMemberRoleCommand{
+		GameID:   gameID,
+		UserID:   userID,
+		MemberID: memberID,
+		Role:     role,
+	}
+}
+
+func (c ChangeMemberRoleCommand) Type() core.CommandType {
+	return ChangeMemberRoleKind
+}
+
+var _ core.Command = (*ChangeMemberRoleCommand)(nil)
+
+type ChangeMemberRole struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewChangeMemberRole(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) ChangeMemberRole {
+	return ChangeMemberRole{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (c ChangeMemberRole) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(ChangeMemberRoleCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := common.ParseUID(cmd.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := entity.ParseRole(cmd.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = game.ChangeMemberRole(userID, memberID, role)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.UpdateMemberRole(ctx, gameID, memberID, role)
+		if err != nil {
+			return err
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*ChangeMemberRole)(nil)
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const CloseRoundKind = "CloseRound"
+
+type CloseRoundCommand struct {
+	GameID   string
+	UserID   string
+	Estimate string
+}
+
+func NewCloseRoundCommand(gameID, userID, estimate string) CloseRoundCommand {
+	return CloseRoundCommand{
+		GameID:   gameID,
+		UserID:   userID,
+		Estimate: estimate,
+	}
+}
+
+func (c CloseRoundCommand) Type() core.CommandType {
+	return CloseRoundKind
+}
+
+var _ core.Command = (*CloseRoundCommand)(nil)
+
+type CloseRound struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewCloseRound(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) CloseRound {
+	return CloseRound{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (c CloseRound) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(CloseRoundCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = game.CloseRound(userID, cmd.Estimate)
+		if err != nil {
+			return err
+		}
+
+		round, _ := game.CurrentRound()
+		err = c.storage.SaveRound(ctx, round)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.CloseEstimation(ctx, round.ID(), round.Estimate())
+		if err != nil {
+			return err
+		}
+
+		if story, ok := game.StoryByRound(round.ID()); ok {
+			err = c.storage.UpdateStory(ctx, story)
+			if err != nil {
+				return err
+			}
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*CloseRound)(nil)
@@ -6,22 +6,28 @@ import (
 
 	"github.com/KyKyPy3/clean/internal/application/core"
 	"github.com/KyKyPy3/clean/internal/domain/common"
+	deck_entity "github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
 	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
 	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	user_entity "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
 	"github.com/KyKyPy3/clean/pkg/logger"
 )
 
 const CreateGameKind = "CreateGame"
 
 type CreateGameCommand struct {
-	Name   string
-	UserID string
+	OrganizationID string
+	Name           string
+	DeckID         string
+	UserID         string
 }
 
-func NewCreateGameCommand(name, userID string) CreateGameCommand {
+func NewCreateGameCommand(organizationID, name, deckID, userID string) CreateGameCommand {
 	return CreateGameCommand{
-		Name:   name,
-		UserID: userID,
+		OrganizationID: organizationID,
+		Name:           name,
+		DeckID:         deckID,
+		UserID:         userID,
 	}
 }
 
@@ -32,23 +38,32 @@ func (c CreateGameCommand) Type() core.CommandType {
 var _ core.Command = (*CreateGameCommand)(nil)
 
 type CreateGame struct {
-	storage  ports.GamePgStorage
-	manager  ports.TrManager
-	mediator ports.Mediator
-	logger   logger.Logger
+	storage     ports.GamePgStorage
+	projector   ports.Projector
+	userStorage ports.UserViewStorage
+	deckStorage ports.DeckViewStorage
+	manager     ports.TrManager
+	mediator    ports.Mediator
+	logger      logger.Logger
 }
 
 func NewCreateGame(
 	storage ports.GamePgStorage,
+	projector ports.Projector,
+	userStorage ports.UserViewStorage,
+	deckStorage ports.DeckViewStorage,
 	manager ports.TrManager,
 	mediator ports.Mediator,
 	logger logger.Logger,
 ) CreateGame {
 	return CreateGame{
-		storage:  storage,
-		manager:  manager,
-		mediator: mediator,
-		logger:   logger,
+		storage:     storage,
+		projector:   projector,
+		userStorage: userStorage,
+		deckStorage: deckStorage,
+		manager:     manager,
+		mediator:    mediator,
+		logger:      logger,
 	}
 }
 
@@ -58,28 +73,59 @@ func (c CreateGame) Handle(ctx context.Context, command core.Command) (any, erro
 		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
 	}
 
-	userID, err := common.ParseUID(createCommand.UserID)
+	organizationID, err := common.ParseUID(createCommand.OrganizationID)
 	if err != nil {
 		return nil, err
 	}
 
-	game, err := entity.NewGame(createCommand.Name, userID)
+	userID, err := common.ParseUID(createCommand.UserID)
 	if err != nil {
 		return nil, err
 	}
 
+	deckID := deck_entity.DefaultDeckID
+	if createCommand.DeckID != "" {
+		deckID, err = common.ParseUID(createCommand.DeckID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = c.manager.Do(ctx, func(ctx context.Context) error {
-		err = c.storage.Create(ctx, game)
+		var deck deck_entity.Deck
+		deck, err = c.deckStorage.GetByID(ctx, deckID)
+		if err != nil {
+			return err
+		}
+
+		if !deck.CanUse(userID) {
+			return entity.ErrDeckNotAvailable
+		}
+
+		var owner user_entity.User
+		owner, err = c.userStorage.GetByID(ctx, userID)
 		if err != nil {
 			return err
 		}
 
-		err = c.mediator.Publish(ctx, game.Events()...)
+		var game entity.Game
+		game, err = entity.NewGame(
+			organizationID,
+			createCommand.Name,
+			userID,
+			owner.FullName().FirstName(),
+			deckID,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.Create(ctx, game)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const CreateJoinLinkKind = "CreateJoinLink"
+
+type CreateJoinLinkCommand struct {
+	GameID string
+	UserID string
+	TTL    time.Duration
+}
+
+func NewCreateJoinLinkCommand(gameID, userID string, ttl time.Duration) CreateJoinLinkCommand {
+	return CreateJoinLinkCommand{
+		GameID: gameID,
+		UserID: userID,
+		TTL:    ttl,
+	}
+}
+
+func (c CreateJoinLinkCommand) Type() core.CommandType {
+	return CreateJoinLinkKind
+}
+
+var _ core.Command = (*CreateJoinLinkCommand)(nil)
+
+type CreateJoinLink struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewCreateJoinLink(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) CreateJoinLink {
+	return CreateJoinLink{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (c CreateJoinLink) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(CreateJoinLinkCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var link entity.JoinLink
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		link, err = game.CreateJoinLink(userID, cmd.TTL)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.CreateJoinLink(ctx, link)
+		if err != nil {
+			return err
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.JoinLinkDTO{
+		Code:      link.Code(),
+		ExpiresAt: link.ExpiresAt().String(),
+	}, nil
+}
+
+var _ core.CommandHandler = (*CreateJoinLink)(nil)
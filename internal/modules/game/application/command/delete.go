@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const DeleteGameKind = "DeleteGame"
+
+type DeleteGameCommand struct {
+	GameID string
+	UserID string
+}
+
+func NewDeleteGameCommand(gameID, userID string) DeleteGameCommand {
+	return DeleteGameCommand{
+		GameID: gameID,
+		UserID: userID,
+	}
+}
+
+func (c DeleteGameCommand) Type() core.CommandType {
+	return DeleteGameKind
+}
+
+var _ core.Command = (*DeleteGameCommand)(nil)
+
+type DeleteGame struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewDeleteGame(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) DeleteGame {
+	return DeleteGame{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (c DeleteGame) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(DeleteGameCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = game.Delete(userID)
+		if err != nil {
+			return err
+		}
+
+		// Events are recorded first, the event store accepts only events of stored games
+		err = commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+		if err != nil {
+			return err
+		}
+
+		return c.storage.Delete(ctx, gameID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*DeleteGame)(nil)
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/aftercommit"
+)
+
+// snapshotInterval is how many game events are recorded between two snapshots.
+const snapshotInterval = 50
+
+// commitEvents appends the uncommitted game events to the event store, takes a
+// snapshot every snapshotInterval events and projects the game. A failed projection
+// rolls the events back, so the read models never drift. The events are published
+// once the transaction commits.
+func commitEvents(
+	ctx context.Context,
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	mediator ports.Mediator,
+	game *entity.Game,
+) error {
+	events := game.Events()
+	if len(events) == 0 {
+		return nil
+	}
+
+	version := game.Version()
+	expected := version - int64(len(events))
+	err := storage.AppendEvents(ctx, game.ID(), expected, events)
+	if err != nil {
+		return err
+	}
+
+	if !game.IsDeleted() && version/snapshotInterval != expected/snapshotInterval {
+		err = storage.SaveSnapshot(ctx, *game)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = projector.Project(ctx, game.ID())
+	if err != nil {
+		return err
+	}
+
+	// The rooms must not see events which are rolled back
+	return aftercommit.Run(ctx, func(ctx context.Context) error {
+		return mediator.Publish(ctx, events...)
+	})
+}
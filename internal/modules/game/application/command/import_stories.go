@@ -0,0 +1,117 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const ImportStoriesKind = "ImportStories"
+
+// ImportStoriesCommand carries the uploaded backlog file in CSV or JSON format.
+type ImportStoriesCommand struct {
+	GameID string
+	UserID string
+	Format string
+	Data   []byte
+}
+
+func NewImportStoriesCommand(gameID, userID, format string, data []byte) ImportStoriesCommand {
+	return ImportStoriesCommand{
+		GameID: gameID,
+		UserID: userID,
+		Format: format,
+		Data:   data,
+	}
+}
+
+func (c ImportStoriesCommand) Type() core.CommandType {
+	return ImportStoriesKind
+}
+
+var _ core.Command = (*ImportStoriesCommand)(nil)
+
+type ImportStories struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewImportStories(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) ImportStories {
+	return ImportStories{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+// Handle appends the uploaded stories to the game queue and returns the number of imported stories.
+// Invalid rows are reported all at once with entity.StoryImportError and nothing is imported.
+func (c ImportStories) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(ImportStoriesCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := parseStoryRows(cmd.Format, cmd.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	stories, err := newStories(gameID, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		stories, err = game.AddStories(userID, stories)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.AddStories(ctx, stories)
+		if err != nil {
+			return err
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return len(stories), nil
+}
+
+var _ core.CommandHandler = (*ImportStories)(nil)
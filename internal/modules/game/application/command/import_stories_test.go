@@ -0,0 +1,89 @@
+package command_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+func TestImportStoriesValidation(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	cases := []struct {
+		name   string
+		format string
+		data   string
+		fields []string
+	}{
+		{
+			name:   "CSV without title column",
+			format: command.StoriesFormatCSV,
+			data:   "key,summary\nPROJ-1,Login\n",
+			fields: []string{"file"},
+		},
+		{
+			name:   "CSV invalid rows",
+			format: command.StoriesFormatCSV,
+			data: "key,title,description,link\n" +
+				"PROJ-1,Login page,,https://tracker.local/PROJ-1\n" +
+				"PROJ-2,,,\n" +
+				"PROJ-3,Logout,,ftp://tracker.local/PROJ-3\n",
+			fields: []string{"title", "link"},
+		},
+		{
+			name:   "JSON invalid rows",
+			format: command.StoriesFormatJSON,
+			data: `[{"key":"PROJ-1","title":"Login"},{"title":42},` +
+				`{"key":"` + strings.Repeat("K", 40) + `","title":"Logout"}]`,
+			fields: []string{"row", "key"},
+		},
+		{
+			name:   "JSON empty",
+			format: command.StoriesFormatJSON,
+			data:   `[]`,
+			fields: []string{"file"},
+		},
+	}
+
+	handler := command.NewImportStories(nil, nil, nil, nil, log)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := command.NewImportStoriesCommand(
+				common.NewUID().String(),
+				common.NewUID().String(),
+				tc.format,
+				[]byte(tc.data),
+			)
+			_, err := handler.Handle(context.Background(), cmd)
+			require.ErrorIs(t, err, entity.ErrInvalidStories)
+
+			var importErr *entity.StoryImportError
+			require.ErrorAs(t, err, &importErr)
+
+			fields := make([]string, 0, len(importErr.Rows))
+			for _, row := range importErr.Rows {
+				fields = append(fields, row.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
+
+func TestImportStoriesUnsupportedFormat(t *testing.T) {
+	handler := command.NewImportStories(nil, nil, nil, nil, nil)
+	cmd := command.NewImportStoriesCommand(common.NewUID().String(), common.NewUID().String(), "xml", nil)
+
+	_, err := handler.Handle(context.Background(), cmd)
+	assert.ErrorIs(t, err, command.ErrUnsupportedStoriesFormat)
+}
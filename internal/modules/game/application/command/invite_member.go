@@ -0,0 +1,118 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	user_entity "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const InviteMemberKind = "InviteMember"
+
+type InviteMemberCommand struct {
+	GameID string
+	UserID string
+	Email  string
+}
+
+func NewInviteMemberCommand(gameID, userID, email string) InviteMemberCommand {
+	return InviteMemberCommand{
+		GameID: gameID,
+		UserID: userID,
+		Email:  email,
+	}
+}
+
+func (c InviteMemberCommand) Type() core.CommandType {
+	return InviteMemberKind
+}
+
+var _ core.Command = (*InviteMemberCommand)(nil)
+
+type InviteMember struct {
+	storage     ports.GamePgStorage
+	projector   ports.Projector
+	userStorage ports.UserViewStorage
+	manager     ports.TrManager
+	mediator    ports.Mediator
+	logger      logger.Logger
+}
+
+func NewInviteMember(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	userStorage ports.UserViewStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) InviteMember {
+	return InviteMember{
+		storage:     storage,
+		projector:   projector,
+		userStorage: userStorage,
+		manager:     manager,
+		mediator:    mediator,
+		logger:      logger,
+	}
+}
+
+func (c InviteMember) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(InviteMemberCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := common.NewEmail(cmd.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		var user user_entity.User
+		user, err = c.userStorage.GetByEmail(ctx, email)
+		if err != nil {
+			return err
+		}
+
+		err = game.InviteMember(userID, user.ID(), user.FullName().FirstName())
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.AddMember(ctx, gameID, user.ID(), entity.RoleVoter)
+		if err != nil {
+			return err
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*InviteMember)(nil)
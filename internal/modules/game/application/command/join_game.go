@@ -0,0 +1,112 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	user_entity "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const JoinGameKind = "JoinGame"
+
+type JoinGameCommand struct {
+	Code   string
+	UserID string
+}
+
+func NewJoinGameCommand(code, userID string) JoinGameCommand {
+	return JoinGameCommand{
+		Code:   code,
+		UserID: userID,
+	}
+}
+
+func (c JoinGameCommand) Type() core.CommandType {
+	return JoinGameKind
+}
+
+var _ core.Command = (*JoinGameCommand)(nil)
+
+type JoinGame struct {
+	storage     ports.GamePgStorage
+	projector   ports.Projector
+	userStorage ports.UserViewStorage
+	manager     ports.TrManager
+	mediator    ports.Mediator
+	logger      logger.Logger
+}
+
+func NewJoinGame(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	userStorage ports.UserViewStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) JoinGame {
+	return JoinGame{
+		storage:     storage,
+		projector:   projector,
+		userStorage: userStorage,
+		manager:     manager,
+		mediator:    mediator,
+		logger:      logger,
+	}
+}
+
+// Handle adds the user to the game of the join link and returns the game id.
+func (c JoinGame) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(JoinGameCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var game entity.Game
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var link entity.JoinLink
+		link, err = c.storage.GetJoinLink(ctx, cmd.Code)
+		if err != nil {
+			return err
+		}
+
+		game, err = c.storage.GetByID(ctx, link.GameID())
+		if err != nil {
+			return err
+		}
+
+		var user user_entity.User
+		user, err = c.userStorage.GetByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		err = game.Join(link, userID, user.FullName().FirstName())
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.AddMember(ctx, game.ID(), userID, entity.RoleVoter)
+		if err != nil {
+			return err
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return game.ID().String(), nil
+}
+
+var _ core.CommandHandler = (*JoinGame)(nil)
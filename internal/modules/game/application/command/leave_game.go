@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const LeaveGameKind = "LeaveGame"
+
+type LeaveGameCommand struct {
+	GameID string
+	UserID string
+}
+
+func NewLeaveGameCommand(gameID, userID string) LeaveGameCommand {
+	return LeaveGameCommand{
+		GameID: gameID,
+		UserID: userID,
+	}
+}
+
+func (c LeaveGameCommand) Type() core.CommandType {
+	return LeaveGameKind
+}
+
+var _ core.Command = (*LeaveGameCommand)(nil)
+
+type LeaveGame struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewLeaveGame(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) LeaveGame {
+	return LeaveGame{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (c LeaveGame) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(LeaveGameCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = game.Leave(userID)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.RemoveMember(ctx, gameID, userID)
+		if err != nil {
+			return err
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*LeaveGame)(nil)
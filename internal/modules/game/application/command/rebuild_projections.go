@@ -0,0 +1,64 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const RebuildProjectionsKind = "RebuildProjections"
+
+type RebuildProjectionsCommand struct{}
+
+func (c RebuildProjectionsCommand) Type() core.CommandType {
+	return RebuildProjectionsKind
+}
+
+var _ core.Command = (*RebuildProjectionsCommand)(nil)
+
+type RebuildProjections struct {
+	projections []ports.Projection
+	manager     ports.TrManager
+	logger      logger.Logger
+}
+
+func NewRebuildProjections(
+	projections []ports.Projection,
+	manager ports.TrManager,
+	logger logger.Logger,
+) RebuildProjections {
+	return RebuildProjections{
+		projections: projections,
+		manager:     manager,
+		logger:      logger,
+	}
+}
+
+// Handle recreates every read model in a single transaction, so readers keep
+// seeing the previous state until the rebuild is complete.
+func (c RebuildProjections) Handle(ctx context.Context, command core.Command) (any, error) {
+	if _, ok := command.(RebuildProjectionsCommand); !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	err := c.manager.Do(ctx, func(ctx context.Context) error {
+		for _, projection := range c.projections {
+			if err := projection.Rebuild(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*RebuildProjections)(nil)
@@ -0,0 +1,107 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const ResetRoundKind = "ResetRound"
+
+type ResetRoundCommand struct {
+	GameID string
+	UserID string
+}
+
+func NewResetRoundCommand(gameID, userID string) ResetRoundCommand {
+	return ResetRoundCommand{
+		GameID: gameID,
+		UserID: userID,
+	}
+}
+
+func (c ResetRoundCommand) Type() core.CommandType {
+	return ResetRoundKind
+}
+
+var _ core.Command = (*ResetRoundCommand)(nil)
+
+type ResetRound struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewResetRound(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) ResetRound {
+	return ResetRound{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (c ResetRound) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(ResetRoundCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = game.ResetRound(userID)
+		if err != nil {
+			return err
+		}
+
+		round, _ := game.CurrentRound()
+		err = c.storage.SaveRound(ctx, round)
+		if err != nil {
+			return err
+		}
+
+		err = scheduleRoundTimer(ctx, c.storage, round)
+		if err != nil {
+			return err
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*ResetRound)(nil)
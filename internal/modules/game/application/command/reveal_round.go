@@ -0,0 +1,129 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const RevealRoundKind = "RevealRound"
+
+type RevealRoundCommand struct {
+	GameID string
+	UserID string
+}
+
+func NewRevealRoundCommand(gameID, userID string) RevealRoundCommand {
+	return RevealRoundCommand{
+		GameID: gameID,
+		UserID: userID,
+	}
+}
+
+func (c RevealRoundCommand) Type() core.CommandType {
+	return RevealRoundKind
+}
+
+var _ core.Command = (*RevealRoundCommand)(nil)
+
+type RevealRound struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewRevealRound(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) RevealRound {
+	return RevealRound{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (c RevealRound) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(RevealRoundCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = game.RevealVotes(userID)
+		if err != nil {
+			return err
+		}
+
+		return saveRevealedRound(ctx, c.storage, c.projector, c.mediator, game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*RevealRound)(nil)
+
+// saveRevealedRound stores the revealed round with its estimation and publishes the game events.
+// It is shared by manual reveals and reveals triggered by the round timer.
+func saveRevealedRound(
+	ctx context.Context,
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	mediator ports.Mediator,
+	game entity.Game,
+) error {
+	round, _ := game.CurrentRound()
+	err := storage.SaveRound(ctx, round)
+	if err != nil {
+		return err
+	}
+
+	err = storage.CancelDeadlines(ctx, round.ID())
+	if err != nil {
+		return err
+	}
+
+	estimation, err := entity.NewEstimation(round, game.GameMembers())
+	if err != nil {
+		return err
+	}
+
+	err = storage.SaveEstimation(ctx, estimation)
+	if err != nil {
+		return err
+	}
+
+	return commitEvents(ctx, storage, projector, mediator, &game)
+}
@@ -0,0 +1,119 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const StartNextRoundKind = "StartNextRound"
+
+type StartNextRoundCommand struct {
+	GameID string
+	UserID string
+	Timer  time.Duration
+}
+
+func NewStartNextRoundCommand(gameID, userID string, timer time.Duration) StartNextRoundCommand {
+	return StartNextRoundCommand{
+		GameID: gameID,
+		UserID: userID,
+		Timer:  timer,
+	}
+}
+
+func (c StartNextRoundCommand) Type() core.CommandType {
+	return StartNextRoundKind
+}
+
+var _ core.Command = (*StartNextRoundCommand)(nil)
+
+type StartNextRound struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewStartNextRound(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) StartNextRound {
+	return StartNextRound{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+// Handle starts a round for the next pending story of the game queue and returns the round id.
+func (c StartNextRound) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(StartNextRoundCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var roundID string
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		var round entity.Round
+		var story entity.Story
+		round, story, err = game.StartNextRound(userID, cmd.Timer)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.SaveRound(ctx, round)
+		if err != nil {
+			return err
+		}
+
+		err = scheduleRoundTimer(ctx, c.storage, round)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.UpdateStory(ctx, story)
+		if err != nil {
+			return err
+		}
+
+		roundID = round.ID().String()
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roundID, nil
+}
+
+var _ core.CommandHandler = (*StartNextRound)(nil)
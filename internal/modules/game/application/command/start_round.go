@@ -0,0 +1,114 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const StartRoundKind = "StartRound"
+
+type StartRoundCommand struct {
+	GameID string
+	UserID string
+	Topic  string
+	Timer  time.Duration
+}
+
+func NewStartRoundCommand(gameID, userID, topic string, timer time.Duration) StartRoundCommand {
+	return StartRoundCommand{
+		GameID: gameID,
+		UserID: userID,
+		Topic:  topic,
+		Timer:  timer,
+	}
+}
+
+func (c StartRoundCommand) Type() core.CommandType {
+	return StartRoundKind
+}
+
+var _ core.Command = (*StartRoundCommand)(nil)
+
+type StartRound struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewStartRound(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) StartRound {
+	return StartRound{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (c StartRound) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(StartRoundCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var roundID string
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		var round entity.Round
+		round, err = game.StartRound(userID, cmd.Topic, cmd.Timer)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.SaveRound(ctx, round)
+		if err != nil {
+			return err
+		}
+
+		err = scheduleRoundTimer(ctx, c.storage, round)
+		if err != nil {
+			return err
+		}
+
+		roundID = round.ID().String()
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roundID, nil
+}
+
+var _ core.CommandHandler = (*StartRound)(nil)
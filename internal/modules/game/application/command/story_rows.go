@@ -0,0 +1,191 @@
+package command
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+)
+
+const (
+	StoriesFormatCSV  = "csv"
+	StoriesFormatJSON = "json"
+
+	maxImportedStories = 500
+)
+
+var ErrUnsupportedStoriesFormat = errors.New("unsupported stories format")
+
+// storyRow is a backlog row as it comes from the uploaded file. Rows are numbered from 1
+// not counting the CSV header. Err is set when the row cannot be decoded.
+type storyRow struct {
+	Row         int
+	Raw         string
+	Err         error
+	Key         string `json:"key"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Link        string `json:"link"`
+}
+
+// parseStoryRows decodes the uploaded backlog. Errors that make the whole file unreadable
+// are reported as row 0 errors.
+func parseStoryRows(format string, data []byte) ([]storyRow, error) {
+	var (
+		rows []storyRow
+		err  error
+	)
+
+	switch format {
+	case StoriesFormatCSV:
+		rows, err = parseStoryCSV(data)
+	case StoriesFormatJSON:
+		rows, err = parseStoryJSON(data)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedStoriesFormat, format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, fileError("", errors.New("file has no stories"))
+	}
+
+	if len(rows) > maxImportedStories {
+		return nil, fileError("", fmt.Errorf("file has more than %d stories", maxImportedStories))
+	}
+
+	return rows, nil
+}
+
+func parseStoryCSV(data []byte) ([]storyRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fileError("", errors.New("file is empty"))
+	}
+
+	if err != nil {
+		return nil, fileError("", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	if _, ok := columns["title"]; !ok {
+		return nil, fileError(strings.Join(header, ","), errors.New("header has no title column"))
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return record[i]
+	}
+
+	rows := make([]storyRow, 0)
+	for n := 1; ; n++ {
+		var record []string
+		record, err = reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			rows = append(rows, storyRow{Row: n, Raw: strings.Join(record, ","), Err: err})
+
+			continue
+		}
+
+		rows = append(rows, storyRow{
+			Row:         n,
+			Key:         field(record, "key"),
+			Title:       field(record, "title"),
+			Description: field(record, "description"),
+			Link:        field(record, "link"),
+		})
+	}
+
+	return rows, nil
+}
+
+func parseStoryJSON(data []byte) ([]storyRow, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fileError("", err)
+	}
+
+	rows := make([]storyRow, 0, len(raw))
+	for i, item := range raw {
+		row := storyRow{}
+		if err := json.Unmarshal(item, &row); err != nil {
+			row = storyRow{Raw: string(item), Err: err}
+		}
+
+		row.Row = i + 1
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// newStories validates every row and collects all invalid ones at once.
+func newStories(gameID common.UID, rows []storyRow) ([]entity.Story, error) {
+	stories := make([]entity.Story, 0, len(rows))
+	var rowErrors []entity.StoryRowError
+	for _, row := range rows {
+		if row.Err != nil {
+			rowErrors = append(rowErrors, entity.StoryRowError{Row: row.Row, Field: "row", Value: row.Raw, Err: row.Err})
+
+			continue
+		}
+
+		story, err := entity.NewStory(gameID, row.Key, row.Title, row.Description, row.Link)
+		if err != nil {
+			field, value := storyField(row, err)
+			rowErrors = append(rowErrors, entity.StoryRowError{Row: row.Row, Field: field, Value: value, Err: err})
+
+			continue
+		}
+
+		stories = append(stories, story)
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, &entity.StoryImportError{Rows: rowErrors}
+	}
+
+	return stories, nil
+}
+
+func storyField(row storyRow, err error) (string, string) {
+	switch {
+	case errors.Is(err, entity.ErrStoryKeyTooLong):
+		return "key", row.Key
+	case errors.Is(err, entity.ErrStoryDescriptionLong):
+		return "description", row.Description
+	case errors.Is(err, entity.ErrInvalidStoryLink):
+		return "link", row.Link
+	default:
+		return "title", row.Title
+	}
+}
+
+func fileError(value string, err error) error {
+	return &entity.StoryImportError{Rows: []entity.StoryRowError{{Row: 0, Field: "file", Value: value, Err: err}}}
+}
@@ -0,0 +1,118 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const TransferOwnershipKind = "TransferOwnership"
+
+type TransferOwnershipCommand struct {
+	GameID   string
+	UserID   string
+	MemberID string
+}
+
+func NewTransferOwnershipCommand(gameID, userID, memberID string) TransferOwnershipCommand {
+	return TransferOwnershipCommand{
+		GameID:   gameID,
+		UserID:   userID,
+		MemberID: memberID,
+	}
+}
+
+func (c TransferOwnershipCommand) Type() core.CommandType {
+	return TransferOwnershipKind
+}
+
+var _ core.Command = (*TransferOwnershipCommand)(nil)
+
+type TransferOwnership struct {
+	storage   ports.GamePgStorage
+	projector ports.Projector
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewTransferOwnership(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) TransferOwnership {
+	return TransferOwnership{
+		storage:   storage,
+		projector: projector,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (c TransferOwnership) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(TransferOwnershipCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := common.ParseUID(cmd.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = game.TransferOwnership(userID, memberID)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.Update(ctx, game)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.UpdateMemberRole(ctx, gameID, userID, entity.RoleFacilitator)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.UpdateMemberRole(ctx, gameID, memberID, entity.RoleOwner)
+		if err != nil {
+			return err
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*TransferOwnership)(nil)
@@ -0,0 +1,133 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	deck_entity "github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const UpdateGameKind = "UpdateGame"
+
+// UpdateGameCommand changes the game attributes. Nil fields are left as is.
+type UpdateGameCommand struct {
+	GameID string
+	UserID string
+	Name   *string
+	DeckID *string
+}
+
+func NewUpdateGameCommand(gameID, userID string, name, deckID *string) UpdateGameCommand {
+	return UpdateGameCommand{
+		GameID: gameID,
+		UserID: userID,
+		Name:   name,
+		DeckID: deckID,
+	}
+}
+
+func (c UpdateGameCommand) Type() core.CommandType {
+	return UpdateGameKind
+}
+
+var _ core.Command = (*UpdateGameCommand)(nil)
+
+type UpdateGame struct {
+	storage     ports.GamePgStorage
+	projector   ports.Projector
+	deckStorage ports.DeckViewStorage
+	manager     ports.TrManager
+	mediator    ports.Mediator
+	logger      logger.Logger
+}
+
+func NewUpdateGame(
+	storage ports.GamePgStorage,
+	projector ports.Projector,
+	deckStorage ports.DeckViewStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) UpdateGame {
+	return UpdateGame{
+		storage:     storage,
+		projector:   projector,
+		deckStorage: deckStorage,
+		manager:     manager,
+		mediator:    mediator,
+		logger:      logger,
+	}
+}
+
+func (c UpdateGame) Handle(ctx context.Context, command core.Command) (any, error) {
+	cmd, ok := command.(UpdateGameCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	gameID, err := common.ParseUID(cmd.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var game entity.Game
+		game, err = c.storage.GetByID(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		name := game.Name()
+		if cmd.Name != nil {
+			name = *cmd.Name
+		}
+
+		deckID := game.DeckID()
+		if cmd.DeckID != nil {
+			deckID, err = common.ParseUID(*cmd.DeckID)
+			if err != nil {
+				return err
+			}
+
+			var deck deck_entity.Deck
+			deck, err = c.deckStorage.GetByID(ctx, deckID)
+			if err != nil {
+				return err
+			}
+
+			if !deck.CanUse(userID) {
+				return entity.ErrDeckNotAvailable
+			}
+		}
+
+		err = game.Update(userID, name, deckID)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.Update(ctx, game)
+		if err != nil {
+			return err
+		}
+
+		return commitEvents(ctx, c.storage, c.projector, c.mediator, &game)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*UpdateGame)(nil)
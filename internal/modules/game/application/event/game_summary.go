@@ -0,0 +1,134 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+// rebuildBatchSize is how many games are projected per batch during the rebuild.
+const rebuildBatchSize = 100
+
+// GameSummaryProjector keeps the game list read model up to date. Every commit of
+// game events recomputes the summary from the game state, so a game can be projected
+// any number of times and the summary always converges to the event log.
+type GameSummaryProjector struct {
+	gameStorage    ports.GamePgStorage
+	summaryStorage ports.GameSummaryStorage
+	userStorage    ports.UserViewStorage
+	logger         logger.Logger
+}
+
+func NewGameSummaryProjector(
+	gameStorage ports.GamePgStorage,
+	summaryStorage ports.GameSummaryStorage,
+	userStorage ports.UserViewStorage,
+	logger logger.Logger,
+) *GameSummaryProjector {
+	return &GameSummaryProjector{
+		gameStorage:    gameStorage,
+		summaryStorage: summaryStorage,
+		userStorage:    userStorage,
+		logger:         logger,
+	}
+}
+
+// Project recomputes the summary of the game, the summary of a deleted game is dropped.
+func (p *GameSummaryProjector) Project(ctx context.Context, gameID common.UID) error {
+	game, err := p.gameStorage.GetByID(ctx, gameID)
+	if errors.Is(err, core.ErrNotFound) {
+		return p.summaryStorage.Delete(ctx, gameID)
+	}
+	if err != nil {
+		return err
+	}
+
+	ownerName, err := p.ownerName(ctx, game)
+	if err != nil {
+		return err
+	}
+
+	summary := ports.GameSummary{
+		GameID:         game.ID(),
+		OrganizationID: game.OrganizationID(),
+		Name:           game.Name(),
+		OwnerID:        game.OwnerID(),
+		OwnerName:      ownerName,
+		MemberCount:    len(game.GameMembers()),
+		ArchivedAt:     game.ArchivedAt(),
+		CreatedAt:      game.CreatedAt(),
+		UpdatedAt:      game.UpdatedAt(),
+	}
+	if round, ok := game.CurrentRound(); ok {
+		summary.RoundStatus = round.Status().String()
+	}
+
+	p.logger.Debugf("Project summary of game %s", gameID)
+
+	return p.summaryStorage.Save(ctx, summary)
+}
+
+// Rebuild recreates the summaries of all games from scratch.
+func (p *GameSummaryProjector) Rebuild(ctx context.Context) error {
+	if err := p.summaryStorage.Clear(ctx); err != nil {
+		return err
+	}
+
+	var projected int
+	afterID := ""
+	for {
+		ids, err := p.summaryStorage.FetchGameIDs(ctx, afterID, rebuildBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			if err = p.Project(ctx, id); err != nil {
+				return fmt.Errorf("project game %s: %w", id, err)
+			}
+		}
+
+		projected += len(ids)
+		if len(ids) < rebuildBatchSize {
+			break
+		}
+		afterID = ids[len(ids)-1].String()
+	}
+
+	p.logger.Infof("Game summaries are rebuilt, %d games processed", projected)
+
+	return nil
+}
+
+// ownerName reuses the stored owner name while the owner stays the same, so the
+// owner is looked up only when the game is created or transferred.
+func (p *GameSummaryProjector) ownerName(ctx context.Context, game entity.Game) (string, error) {
+	summary, err := p.summaryStorage.GetByID(ctx, game.ID())
+	if err != nil && !errors.Is(err, core.ErrNotFound) {
+		return "", err
+	}
+	if err == nil && summary.OwnerID == game.OwnerID() {
+		return summary.OwnerName, nil
+	}
+
+	owner, err := p.userStorage.GetByID(ctx, game.OwnerID())
+	if errors.Is(err, core.ErrNotFound) {
+		// The owner has left the organization, keep the name known by the game
+		for _, member := range game.GameMembers() {
+			if member.UserID() == game.OwnerID() {
+				return member.Name(), nil
+			}
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("get owner of game %s: %w", game.ID(), err)
+	}
+
+	return owner.FullName().String(), nil
+}
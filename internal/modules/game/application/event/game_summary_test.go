@@ -0,0 +1,138 @@
+package event_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/event"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	user_entity "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+type gameStorageStub struct {
+	ports.GamePgStorage
+	games map[common.UID]entity.Game
+}
+
+func (s *gameStorageStub) GetByID(_ context.Context, id common.UID) (entity.Game, error) {
+	game, ok := s.games[id]
+	if !ok || game.IsDeleted() {
+		return entity.Game{}, core.ErrNotFound
+	}
+
+	return game, nil
+}
+
+type summaryStorageStub struct {
+	summaries map[common.UID]ports.GameSummary
+}
+
+func (s *summaryStorageStub) Fetch(_ context.Context, _, _ int64, _ bool) ([]ports.GameSummary, error) {
+	return nil, nil
+}
+
+func (s *summaryStorageStub) GetByID(_ context.Context, gameID common.UID) (ports.GameSummary, error) {
+	summary, ok := s.summaries[gameID]
+	if !ok {
+		return ports.GameSummary{}, core.ErrNotFound
+	}
+
+	return summary, nil
+}
+
+func (s *summaryStorageStub) Save(_ context.Context, summary ports.GameSummary) error {
+	s.summaries[summary.GameID] = summary
+	return nil
+}
+
+func (s *summaryStorageStub) Delete(_ context.Context, gameID common.UID) error {
+	delete(s.summaries, gameID)
+	return nil
+}
+
+func (s *summaryStorageStub) Clear(_ context.Context) error {
+	s.summaries = map[common.UID]ports.GameSummary{}
+	return nil
+}
+
+func (s *summaryStorageStub) FetchGameIDs(_ context.Context, _ string, _ int64) ([]common.UID, error) {
+	return nil, nil
+}
+
+type userStorageStub struct {
+	ports.UserViewStorage
+	lookups int
+}
+
+func (s *userStorageStub) GetByID(_ context.Context, id common.UID) (user_entity.User, error) {
+	s.lookups++
+
+	fullName, err := vo.NewFullName("John", "Smith", "")
+	if err != nil {
+		return user_entity.User{}, err
+	}
+
+	return user_entity.Hydrate(id, fullName, common.Email{}, "", time.Now(), time.Now()), nil
+}
+
+type deckMock struct{}
+
+func (deckMock) HasCard(_ string) bool {
+	return true
+}
+
+func TestGameSummaryProjector(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	ownerID := common.NewUID()
+	memberID := common.NewUID()
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "John", common.NewUID())
+	require.NoError(t, err)
+
+	games := &gameStorageStub{games: map[common.UID]entity.Game{game.ID(): game}}
+	summaries := &summaryStorageStub{summaries: map[common.UID]ports.GameSummary{}}
+	users := &userStorageStub{}
+	projector := event.NewGameSummaryProjector(games, summaries, users, log)
+
+	require.NoError(t, projector.Project(context.Background(), game.ID()))
+
+	summary, ok := summaries.summaries[game.ID()]
+	require.True(t, ok)
+	assert.Equal(t, "Sprint 42", summary.Name)
+	assert.Equal(t, "Smith John ", summary.OwnerName)
+	assert.Equal(t, 1, summary.MemberCount)
+	assert.Empty(t, summary.RoundStatus)
+
+	require.NoError(t, game.AddMember(memberID, "Bob"))
+	_, err = game.StartRound(ownerID, "Login page", 0)
+	require.NoError(t, err)
+	require.NoError(t, game.CastVote(memberID, "5", deckMock{}))
+	games.games[game.ID()] = game
+
+	// Projecting the same game again gives the same summary
+	for range 2 {
+		require.NoError(t, projector.Project(context.Background(), game.ID()))
+	}
+
+	summary = summaries.summaries[game.ID()]
+	assert.Equal(t, 2, summary.MemberCount)
+	assert.Equal(t, entity.RoundVoting.String(), summary.RoundStatus)
+	assert.Equal(t, 1, users.lookups)
+
+	require.NoError(t, game.Delete(ownerID))
+	games.games[game.ID()] = game
+	require.NoError(t, projector.Project(context.Background(), game.ID()))
+	assert.Empty(t, summaries.summaries)
+}
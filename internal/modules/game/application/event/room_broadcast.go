@@ -0,0 +1,46 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/event"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+// RoomBroadcast forwards game domain events to the clients of the game room. The
+// events recorded by commands arrive after their transaction commits.
+type RoomBroadcast struct {
+	broadcaster ports.RoomBroadcaster
+	logger      logger.Logger
+}
+
+func NewRoomBroadcast(broadcaster ports.RoomBroadcaster, logger logger.Logger) *RoomBroadcast {
+	return &RoomBroadcast{
+		broadcaster: broadcaster,
+		logger:      logger,
+	}
+}
+
+func (r *RoomBroadcast) Handle(ctx context.Context, e mediator.Event) error {
+	roomEvent, ok := e.(event.RoomEvent)
+	if !ok {
+		return fmt.Errorf("unknown type of event %T", e)
+	}
+
+	payload, err := json.Marshal(dto.RoomMessageDTO{
+		Type: roomEvent.Kind(),
+		Data: roomEvent,
+	})
+	if err != nil {
+		return err
+	}
+
+	r.logger.Debugf("Broadcast %s event to game room %s", roomEvent.Kind(), roomEvent.Room())
+
+	return r.broadcaster.Publish(ctx, roomEvent.Room(), payload)
+}
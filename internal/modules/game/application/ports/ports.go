@@ -2,8 +2,10 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/KyKyPy3/clean/internal/domain/common"
+	deck_entity "github.com/KyKyPy3/clean/internal/modules/deck/domain/entity"
 	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
 	user_entity "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
 	"github.com/KyKyPy3/clean/pkg/mediator"
@@ -17,12 +19,115 @@ type TrManager interface {
 	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
 }
 
+// GamePgStorage keeps games and their event store. Every query is limited to
+// the games of the organization the context is scoped to with pkg/tenant.
 type GamePgStorage interface {
-	Fetch(ctx context.Context, limit, offset int64) ([]entity.Game, error)
+	// GetByID rebuilds the game from its snapshot and the events recorded after it.
 	GetByID(ctx context.Context, id common.UID) (entity.Game, error)
+	// AppendEvents records the game events after the expected version. It fails with
+	// core.ErrConcurrentModification when other events were recorded in the meantime.
+	AppendEvents(ctx context.Context, gameID common.UID, expectedVersion int64, events []mediator.Event) error
+	SaveSnapshot(ctx context.Context, game entity.Game) error
+	// FetchUntrackedGameIDs returns the games created before the event store, which have no snapshot yet.
+	FetchUntrackedGameIDs(ctx context.Context, afterID string, limit int64) ([]common.UID, error)
 	Create(ctx context.Context, registration entity.Game) error
+	Update(ctx context.Context, game entity.Game) error
+	Delete(ctx context.Context, id common.UID) error
+	SaveRound(ctx context.Context, round entity.Round) error
+	AddStories(ctx context.Context, stories []entity.Story) error
+	UpdateStory(ctx context.Context, story entity.Story) error
+	SaveEstimation(ctx context.Context, estimation entity.Estimation) error
+	CloseEstimation(ctx context.Context, roundID common.UID, estimate string) error
+	FetchEstimations(ctx context.Context, gameID common.UID, limit, offset int64) ([]entity.Estimation, error)
+	CountEstimations(ctx context.Context, gameID common.UID) (int64, error)
+	GetLatestEstimation(ctx context.Context, roundID common.UID) (entity.Estimation, error)
+	ScheduleDeadline(ctx context.Context, deadline entity.Deadline) error
+	CancelDeadlines(ctx context.Context, roundID common.UID) error
+	DeleteDeadline(ctx context.Context, id common.UID) error
+	// LockDeadlines must be called inside a transaction, the lock is released when it ends.
+	LockDeadlines(ctx context.Context) (bool, error)
+	FetchDueDeadlines(ctx context.Context, now time.Time, limit int64) ([]entity.Deadline, error)
+	AddMember(ctx context.Context, gameID, userID common.UID, role entity.Role) error
+	UpdateMemberRole(ctx context.Context, gameID, userID common.UID, role entity.Role) error
+	RemoveMember(ctx context.Context, gameID, userID common.UID) error
+	CreateJoinLink(ctx context.Context, link entity.JoinLink) error
+	GetJoinLink(ctx context.Context, code string) (entity.JoinLink, error)
+}
+
+// GameSummary is the denormalized game list entry kept up to date from game events.
+type GameSummary struct {
+	GameID         common.UID
+	OrganizationID common.UID
+	Name           string
+	OwnerID        common.UID
+	OwnerName      string
+	MemberCount    int
+	// RoundStatus is empty until the first round is started.
+	RoundStatus    string
+	ArchivedAt     *time.Time
+	LastActivityAt time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type GameSummaryStorage interface {
+	Fetch(ctx context.Context, limit, offset int64, withArchived bool) ([]GameSummary, error)
+	GetByID(ctx context.Context, gameID common.UID) (GameSummary, error)
+	// Save upserts the summary. Its last activity is set to the date of the latest game event.
+	Save(ctx context.Context, summary GameSummary) error
+	Delete(ctx context.Context, gameID common.UID) error
+	Clear(ctx context.Context) error
+	// FetchGameIDs returns ids of the stored games, ordered by id and starting
+	// after the given one.
+	FetchGameIDs(ctx context.Context, afterID string, limit int64) ([]common.UID, error)
+}
+
+// Projector updates the read models of the game in the transaction recording its events.
+type Projector interface {
+	Project(ctx context.Context, gameID common.UID) error
+}
+
+// Projection is a read model built from game events.
+type Projection interface {
+	// Rebuild recreates the read model from scratch.
+	Rebuild(ctx context.Context) error
 }
 
 type UserViewStorage interface {
 	GetByID(ctx context.Context, id common.UID) (user_entity.User, error)
+	GetByEmail(ctx context.Context, email common.Email) (user_entity.User, error)
+}
+
+type DeckViewStorage interface {
+	GetByID(ctx context.Context, id common.UID) (deck_entity.Deck, error)
+}
+
+// RoomBroadcaster delivers serialized room messages to every instance serving the game room.
+type RoomBroadcaster interface {
+	Publish(ctx context.Context, gameID string, payload []byte) error
+}
+
+// RoomMessage is a room message with its sequence number in the game event log.
+type RoomMessage struct {
+	ID      int64
+	Payload []byte
+}
+
+type Room interface {
+	RoomBroadcaster
+	Subscribe(gameID string) (<-chan RoomMessage, func())
+	// Replay returns logged messages after the given id. The flag is false when
+	// some of them have already been evicted from the bounded log.
+	Replay(ctx context.Context, gameID string, afterID int64) ([]RoomMessage, bool, error)
+}
+
+// PresenceStorage keeps the live connections of game rooms. A connection expires
+// unless it is touched again within the ttl.
+type PresenceStorage interface {
+	Touch(ctx context.Context, gameID, userID, connID string, ttl time.Duration) error
+	Remove(ctx context.Context, gameID, userID, connID string) error
+	// Online returns the ids of users with at least one live connection.
+	Online(ctx context.Context, gameID string) ([]string, error)
+	// Prune drops expired connections and returns the ids of their users.
+	Prune(ctx context.Context, gameID string) ([]string, error)
 }
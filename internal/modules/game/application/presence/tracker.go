@@ -0,0 +1,137 @@
+package presence
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/event"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const (
+	// ConnectionTTL is how long a connection stays online without a heartbeat.
+	ConnectionTTL     = 30 * time.Second
+	HeartbeatInterval = 10 * time.Second
+	leaveTimeout      = 5 * time.Second
+)
+
+// Tracker marks game room connections online while they are open and publishes
+// MemberOnline and MemberOffline events when a user gets the first connection
+// or loses the last one. Connections of crashed instances expire and are
+// reported offline by the heartbeats of other connections of the room.
+type Tracker struct {
+	storage  ports.PresenceStorage
+	mediator ports.Mediator
+	logger   logger.Logger
+}
+
+func NewTracker(storage ports.PresenceStorage, mediator ports.Mediator, logger logger.Logger) *Tracker {
+	return &Tracker{
+		storage:  storage,
+		mediator: mediator,
+		logger:   logger,
+	}
+}
+
+// Track keeps the user online in the game room until the returned function is called.
+func (t *Tracker) Track(ctx context.Context, gameID, userID string) func() {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	connID := common.NewUID().String()
+
+	if err := t.Join(ctx, gameID, userID, connID); err != nil {
+		t.logger.Errorf("can't mark user %s online in game %s, err: %v", userID, gameID, err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(HeartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := t.Heartbeat(ctx, gameID, userID, connID); err != nil {
+					t.logger.Errorf("can't refresh presence of user %s in game %s, err: %v", userID, gameID, err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+
+		leaveCtx, leaveCancel := context.WithTimeout(context.WithoutCancel(ctx), leaveTimeout)
+		defer leaveCancel()
+
+		if err := t.Leave(leaveCtx, gameID, userID, connID); err != nil {
+			t.logger.Errorf("can't mark user %s offline in game %s, err: %v", userID, gameID, err)
+		}
+	}
+}
+
+// Join registers the connection and reports the user online if it is the first one.
+func (t *Tracker) Join(ctx context.Context, gameID, userID, connID string) error {
+	online, err := t.storage.Online(ctx, gameID)
+	if err != nil {
+		return err
+	}
+
+	if err = t.storage.Touch(ctx, gameID, userID, connID, ConnectionTTL); err != nil {
+		return err
+	}
+
+	if slices.Contains(online, userID) {
+		return nil
+	}
+
+	return t.mediator.Publish(ctx, event.MemberOnlineEvent{GameID: gameID, UserID: userID})
+}
+
+// Heartbeat extends the connection ttl and reports users whose connections have all expired.
+func (t *Tracker) Heartbeat(ctx context.Context, gameID, userID, connID string) error {
+	if err := t.storage.Touch(ctx, gameID, userID, connID, ConnectionTTL); err != nil {
+		return err
+	}
+
+	stale, err := t.storage.Prune(ctx, gameID)
+	if err != nil || len(stale) == 0 {
+		return err
+	}
+
+	return t.publishOffline(ctx, gameID, stale)
+}
+
+// Leave drops the connection and reports the user offline if it was the last one.
+func (t *Tracker) Leave(ctx context.Context, gameID, userID, connID string) error {
+	if err := t.storage.Remove(ctx, gameID, userID, connID); err != nil {
+		return err
+	}
+
+	return t.publishOffline(ctx, gameID, []string{userID})
+}
+
+func (t *Tracker) publishOffline(ctx context.Context, gameID string, users []string) error {
+	online, err := t.storage.Online(ctx, gameID)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range users {
+		if slices.Contains(online, userID) {
+			continue
+		}
+
+		if err = t.mediator.Publish(ctx, event.MemberOfflineEvent{GameID: gameID, UserID: userID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
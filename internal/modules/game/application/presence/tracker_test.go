@@ -0,0 +1,103 @@
+package presence_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/modules/game/application/presence"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/event"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+const gameID = "6f2b0c1e-4a52-4bd8-9e1a-8f4f3f1b2c3d"
+
+type storageStub struct {
+	conns map[string]string
+	stale map[string]string
+}
+
+func (s *storageStub) Touch(_ context.Context, _, userID, connID string, _ time.Duration) error {
+	s.conns[connID] = userID
+	return nil
+}
+
+func (s *storageStub) Remove(_ context.Context, _, _, connID string) error {
+	delete(s.conns, connID)
+	return nil
+}
+
+func (s *storageStub) Online(_ context.Context, _ string) ([]string, error) {
+	users := make([]string, 0, len(s.conns))
+	for _, userID := range s.conns {
+		users = append(users, userID)
+	}
+	slices.Sort(users)
+
+	return slices.Compact(users), nil
+}
+
+func (s *storageStub) Prune(_ context.Context, _ string) ([]string, error) {
+	users := make([]string, 0, len(s.stale))
+	for _, userID := range s.stale {
+		users = append(users, userID)
+	}
+	s.stale = map[string]string{}
+
+	return users, nil
+}
+
+type mediatorStub struct {
+	events []mediator.Event
+}
+
+func (m *mediatorStub) Publish(_ context.Context, events ...mediator.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
+func TestTracker(t *testing.T) {
+	ctx := context.Background()
+
+	log := logger.NewLogger(logger.Config{Mode: "test"})
+	log.Init()
+
+	storage := &storageStub{conns: map[string]string{}, stale: map[string]string{}}
+	events := &mediatorStub{}
+	tracker := presence.NewTracker(storage, events, log)
+
+	require.NoError(t, tracker.Join(ctx, gameID, "alice", "tab-1"))
+	require.NoError(t, tracker.Join(ctx, gameID, "alice", "tab-2"))
+	require.NoError(t, tracker.Join(ctx, gameID, "bob", "tab-3"))
+	assert.Equal(t, []mediator.Event{
+		event.MemberOnlineEvent{GameID: gameID, UserID: "alice"},
+		event.MemberOnlineEvent{GameID: gameID, UserID: "bob"},
+	}, events.events)
+
+	// Second tab of the same user is still open
+	events.events = nil
+	require.NoError(t, tracker.Leave(ctx, gameID, "alice", "tab-1"))
+	assert.Empty(t, events.events)
+
+	// Connection of a crashed instance has expired
+	delete(storage.conns, "tab-3")
+	storage.stale["tab-3"] = "bob"
+	require.NoError(t, tracker.Heartbeat(ctx, gameID, "alice", "tab-2"))
+	assert.Equal(t, []mediator.Event{
+		event.MemberOfflineEvent{GameID: gameID, UserID: "bob"},
+	}, events.events)
+
+	events.events = nil
+	leave := tracker.Track(ctx, gameID, "carol")
+	assert.Equal(t, []mediator.Event{
+		event.MemberOnlineEvent{GameID: gameID, UserID: "carol"},
+	}, events.events)
+
+	leave()
+	assert.Equal(t, event.MemberOfflineEvent{GameID: gameID, UserID: "carol"}, events.events[1])
+}
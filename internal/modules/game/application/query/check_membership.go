@@ -0,0 +1,68 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const CheckMembershipKind = "CheckMembership"
+
+type CheckMembershipQuery struct {
+	GameID string
+	UserID string
+	Guest  bool
+}
+
+func (c CheckMembershipQuery) Type() core.QueryType {
+	return CheckMembershipKind
+}
+
+var _ core.Query = (*CheckMembershipQuery)(nil)
+
+type CheckMembership struct {
+	gameStorage ports.GamePgStorage
+	logger      logger.Logger
+}
+
+func NewCheckMembership(gameStorage ports.GamePgStorage, logger logger.Logger) CheckMembership {
+	return CheckMembership{
+		gameStorage: gameStorage,
+		logger:      logger,
+	}
+}
+
+// Handle returns ErrNotGameMember when the user has no access to the game.
+// Guests are admitted to the game their token is scoped to.
+func (c CheckMembership) Handle(ctx context.Context, query core.Query) (any, error) {
+	checkQuery, ok := query.(CheckMembershipQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	gameID, err := common.ParseUID(checkQuery.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(checkQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := c.gameStorage.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !checkQuery.Guest && !game.IsMember(userID) {
+		return nil, entity.ErrNotGameMember
+	}
+
+	return true, nil
+}
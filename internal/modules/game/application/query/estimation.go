@@ -0,0 +1,44 @@
+package query
+
+import (
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+)
+
+func estimationToDTO(estimation entity.Estimation) dto.EstimationDTO {
+	votes := make([]dto.VoteDTO, 0, len(estimation.Votes()))
+	for _, vote := range estimation.Votes() {
+		votes = append(votes, dto.VoteDTO{
+			UserID: vote.UserID().String(),
+			Name:   vote.Name(),
+			Voted:  true,
+			Value:  vote.Value(),
+		})
+	}
+
+	return dto.EstimationDTO{
+		ID:         estimation.ID().String(),
+		RoundID:    estimation.RoundID().String(),
+		Topic:      estimation.Topic(),
+		Estimate:   estimation.Estimate(),
+		Votes:      votes,
+		Stats:      statsToDTO(estimation.Stats()),
+		RevealedAt: estimation.RevealedAt().String(),
+	}
+}
+
+func statsToDTO(stats entity.Stats) dto.StatsDTO {
+	res := dto.StatsDTO{
+		Votes:     stats.Votes,
+		Mode:      stats.Mode,
+		Consensus: stats.Consensus,
+	}
+
+	if stats.Numeric > 0 {
+		res.Average = &stats.Average
+		res.Median = &stats.Median
+		res.Spread = &stats.Spread
+	}
+
+	return res
+}
@@ -0,0 +1,93 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const (
+	ExportGameKind = "ExportGame"
+	exportPageSize = 100
+)
+
+type ExportGameQuery struct {
+	GameID string
+	UserID string
+}
+
+func (e ExportGameQuery) Type() core.QueryType {
+	return ExportGameKind
+}
+
+var _ core.Query = (*ExportGameQuery)(nil)
+
+type ExportGame struct {
+	gameStorage ports.GamePgStorage
+	logger      logger.Logger
+}
+
+func NewExportGame(gameStorage ports.GamePgStorage, logger logger.Logger) ExportGame {
+	return ExportGame{
+		gameStorage: gameStorage,
+		logger:      logger,
+	}
+}
+
+// Handle returns the whole game session with every revealed round.
+func (e ExportGame) Handle(ctx context.Context, query core.Query) (any, error) {
+	exportQuery, ok := query.(ExportGameQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	gameID, err := common.ParseUID(exportQuery.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(exportQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := e.gameStorage.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !game.IsMember(userID) {
+		return nil, entity.ErrNotGameMember
+	}
+
+	items := make([]dto.EstimationDTO, 0)
+	for offset := int64(0); ; offset += exportPageSize {
+		var estimations []entity.Estimation
+		estimations, err = e.gameStorage.FetchEstimations(ctx, gameID, exportPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("fetch estimations error: %w", err)
+		}
+
+		for _, estimation := range estimations {
+			items = append(items, estimationToDTO(estimation))
+		}
+
+		if len(estimations) < exportPageSize {
+			break
+		}
+	}
+
+	return dto.GameExportDTO{
+		ID:          game.ID().String(),
+		Name:        game.Name(),
+		ExportedAt:  time.Now().UTC().String(),
+		Estimations: items,
+	}, nil
+}
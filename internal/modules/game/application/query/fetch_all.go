@@ -15,6 +15,8 @@ const FetchGamesKind = "FetchGames"
 type FetchGamesQuery struct {
 	Limit  int64
 	Offset int64
+	// Archived includes archived games into the result.
+	Archived bool
 }
 
 func (f FetchGamesQuery) Type() core.QueryType {
@@ -24,47 +26,44 @@ func (f FetchGamesQuery) Type() core.QueryType {
 var _ core.Query = (*FetchGamesQuery)(nil)
 
 type FetchGames struct {
-	gameStorage ports.GamePgStorage
-	userStorage ports.UserViewStorage
-	logger      logger.Logger
+	summaryStorage ports.GameSummaryStorage
+	logger         logger.Logger
 }
 
 func NewFetchGames(
-	gameStorage ports.GamePgStorage,
-	userStorage ports.UserViewStorage,
+	summaryStorage ports.GameSummaryStorage,
 	logger logger.Logger,
 ) FetchGames {
 	return FetchGames{
-		gameStorage: gameStorage,
-		userStorage: userStorage,
-		logger:      logger,
+		summaryStorage: summaryStorage,
+		logger:         logger,
 	}
 }
 
+// Handle reads the games from the summary read model only.
 func (f FetchGames) Handle(ctx context.Context, query core.Query) (any, error) {
 	fetchQuery, ok := query.(FetchGamesQuery)
 	if !ok {
 		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
 	}
 
-	games, fetchErr := f.gameStorage.Fetch(ctx, fetchQuery.Limit, fetchQuery.Offset)
+	summaries, fetchErr := f.summaryStorage.Fetch(ctx, fetchQuery.Limit, fetchQuery.Offset, fetchQuery.Archived)
 	if fetchErr != nil {
 		return nil, fmt.Errorf("fetch games error: %w", fetchErr)
 	}
 
-	gamesDto := make([]dto.GameShortDTO, 0, len(games))
-	for _, game := range games {
-		user, userErr := f.userStorage.GetByID(ctx, game.OwnerID())
-		if userErr != nil {
-			return nil, fmt.Errorf("get user error for game %s: %w", game.ID(), userErr)
-		}
-
+	gamesDto := make([]dto.GameShortDTO, 0, len(summaries))
+	for _, summary := range summaries {
 		gamesDto = append(gamesDto, dto.GameShortDTO{
-			ID:        game.ID().String(),
-			Name:      game.Name(),
-			User:      user.FullName().String(),
-			CreatedAt: game.CreatedAt().String(),
-			UpdatedAt: game.UpdatedAt().String(),
+			ID:             summary.GameID.String(),
+			Name:           summary.Name,
+			User:           summary.OwnerName,
+			Archived:       summary.ArchivedAt != nil,
+			MemberCount:    summary.MemberCount,
+			RoundStatus:    summary.RoundStatus,
+			LastActivityAt: summary.LastActivityAt.String(),
+			CreatedAt:      summary.CreatedAt.String(),
+			UpdatedAt:      summary.UpdatedAt.String(),
 		})
 	}
 
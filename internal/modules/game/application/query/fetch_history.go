@@ -0,0 +1,89 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const FetchHistoryKind = "FetchHistory"
+
+type FetchHistoryQuery struct {
+	GameID string
+	UserID string
+	Limit  int64
+	Offset int64
+}
+
+func (f FetchHistoryQuery) Type() core.QueryType {
+	return FetchHistoryKind
+}
+
+var _ core.Query = (*FetchHistoryQuery)(nil)
+
+type FetchHistory struct {
+	gameStorage ports.GamePgStorage
+	logger      logger.Logger
+}
+
+func NewFetchHistory(gameStorage ports.GamePgStorage, logger logger.Logger) FetchHistory {
+	return FetchHistory{
+		gameStorage: gameStorage,
+		logger:      logger,
+	}
+}
+
+// Handle returns a page of revealed rounds of the game with their statistics.
+func (f FetchHistory) Handle(ctx context.Context, query core.Query) (any, error) {
+	fetchQuery, ok := query.(FetchHistoryQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	gameID, err := common.ParseUID(fetchQuery.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(fetchQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := f.gameStorage.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !game.IsMember(userID) {
+		return nil, entity.ErrNotGameMember
+	}
+
+	estimations, err := f.gameStorage.FetchEstimations(ctx, gameID, fetchQuery.Limit, fetchQuery.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("fetch estimations error: %w", err)
+	}
+
+	total, err := f.gameStorage.CountEstimations(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("count estimations error: %w", err)
+	}
+
+	items := make([]dto.EstimationDTO, 0, len(estimations))
+	for _, estimation := range estimations {
+		items = append(items, estimationToDTO(estimation))
+	}
+
+	return dto.HistoryDTO{
+		Items:  items,
+		Total:  total,
+		Limit:  fetchQuery.Limit,
+		Offset: fetchQuery.Offset,
+	}, nil
+}
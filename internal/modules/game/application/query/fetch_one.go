@@ -7,6 +7,7 @@ import (
 	"github.com/KyKyPy3/clean/internal/application/core"
 	"github.com/KyKyPy3/clean/internal/domain/common"
 	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
 	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
 	"github.com/KyKyPy3/clean/pkg/logger"
 )
@@ -14,7 +15,9 @@ import (
 const FetchGameKind = "FetchGame"
 
 type FetchGameQuery struct {
-	ID string
+	ID     string
+	UserID string
+	Guest  bool
 }
 
 func (f FetchGameQuery) Type() core.QueryType {
@@ -52,21 +55,47 @@ func (f FetchGame) Handle(ctx context.Context, query core.Query) (any, error) {
 		return nil, err
 	}
 
+	userID, err := common.ParseUID(fetchByIDQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
 	game, err := f.gameStorage.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if !fetchByIDQuery.Guest && !game.IsMember(userID) {
+		return nil, entity.ErrNotGameMember
+	}
+
 	user, err := f.userStorage.GetByID(ctx, game.OwnerID())
 	if err != nil {
 		return nil, err
 	}
 
+	members := make([]dto.MemberDTO, 0, len(game.GameMembers()))
+	for _, member := range game.GameMembers() {
+		members = append(members, dto.MemberDTO{
+			UserID: member.UserID().String(),
+			Name:   member.Name(),
+			Role:   member.Role().String(),
+		})
+	}
+
+	var archivedAt string
+	if game.IsArchived() {
+		archivedAt = game.ArchivedAt().String()
+	}
+
 	return dto.GameDTO{
-		ID:        game.ID().String(),
-		Name:      game.Name(),
-		User:      user.FullName().String(),
-		CreatedAt: game.CreatedAt().String(),
-		UpdatedAt: game.UpdatedAt().String(),
+		ID:         game.ID().String(),
+		Name:       game.Name(),
+		DeckID:     game.DeckID().String(),
+		User:       user.FullName().String(),
+		Members:    members,
+		ArchivedAt: archivedAt,
+		CreatedAt:  game.CreatedAt().String(),
+		UpdatedAt:  game.UpdatedAt().String(),
 	}, nil
 }
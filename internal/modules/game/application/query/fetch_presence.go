@@ -0,0 +1,100 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const FetchPresenceKind = "FetchPresence"
+
+type FetchPresenceQuery struct {
+	GameID string
+	UserID string
+	Guest  bool
+}
+
+func (f FetchPresenceQuery) Type() core.QueryType {
+	return FetchPresenceKind
+}
+
+var _ core.Query = (*FetchPresenceQuery)(nil)
+
+type FetchPresence struct {
+	gameStorage     ports.GamePgStorage
+	presenceStorage ports.PresenceStorage
+	logger          logger.Logger
+}
+
+func NewFetchPresence(
+	gameStorage ports.GamePgStorage,
+	presenceStorage ports.PresenceStorage,
+	logger logger.Logger,
+) FetchPresence {
+	return FetchPresence{
+		gameStorage:     gameStorage,
+		presenceStorage: presenceStorage,
+		logger:          logger,
+	}
+}
+
+// Handle returns game members with their online state and whether they have voted in the active round.
+func (f FetchPresence) Handle(ctx context.Context, query core.Query) (any, error) {
+	fetchQuery, ok := query.(FetchPresenceQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	gameID, err := common.ParseUID(fetchQuery.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(fetchQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := f.gameStorage.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fetchQuery.Guest && !game.IsMember(userID) {
+		return nil, entity.ErrNotGameMember
+	}
+
+	online, err := f.presenceStorage.Online(ctx, gameID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	presence := dto.PresenceDTO{
+		Members: make([]dto.MemberPresenceDTO, 0, len(game.GameMembers())),
+	}
+
+	round, ok := game.CurrentRound()
+	active := ok && round.IsActive()
+	if active {
+		presence.RoundID = round.ID().String()
+	}
+
+	for _, member := range game.GameMembers() {
+		presence.Members = append(presence.Members, dto.MemberPresenceDTO{
+			UserID: member.UserID().String(),
+			Name:   member.Name(),
+			Role:   member.Role().String(),
+			Online: slices.Contains(online, member.UserID().String()),
+			Voted:  active && round.HasVoted(member.UserID()),
+		})
+	}
+
+	return presence, nil
+}
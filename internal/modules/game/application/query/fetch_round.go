@@ -0,0 +1,112 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const FetchRoundKind = "FetchRound"
+
+type FetchRoundQuery struct {
+	GameID string
+	UserID string
+	Guest  bool
+}
+
+func (f FetchRoundQuery) Type() core.QueryType {
+	return FetchRoundKind
+}
+
+var _ core.Query = (*FetchRoundQuery)(nil)
+
+type FetchRound struct {
+	gameStorage ports.GamePgStorage
+	logger      logger.Logger
+}
+
+func NewFetchRound(
+	gameStorage ports.GamePgStorage,
+	logger logger.Logger,
+) FetchRound {
+	return FetchRound{
+		gameStorage: gameStorage,
+		logger:      logger,
+	}
+}
+
+// Handle returns the current round of the game. Card values stay hidden until the round is revealed.
+func (f FetchRound) Handle(ctx context.Context, query core.Query) (any, error) {
+	fetchQuery, ok := query.(FetchRoundQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	gameID, err := common.ParseUID(fetchQuery.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(fetchQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := f.gameStorage.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fetchQuery.Guest && !game.IsMember(userID) {
+		return nil, entity.ErrNotGameMember
+	}
+
+	round, ok := game.CurrentRound()
+	if !ok {
+		return nil, entity.ErrRoundNotFound
+	}
+
+	names := make(map[common.UID]string, len(game.GameMembers()))
+	for _, member := range game.GameMembers() {
+		names[member.UserID()] = member.Name()
+	}
+
+	visible := round.Status() != entity.RoundVoting
+	votes := make([]dto.VoteDTO, 0, len(round.Votes()))
+	for _, vote := range round.Votes() {
+		voteDto := dto.VoteDTO{
+			UserID: vote.UserID().String(),
+			Name:   names[vote.UserID()],
+			Voted:  true,
+		}
+		if visible {
+			voteDto.Value = vote.Value()
+		}
+
+		votes = append(votes, voteDto)
+	}
+
+	var deadline string
+	if round.Deadline() != nil {
+		deadline = round.Deadline().String()
+	}
+
+	return dto.RoundDTO{
+		ID:        round.ID().String(),
+		GameID:    round.GameID().String(),
+		Topic:     round.Topic(),
+		Status:    round.Status().String(),
+		Estimate:  round.Estimate(),
+		Votes:     votes,
+		Timer:     int(round.Timer().Seconds()),
+		Deadline:  deadline,
+		CreatedAt: round.CreatedAt().String(),
+		UpdatedAt: round.UpdatedAt().String(),
+	}, nil
+}
@@ -0,0 +1,82 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const FetchRoundStatsKind = "FetchRoundStats"
+
+type FetchRoundStatsQuery struct {
+	GameID  string
+	RoundID string
+	UserID  string
+}
+
+func (f FetchRoundStatsQuery) Type() core.QueryType {
+	return FetchRoundStatsKind
+}
+
+var _ core.Query = (*FetchRoundStatsQuery)(nil)
+
+type FetchRoundStats struct {
+	gameStorage ports.GamePgStorage
+	logger      logger.Logger
+}
+
+func NewFetchRoundStats(gameStorage ports.GamePgStorage, logger logger.Logger) FetchRoundStats {
+	return FetchRoundStats{
+		gameStorage: gameStorage,
+		logger:      logger,
+	}
+}
+
+// Handle returns statistics of the latest reveal of the round.
+func (f FetchRoundStats) Handle(ctx context.Context, query core.Query) (any, error) {
+	statsQuery, ok := query.(FetchRoundStatsQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	gameID, err := common.ParseUID(statsQuery.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	roundID, err := common.ParseUID(statsQuery.RoundID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(statsQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := f.gameStorage.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !game.IsMember(userID) {
+		return nil, entity.ErrNotGameMember
+	}
+
+	estimation, err := f.gameStorage.GetLatestEstimation(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	if estimation.GameID() != gameID {
+		return nil, domain_core.ErrNotFound
+	}
+
+	return statsToDTO(estimation.Stats()), nil
+}
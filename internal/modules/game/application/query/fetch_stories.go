@@ -0,0 +1,82 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const FetchStoriesKind = "FetchStories"
+
+type FetchStoriesQuery struct {
+	GameID string
+	UserID string
+	Guest  bool
+}
+
+func (f FetchStoriesQuery) Type() core.QueryType {
+	return FetchStoriesKind
+}
+
+var _ core.Query = (*FetchStoriesQuery)(nil)
+
+type FetchStories struct {
+	gameStorage ports.GamePgStorage
+	logger      logger.Logger
+}
+
+func NewFetchStories(gameStorage ports.GamePgStorage, logger logger.Logger) FetchStories {
+	return FetchStories{
+		gameStorage: gameStorage,
+		logger:      logger,
+	}
+}
+
+// Handle returns the story queue of the game.
+func (f FetchStories) Handle(ctx context.Context, query core.Query) (any, error) {
+	fetchQuery, ok := query.(FetchStoriesQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	gameID, err := common.ParseUID(fetchQuery.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(fetchQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := f.gameStorage.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fetchQuery.Guest && !game.IsMember(userID) {
+		return nil, entity.ErrNotGameMember
+	}
+
+	stories := make([]dto.StoryDTO, 0, len(game.Stories()))
+	for _, story := range game.Stories() {
+		stories = append(stories, dto.StoryDTO{
+			ID:          story.ID().String(),
+			Key:         story.Key(),
+			Title:       story.Title(),
+			Description: story.Description(),
+			Link:        story.Link(),
+			Position:    story.Position(),
+			Status:      story.Status().String(),
+			Estimate:    story.Estimate(),
+		})
+	}
+
+	return stories, nil
+}
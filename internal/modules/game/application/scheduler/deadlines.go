@@ -0,0 +1,151 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/latch"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+const pageSize = 50
+
+type CommandDispatcher interface {
+	Dispatch(ctx context.Context, command core.Command) (any, error)
+}
+
+// Deadlines executes due game deadlines stored in Postgres. Every deadline runs in
+// a transaction holding an advisory lock, so only one instance executes deadlines
+// at a time and a deadline is dropped only together with the result of its action.
+type Deadlines struct {
+	storage  ports.GamePgStorage
+	manager  ports.TrManager
+	commands CommandDispatcher
+	logger   logger.Logger
+}
+
+func NewDeadlines(
+	storage ports.GamePgStorage,
+	manager ports.TrManager,
+	commands CommandDispatcher,
+	logger logger.Logger,
+) *Deadlines {
+	return &Deadlines{
+		storage:  storage,
+		manager:  manager,
+		commands: commands,
+		logger:   logger,
+	}
+}
+
+// Start polls due deadlines with the given interval until the context is done.
+func (d *Deadlines) Start(ctx context.Context, lock *latch.CountDownLatch, interval time.Duration) {
+	lock.Add(1)
+
+	go func() {
+		defer lock.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := d.Execute(ctx, time.Now().UTC()); err != nil {
+					d.logger.Errorf("failed to execute game deadlines, err: %v", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Execute runs the actions of deadlines due at the given time. It does nothing
+// when another instance holds the deadlines lock. Deadlines of all organizations
+// are executed, every one in its own transaction, so a failing deadline is logged
+// and retried on the next tick without holding back the others.
+func (d *Deadlines) Execute(ctx context.Context, now time.Time) error {
+	ctx = tenant.Unscoped(ctx)
+	failed := make(map[common.UID]struct{})
+
+	for range pageSize {
+		executed, err := d.executeNext(ctx, now, failed)
+		if err != nil {
+			return err
+		}
+		if !executed {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// executeNext runs the earliest due deadline that has not failed during this
+// tick. It reports whether a deadline was found.
+func (d *Deadlines) executeNext(ctx context.Context, now time.Time, failed map[common.UID]struct{}) (bool, error) {
+	var deadline entity.Deadline
+	err := d.manager.Do(ctx, func(ctx context.Context) error {
+		locked, err := d.storage.LockDeadlines(ctx)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			d.logger.Debugf("Game deadlines are executed by another instance")
+			return nil
+		}
+
+		// One more than the failed deadlines holds at least one to execute
+		deadlines, err := d.storage.FetchDueDeadlines(ctx, now, int64(len(failed)+1))
+		if err != nil {
+			return err
+		}
+
+		for _, due := range deadlines {
+			if _, ok := failed[due.ID()]; !ok {
+				deadline = due
+				break
+			}
+		}
+		if deadline.ID().IsEmpty() {
+			return nil
+		}
+
+		if err = d.execute(ctx, deadline, now); err != nil {
+			return err
+		}
+
+		return d.storage.DeleteDeadline(ctx, deadline.ID())
+	})
+	if err != nil && !deadline.ID().IsEmpty() {
+		d.logger.Errorf("Failed to execute game deadline %s, err: %v", deadline.ID(), err)
+		failed[deadline.ID()] = struct{}{}
+
+		return true, nil
+	}
+
+	return !deadline.ID().IsEmpty(), err
+}
+
+func (d *Deadlines) execute(ctx context.Context, deadline entity.Deadline, now time.Time) error {
+	switch deadline.Kind() {
+	case entity.DeadlineRoundReveal:
+		_, err := d.commands.Dispatch(ctx, command.NewAutoRevealRoundCommand(
+			deadline.GameID().String(),
+			deadline.RoundID().String(),
+			now,
+		))
+
+		return err
+	default:
+		d.logger.Warnf("Drop game deadline %s of unknown kind %d", deadline.ID(), deadline.Kind())
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/scheduler"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/event"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+type storageStub struct {
+	ports.GamePgStorage
+	game        entity.Game
+	deadlines   []entity.Deadline
+	locked      bool
+	estimations int
+}
+
+func (s *storageStub) GetByID(_ context.Context, _ common.UID) (entity.Game, error) {
+	return s.game, nil
+}
+
+func (s *storageStub) SaveRound(_ context.Context, _ entity.Round) error {
+	return nil
+}
+
+func (s *storageStub) SaveEstimation(_ context.Context, _ entity.Estimation) error {
+	s.estimations++
+	return nil
+}
+
+func (s *storageStub) AppendEvents(_ context.Context, _ common.UID, _ int64, _ []mediator.Event) error {
+	return nil
+}
+
+func (s *storageStub) CancelDeadlines(_ context.Context, roundID common.UID) error {
+	pending := make([]entity.Deadline, 0, len(s.deadlines))
+	for _, deadline := range s.deadlines {
+		if deadline.RoundID() != roundID {
+			pending = append(pending, deadline)
+		}
+	}
+	s.deadlines = pending
+
+	return nil
+}
+
+func (s *storageStub) DeleteDeadline(_ context.Context, id common.UID) error {
+	pending := make([]entity.Deadline, 0, len(s.deadlines))
+	for _, deadline := range s.deadlines {
+		if deadline.ID() != id {
+			pending = append(pending, deadline)
+		}
+	}
+	s.deadlines = pending
+
+	return nil
+}
+
+func (s *storageStub) LockDeadlines(_ context.Context) (bool, error) {
+	return !s.locked, nil
+}
+
+func (s *storageStub) FetchDueDeadlines(_ context.Context, now time.Time, _ int64) ([]entity.Deadline, error) {
+	due := make([]entity.Deadline, 0)
+	for _, deadline := range s.deadlines {
+		if !deadline.DueAt().After(now) {
+			due = append(due, deadline)
+		}
+	}
+
+	return due, nil
+}
+
+type managerStub struct{}
+
+func (managerStub) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type projectorStub struct {
+	projected []common.UID
+}
+
+func (p *projectorStub) Project(_ context.Context, gameID common.UID) error {
+	p.projected = append(p.projected, gameID)
+	return nil
+}
+
+type mediatorStub struct {
+	events []mediator.Event
+}
+
+func (m *mediatorStub) Publish(_ context.Context, events ...mediator.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
+// dispatcherStub fails the reveal of the failing round.
+type dispatcherStub struct {
+	failing  common.UID
+	revealed []string
+}
+
+func (d *dispatcherStub) Dispatch(_ context.Context, cmd core.Command) (any, error) {
+	reveal, ok := cmd.(command.AutoRevealRoundCommand)
+	if !ok {
+		return nil, core.ErrUnexpectedCommand
+	}
+
+	if reveal.RoundID == d.failing.String() {
+		return nil, errors.New("reveal failed")
+	}
+
+	d.revealed = append(d.revealed, reveal.RoundID)
+	return nil, nil
+}
+
+func TestDeadlinesRevealExpiredRound(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	ownerID := common.NewUID()
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+
+	round, err := game.StartRound(ownerID, "Login page", time.Minute)
+	require.NoError(t, err)
+	deadline, ok := entity.NewRoundRevealDeadline(round)
+	require.True(t, ok)
+
+	storage := &storageStub{game: game, deadlines: []entity.Deadline{deadline}}
+	projector := &projectorStub{}
+	events := &mediatorStub{}
+	bus := core.NewCommandBus()
+	bus.Register(
+		command.AutoRevealRoundKind,
+		command.NewAutoRevealRound(storage, projector, managerStub{}, events, log),
+	)
+	deadlines := scheduler.NewDeadlines(storage, managerStub{}, bus, log)
+
+	// Nothing is due before the deadline
+	require.NoError(t, deadlines.Execute(context.Background(), deadline.DueAt().Add(-time.Second)))
+	assert.Len(t, storage.deadlines, 1)
+
+	// Another instance holds the lock
+	storage.locked = true
+	require.NoError(t, deadlines.Execute(context.Background(), deadline.DueAt()))
+	assert.Len(t, storage.deadlines, 1)
+	storage.locked = false
+
+	require.NoError(t, deadlines.Execute(context.Background(), deadline.DueAt()))
+	assert.Empty(t, storage.deadlines)
+	assert.Equal(t, []common.UID{game.ID()}, projector.projected)
+	assert.Equal(t, 1, storage.estimations)
+	require.NotEmpty(t, events.events)
+
+	revealed, ok := events.events[len(events.events)-1].(event.RoundRevealedEvent)
+	require.True(t, ok)
+	assert.Equal(t, round.ID().String(), revealed.RoundID)
+	assert.True(t, revealed.Auto)
+}
+
+func TestDeadlinesSkipStaleRound(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	ownerID := common.NewUID()
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+
+	round, err := game.StartRound(ownerID, "Login page", time.Minute)
+	require.NoError(t, err)
+	deadline, ok := entity.NewRoundRevealDeadline(round)
+	require.True(t, ok)
+	require.NoError(t, game.RevealVotes(ownerID))
+
+	storage := &storageStub{game: game, deadlines: []entity.Deadline{deadline}}
+	events := &mediatorStub{}
+	bus := core.NewCommandBus()
+	bus.Register(
+		command.AutoRevealRoundKind,
+		command.NewAutoRevealRound(storage, &projectorStub{}, managerStub{}, events, log),
+	)
+
+	require.NoError(t, scheduler.NewDeadlines(storage, managerStub{}, bus, log).Execute(
+		context.Background(),
+		deadline.DueAt(),
+	))
+	assert.Empty(t, storage.deadlines)
+	assert.Empty(t, events.events)
+	assert.Zero(t, storage.estimations)
+}
+
+func TestDeadlinesKeepFailedDeadline(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	now := time.Now().UTC()
+	gameID := common.NewUID()
+	failing := entity.HydrateDeadline(
+		common.NewUID(),
+		gameID,
+		common.NewUID(),
+		entity.DeadlineRoundReveal,
+		now.Add(-time.Minute),
+		now,
+	)
+	due := entity.HydrateDeadline(common.NewUID(), gameID, common.NewUID(), entity.DeadlineRoundReveal, now, now)
+
+	storage := &storageStub{deadlines: []entity.Deadline{failing, due}}
+	dispatcher := &dispatcherStub{failing: failing.RoundID()}
+	deadlines := scheduler.NewDeadlines(storage, managerStub{}, dispatcher, log)
+
+	// The failed deadline is retried on the next tick, the later one is executed anyway
+	require.NoError(t, deadlines.Execute(context.Background(), now))
+	assert.Equal(t, []string{due.RoundID().String()}, dispatcher.revealed)
+	require.Len(t, storage.deadlines, 1)
+	assert.Equal(t, failing.ID(), storage.deadlines[0].ID())
+
+	dispatcher.failing = common.UID{}
+	require.NoError(t, deadlines.Execute(context.Background(), now))
+	assert.Empty(t, storage.deadlines)
+}
@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+// DeadlineKind represents the action executed when a game deadline is due.
+type DeadlineKind int
+
+const (
+	DeadlineRoundReveal DeadlineKind = iota + 1
+)
+
+func (k DeadlineKind) String() string {
+	switch k {
+	case DeadlineRoundReveal:
+		return "round_reveal"
+	default:
+		return "unknown"
+	}
+}
+
+// Deadline is a scheduled game action that must survive restarts.
+type Deadline struct {
+	id        common.UID
+	gameID    common.UID
+	roundID   common.UID
+	kind      DeadlineKind
+	dueAt     time.Time
+	createdAt time.Time
+}
+
+// NewRoundRevealDeadline schedules the automatic reveal of the round votes.
+// The flag is false when the round has no running timer.
+func NewRoundRevealDeadline(round Round) (Deadline, bool) {
+	if round.deadline == nil || round.status != RoundVoting {
+		return Deadline{}, false
+	}
+
+	return Deadline{
+		id:        common.NewUID(),
+		gameID:    round.gameID,
+		roundID:   round.id,
+		kind:      DeadlineRoundReveal,
+		dueAt:     *round.deadline,
+		createdAt: time.Now().UTC(),
+	}, true
+}
+
+func HydrateDeadline(
+	id common.UID,
+	gameID common.UID,
+	roundID common.UID,
+	kind DeadlineKind,
+	dueAt time.Time,
+	createdAt time.Time,
+) Deadline {
+	return Deadline{
+		id:        id,
+		gameID:    gameID,
+		roundID:   roundID,
+		kind:      kind,
+		dueAt:     dueAt,
+		createdAt: createdAt,
+	}
+}
+
+func (d Deadline) ID() common.UID {
+	return d.id
+}
+
+func (d Deadline) GameID() common.UID {
+	return d.gameID
+}
+
+func (d Deadline) RoundID() common.UID {
+	return d.roundID
+}
+
+func (d Deadline) Kind() DeadlineKind {
+	return d.kind
+}
+
+func (d Deadline) DueAt() time.Time {
+	return d.dueAt
+}
+
+func (d Deadline) CreatedAt() time.Time {
+	return d.createdAt
+}
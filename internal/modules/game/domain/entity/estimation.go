@@ -0,0 +1,237 @@
+package entity
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+const halfCard = 0.5
+
+// EstimationVote is a card revealed in an estimation with the voter name at reveal time.
+type EstimationVote struct {
+	userID common.UID
+	name   string
+	value  string
+}
+
+func HydrateEstimationVote(userID common.UID, name, value string) EstimationVote {
+	return EstimationVote{
+		userID: userID,
+		name:   name,
+		value:  value,
+	}
+}
+
+func (v EstimationVote) UserID() common.UID {
+	return v.userID
+}
+
+func (v EstimationVote) Name() string {
+	return v.name
+}
+
+func (v EstimationVote) Value() string {
+	return v.value
+}
+
+// Estimation is a snapshot of the round taken when its votes are revealed.
+type Estimation struct {
+	id         common.UID
+	gameID     common.UID
+	roundID    common.UID
+	topic      string
+	votes      []EstimationVote
+	estimate   string
+	revealedAt time.Time
+}
+
+// NewEstimation takes the snapshot of the revealed round. Members are used to keep voter names.
+func NewEstimation(round Round, members []GameMember) (Estimation, error) {
+	if round.Status() != RoundRevealed {
+		return Estimation{}, ErrRoundNotRevealed
+	}
+
+	names := make(map[common.UID]string, len(members))
+	for _, member := range members {
+		names[member.userID] = member.name
+	}
+
+	votes := make([]EstimationVote, 0, len(round.votes))
+	for _, vote := range round.votes {
+		votes = append(votes, EstimationVote{
+			userID: vote.userID,
+			name:   names[vote.userID],
+			value:  vote.value,
+		})
+	}
+
+	return Estimation{
+		id:         common.NewUID(),
+		gameID:     round.gameID,
+		roundID:    round.id,
+		topic:      round.topic,
+		votes:      votes,
+		revealedAt: time.Now().UTC(),
+	}, nil
+}
+
+func HydrateEstimation(
+	id common.UID,
+	gameID common.UID,
+	roundID common.UID,
+	topic string,
+	votes []EstimationVote,
+	estimate string,
+	revealedAt time.Time,
+) Estimation {
+	return Estimation{
+		id:         id,
+		gameID:     gameID,
+		roundID:    roundID,
+		topic:      topic,
+		votes:      votes,
+		estimate:   estimate,
+		revealedAt: revealedAt,
+	}
+}
+
+func (e Estimation) ID() common.UID {
+	return e.id
+}
+
+func (e Estimation) GameID() common.UID {
+	return e.gameID
+}
+
+func (e Estimation) RoundID() common.UID {
+	return e.roundID
+}
+
+func (e Estimation) Topic() string {
+	return e.topic
+}
+
+func (e Estimation) Votes() []EstimationVote {
+	return e.votes
+}
+
+// Estimate returns the final estimate of the round, empty until the round is closed.
+func (e Estimation) Estimate() string {
+	return e.estimate
+}
+
+func (e Estimation) RevealedAt() time.Time {
+	return e.revealedAt
+}
+
+// Stats computes the estimation statistics.
+func (e Estimation) Stats() Stats {
+	values := make([]string, 0, len(e.votes))
+	for _, vote := range e.votes {
+		values = append(values, vote.value)
+	}
+
+	return NewStats(values)
+}
+
+// Stats describes the distribution of the revealed cards. Average, median and spread
+// are computed over numeric cards only, Numeric holds their count.
+type Stats struct {
+	Votes     int
+	Numeric   int
+	Average   float64
+	Median    float64
+	Spread    float64
+	Mode      []string
+	Consensus bool
+}
+
+// NewStats computes statistics for the card values.
+func NewStats(values []string) Stats {
+	stats := Stats{
+		Votes: len(values),
+		Mode:  mode(values),
+	}
+
+	stats.Consensus = len(values) > 0
+	for _, value := range values {
+		if value != values[0] {
+			stats.Consensus = false
+
+			break
+		}
+	}
+
+	numbers := make([]float64, 0, len(values))
+	for _, value := range values {
+		if number, ok := cardNumber(value); ok {
+			numbers = append(numbers, number)
+		}
+	}
+
+	stats.Numeric = len(numbers)
+	if len(numbers) == 0 {
+		return stats
+	}
+
+	sort.Float64s(numbers)
+
+	var sum float64
+	for _, number := range numbers {
+		sum += number
+	}
+
+	stats.Average = sum / float64(len(numbers))
+	stats.Spread = numbers[len(numbers)-1] - numbers[0]
+
+	middle := len(numbers) / 2
+	if len(numbers)%2 == 0 {
+		stats.Median = (numbers[middle-1] + numbers[middle]) / 2
+	} else {
+		stats.Median = numbers[middle]
+	}
+
+	return stats
+}
+
+// mode returns the most frequent values in order of their first appearance.
+func mode(values []string) []string {
+	counts := make(map[string]int, len(values))
+	best := 0
+	for _, value := range values {
+		counts[value]++
+		if counts[value] > best {
+			best = counts[value]
+		}
+	}
+
+	result := make([]string, 0)
+	for _, value := range values {
+		if counts[value] == best {
+			result = append(result, value)
+			// Count each value once
+			counts[value] = 0
+		}
+	}
+
+	return result
+}
+
+// cardNumber converts the card to number. Cards like "?" or "☕" are not numeric.
+func cardNumber(value string) (float64, bool) {
+	if value == "½" {
+		return halfCard, true
+	}
+
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, false
+	}
+
+	return number, true
+}
@@ -0,0 +1,96 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+)
+
+func TestStats(t *testing.T) {
+	cases := []struct {
+		name      string
+		values    []string
+		numeric   int
+		average   float64
+		median    float64
+		spread    float64
+		mode      []string
+		consensus bool
+	}{
+		{
+			name:   "Empty",
+			values: []string{},
+			mode:   []string{},
+		},
+		{
+			name:    "Odd",
+			values:  []string{"3", "5", "5", "13"},
+			numeric: 4,
+			average: 6.5,
+			median:  5,
+			spread:  10,
+			mode:    []string{"5"},
+		},
+		{
+			name:    "Tie with non numeric cards",
+			values:  []string{"8", "?", "½", "?", "8"},
+			numeric: 3,
+			average: 5.5,
+			median:  8,
+			spread:  7.5,
+			mode:    []string{"8", "?"},
+		},
+		{
+			name:      "Consensus",
+			values:    []string{"M", "M"},
+			mode:      []string{"M"},
+			consensus: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			stats := entity.NewStats(tc.values)
+
+			assert.Equal(t, len(tc.values), stats.Votes)
+			assert.Equal(t, tc.numeric, stats.Numeric)
+			assert.InDelta(t, tc.average, stats.Average, 1e-9)
+			assert.InDelta(t, tc.median, stats.Median, 1e-9)
+			assert.InDelta(t, tc.spread, stats.Spread, 1e-9)
+			assert.Equal(t, tc.mode, stats.Mode)
+			assert.Equal(t, tc.consensus, stats.Consensus)
+		})
+	}
+}
+
+func TestNewEstimation(t *testing.T) {
+	ownerID := common.NewUID()
+	memberID := common.NewUID()
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+	require.NoError(t, game.AddMember(memberID, "Bob"))
+
+	_, err = game.StartRound(ownerID, "Login page", 0)
+	require.NoError(t, err)
+	require.NoError(t, game.CastVote(memberID, "5", fibonacci))
+
+	round, _ := game.CurrentRound()
+	_, err = entity.NewEstimation(round, game.GameMembers())
+	assert.ErrorIs(t, err, entity.ErrRoundNotRevealed)
+
+	require.NoError(t, game.RevealVotes(ownerID))
+	round, _ = game.CurrentRound()
+	estimation, err := entity.NewEstimation(round, game.GameMembers())
+	require.NoError(t, err)
+
+	assert.Equal(t, round.ID(), estimation.RoundID())
+	assert.Equal(t, "Login page", estimation.Topic())
+	require.Len(t, estimation.Votes(), 1)
+	assert.Equal(t, "Bob", estimation.Votes()[0].Name())
+	assert.True(t, estimation.Stats().Consensus)
+}
@@ -1,59 +1,108 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/KyKyPy3/clean/internal/domain/common"
 	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain"
 	"github.com/KyKyPy3/clean/internal/modules/game/domain/event"
 	"github.com/KyKyPy3/clean/pkg/mediator"
 )
 
+var (
+	ErrDeckNotAvailable = errors.New("deck is not available to the user")
+	ErrNotGameOwner     = fmt.Errorf("user is not the game owner: %w", ErrPermissionDenied)
+	ErrAlreadyMember    = errors.New("user is already a game member")
+	ErrOwnerCannotLeave = errors.New("game owner cannot leave the game")
+	ErrJoinLinkMismatch = errors.New("join link belongs to another game")
+	ErrEmptyGameName    = errors.New("game name cannot be empty")
+	ErrGameArchived     = errors.New("game is archived")
+)
+
 // Game struct.
 type Game struct {
 	*core.BaseAggregateRoot
 
-	id          common.UID
-	name        string
-	ownerID     common.UID
-	gameMembers []GameMember
-	createdAt   time.Time
-	updatedAt   time.Time
+	id             common.UID
+	organizationID common.UID
+	name           string
+	ownerID        common.UID
+	deckID         common.UID
+	gameMembers    []GameMember
+	round          *Round
+	stories        []Story
+	archivedAt     *time.Time
+	deletedAt      *time.Time
+	createdAt      time.Time
+	updatedAt      time.Time
+	// version is the number of events the game state is built from, including uncommitted ones.
+	version int64
 }
 
-// NewGame - creates a new Game instance with the provided name.
+// NewGame - creates a new Game instance with the provided name and deck in the
+// organization. The owner becomes the first game member.
 func NewGame(
+	organizationID common.UID,
 	name string,
 	ownerID common.UID,
+	ownerName string,
+	deckID common.UID,
 ) (Game, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Game{}, ErrEmptyGameName
+	}
+
 	game := Game{
 		BaseAggregateRoot: &core.BaseAggregateRoot{},
-		id:                common.NewUID(),
-		name:              name,
-		ownerID:           ownerID,
 	}
 
-	game.BaseAggregateRoot.AddEvent(event.GameCreatedEvent{ID: game.ID().String(), Name: name})
+	err := game.raise(event.GameCreatedEvent{
+		ID:             common.NewUID().String(),
+		OrganizationID: organizationID.String(),
+		Name:           name,
+		OwnerID:        ownerID.String(),
+		OwnerName:      ownerName,
+		DeckID:         deckID.String(),
+		CreatedAt:      time.Now().UTC(),
+	})
+	if err != nil {
+		return Game{}, err
+	}
 
 	return game, nil
 }
 
 func Hydrate(
 	id common.UID,
+	organizationID common.UID,
 	name string,
 	ownerID common.UID,
+	deckID common.UID,
 	gameMembers []GameMember,
+	round *Round,
+	stories []Story,
+	archivedAt *time.Time,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) Game {
 	game := Game{
 		BaseAggregateRoot: &core.BaseAggregateRoot{},
 		id:                id,
+		organizationID:    organizationID,
 		name:              name,
 		ownerID:           ownerID,
+		deckID:            deckID,
 		gameMembers:       gameMembers,
+		round:             round,
+		stories:           stories,
+		archivedAt:        archivedAt,
 		createdAt:         createdAt,
 		updatedAt:         updatedAt,
 	}
@@ -65,6 +114,11 @@ func (g *Game) ID() common.UID {
 	return g.id
 }
 
+// OrganizationID returns the organization owning the game.
+func (g *Game) OrganizationID() common.UID {
+	return g.organizationID
+}
+
 func (g *Game) IsEmpty() bool {
 	return reflect.DeepEqual(*g, Game{})
 }
@@ -78,21 +132,603 @@ func (g *Game) OwnerID() common.UID {
 	return g.ownerID
 }
 
+// DeckID returns the deck used for voting in the game.
+func (g *Game) DeckID() common.UID {
+	return g.deckID
+}
+
+// ArchivedAt returns the archiving time, nil for the active game.
+func (g *Game) ArchivedAt() *time.Time {
+	return g.archivedAt
+}
+
+// IsArchived reports whether the game is archived and read-only.
+func (g *Game) IsArchived() bool {
+	return g.archivedAt != nil
+}
+
+// IsDeleted reports whether the game was deleted. Deleted games are kept only in the event store.
+func (g *Game) IsDeleted() bool {
+	return g.deletedAt != nil
+}
+
+// Update renames the game and switches its deck. Facilitators can update the game
+// while no round is in progress.
+func (g *Game) Update(actorID common.UID, name string, deckID common.UID) error {
+	err := g.authorize(actorID, Role.CanFacilitate)
+	if err != nil {
+		return err
+	}
+
+	if g.IsArchived() {
+		return ErrGameArchived
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrEmptyGameName
+	}
+
+	if deckID != g.deckID && g.round != nil && g.round.IsActive() {
+		return ErrRoundInProgress
+	}
+
+	return g.raise(event.GameUpdatedEvent{
+		GameID:    g.id.String(),
+		Name:      name,
+		DeckID:    deckID.String(),
+		UpdatedAt: time.Now().UTC(),
+	})
+}
+
+// Archive makes the game read-only and hides it from the games list.
+func (g *Game) Archive(actorID common.UID) error {
+	if g.ownerID != actorID {
+		return ErrNotGameOwner
+	}
+
+	if g.IsArchived() {
+		return ErrGameArchived
+	}
+
+	if g.round != nil && g.round.IsActive() {
+		return ErrRoundInProgress
+	}
+
+	return g.raise(event.GameArchivedEvent{
+		GameID:     g.id.String(),
+		ArchivedBy: actorID.String(),
+		ArchivedAt: time.Now().UTC(),
+	})
+}
+
 func (g *Game) GameMembers() []GameMember {
 	return g.gameMembers
 }
 
 func (g *Game) AddMember(memberID common.UID, name string) error {
-	newMember, err := NewGroupMember(name, memberID, g.id)
-	if err != nil {
+	return g.raise(event.MemberJoinedEvent{
+		GameID: g.id.String(),
+		UserID: memberID.String(),
+		Name:   name,
+	})
+}
+
+// InviteMember adds the user to the game on behalf of the owner.
+func (g *Game) InviteMember(actorID, userID common.UID, name string) error {
+	if g.ownerID != actorID {
+		return ErrNotGameOwner
+	}
+
+	if g.IsArchived() {
+		return ErrGameArchived
+	}
+
+	if g.IsMember(userID) {
+		return ErrAlreadyMember
+	}
+
+	if err := g.AddMember(userID, name); err != nil {
 		return err
 	}
 
-	g.gameMembers = append(g.gameMembers, newMember)
+	return g.raise(event.MemberInvitedEvent{
+		GameID:    g.id.String(),
+		UserID:    userID.String(),
+		InvitedBy: actorID.String(),
+	})
+}
+
+// CreateJoinLink issues an expiring join code for the game.
+func (g *Game) CreateJoinLink(actorID common.UID, ttl time.Duration) (JoinLink, error) {
+	if g.ownerID != actorID {
+		return JoinLink{}, ErrNotGameOwner
+	}
+
+	if g.IsArchived() {
+		return JoinLink{}, ErrGameArchived
+	}
+
+	link, err := NewJoinLink(g.id, actorID, ttl)
+	if err != nil {
+		return JoinLink{}, err
+	}
+
+	err = g.raise(event.JoinLinkCreatedEvent{
+		GameID:    g.id.String(),
+		Code:      link.Code(),
+		CreatedBy: actorID.String(),
+		ExpiresAt: link.ExpiresAt(),
+	})
+	if err != nil {
+		return JoinLink{}, err
+	}
+
+	return link, nil
+}
+
+// Join adds the user to the game with a valid join link.
+func (g *Game) Join(link JoinLink, userID common.UID, name string) error {
+	if link.GameID() != g.id {
+		return ErrJoinLinkMismatch
+	}
+
+	if link.IsExpired(time.Now().UTC()) {
+		return ErrJoinLinkExpired
+	}
+
+	if g.IsArchived() {
+		return ErrGameArchived
+	}
+
+	if g.IsMember(userID) {
+		return ErrAlreadyMember
+	}
+
+	return g.AddMember(userID, name)
+}
+
+// Leave removes the user from the game members.
+func (g *Game) Leave(userID common.UID) error {
+	if g.ownerID == userID {
+		return ErrOwnerCannotLeave
+	}
+
+	if g.IsArchived() {
+		return ErrGameArchived
+	}
+
+	return g.raise(event.MemberLeftEvent{
+		GameID: g.id.String(),
+		UserID: userID.String(),
+	})
+}
+
+// RemoveMember kicks the member out of the game on behalf of the owner.
+func (g *Game) RemoveMember(actorID, memberID common.UID) error {
+	if g.ownerID != actorID {
+		return ErrNotGameOwner
+	}
+
+	if g.IsArchived() {
+		return ErrGameArchived
+	}
+
+	if g.ownerID == memberID {
+		return ErrOwnerCannotLeave
+	}
+
+	return g.raise(event.MemberRemovedEvent{
+		GameID:    g.id.String(),
+		UserID:    memberID.String(),
+		RemovedBy: actorID.String(),
+	})
+}
+
+func (g *Game) removeMember(userID common.UID) bool {
+	for i, member := range g.gameMembers {
+		if member.userID == userID {
+			g.gameMembers = append(g.gameMembers[:i], g.gameMembers[i+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsMember reports whether the user is the owner or a member of the game.
+func (g *Game) IsMember(userID common.UID) bool {
+	if g.ownerID == userID {
+		return true
+	}
+
+	for _, member := range g.gameMembers {
+		if member.userID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Role returns the role of the user in the game.
+func (g *Game) Role(userID common.UID) (Role, bool) {
+	if g.ownerID == userID {
+		return RoleOwner, true
+	}
+
+	for _, member := range g.gameMembers {
+		if member.userID == userID {
+			return member.role, true
+		}
+	}
+
+	return 0, false
+}
+
+// ChangeMemberRole assigns the facilitator, voter or observer role to the member on behalf of the owner.
+func (g *Game) ChangeMemberRole(actorID, memberID common.UID, role Role) error {
+	if g.ownerID != actorID {
+		return ErrNotGameOwner
+	}
+
+	if g.IsArchived() {
+		return ErrGameArchived
+	}
+
+	if role != RoleFacilitator && role != RoleVoter && role != RoleObserver {
+		return ErrInvalidRole
+	}
+
+	// The owner role changes only with the ownership transfer
+	if g.ownerID == memberID {
+		return ErrInvalidRole
+	}
+
+	return g.raise(event.MemberRoleChangedEvent{
+		GameID: g.id.String(),
+		UserID: memberID.String(),
+		Role:   role.String(),
+	})
+}
+
+// TransferOwnership hands the game over to another member. The previous owner stays as facilitator.
+func (g *Game) TransferOwnership(actorID, memberID common.UID) error {
+	if g.ownerID != actorID {
+		return ErrNotGameOwner
+	}
+
+	if g.IsArchived() {
+		return ErrGameArchived
+	}
+
+	// The owner already holds the owner role
+	if memberID == actorID {
+		return ErrInvalidRole
+	}
+
+	if g.member(memberID) == nil {
+		return ErrNotGameMember
+	}
+
+	return g.raise(event.OwnershipTransferredEvent{
+		GameID:          g.id.String(),
+		PreviousOwnerID: actorID.String(),
+		OwnerID:         memberID.String(),
+		TransferredAt:   time.Now().UTC(),
+	})
+}
+
+// Delete marks the game as deleted on behalf of the owner.
+func (g *Game) Delete(actorID common.UID) error {
+	if g.ownerID != actorID {
+		return ErrNotGameOwner
+	}
+
+	return g.raise(event.GameDeletedEvent{
+		GameID:    g.id.String(),
+		DeletedBy: actorID.String(),
+		DeletedAt: time.Now().UTC(),
+	})
+}
+
+func (g *Game) member(userID common.UID) *GameMember {
+	for i := range g.gameMembers {
+		if g.gameMembers[i].userID == userID {
+			return &g.gameMembers[i]
+		}
+	}
+
+	return nil
+}
+
+// authorize checks that the user is a game member whose role allows the action.
+func (g *Game) authorize(userID common.UID, allowed func(Role) bool) error {
+	role, ok := g.Role(userID)
+	if !ok {
+		return ErrNotGameMember
+	}
+
+	if !allowed(role) {
+		return ErrPermissionDenied
+	}
 
 	return nil
 }
 
+// CurrentRound returns the latest round of the game if there is one.
+func (g *Game) CurrentRound() (Round, bool) {
+	if g.round == nil {
+		return Round{}, false
+	}
+
+	return *g.round, true
+}
+
+// StartRound opens a new voting round for the given topic. A non zero timer
+// reveals the votes automatically when it expires.
+func (g *Game) StartRound(actorID common.UID, topic string, timer time.Duration) (Round, error) {
+	return g.startRound(actorID, topic, timer, nil)
+}
+
+// StartNextRound opens a new voting round for the first pending story of the queue.
+func (g *Game) StartNextRound(actorID common.UID, timer time.Duration) (Round, Story, error) {
+	for i := range g.stories {
+		if g.stories[i].status != StoryPending {
+			continue
+		}
+
+		story := &g.stories[i]
+		round, err := g.startRound(actorID, story.Topic(), timer, story)
+		if err != nil {
+			return Round{}, Story{}, err
+		}
+
+		return round, *story, nil
+	}
+
+	if err := g.authorize(actorID, Role.CanFacilitate); err != nil {
+		return Round{}, Story{}, err
+	}
+
+	return Round{}, Story{}, ErrNoPendingStories
+}
+
+// Stories returns the story queue ordered by position.
+func (g *Game) Stories() []Story {
+	return g.stories
+}
+
+// StoryByRound returns the story estimated in the round.
+func (g *Game) StoryByRound(roundID common.UID) (Story, bool) {
+	for _, story := range g.stories {
+		if story.roundID != nil && *story.roundID == roundID {
+			return story, true
+		}
+	}
+
+	return Story{}, false
+}
+
+// AddStories appends the stories to the end of the game queue and returns them with assigned positions.
+func (g *Game) AddStories(actorID common.UID, stories []Story) ([]Story, error) {
+	if err := g.authorize(actorID, Role.CanFacilitate); err != nil {
+		return nil, err
+	}
+
+	if g.IsArchived() {
+		return nil, ErrGameArchived
+	}
+
+	position := 0
+	if len(g.stories) > 0 {
+		position = g.stories[len(g.stories)-1].position
+	}
+
+	imported := make([]event.ImportedStory, 0, len(stories))
+	for _, story := range stories {
+		position++
+		imported = append(imported, event.ImportedStory{
+			ID:          story.id.String(),
+			Key:         story.key,
+			Title:       story.title,
+			Description: story.description,
+			Link:        story.link,
+			Position:    position,
+		})
+	}
+
+	err := g.raise(event.StoriesImportedEvent{
+		GameID:     g.id.String(),
+		Count:      len(imported),
+		Stories:    imported,
+		ImportedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(g.stories[len(g.stories)-len(imported):]), nil
+}
+
+func (g *Game) startRound(actorID common.UID, topic string, timer time.Duration, story *Story) (Round, error) {
+	if err := g.authorize(actorID, Role.CanFacilitate); err != nil {
+		return Round{}, err
+	}
+
+	if g.IsArchived() {
+		return Round{}, ErrGameArchived
+	}
+
+	if g.round != nil && g.round.IsActive() {
+		return Round{}, ErrRoundInProgress
+	}
+
+	round, err := NewRound(g.id, topic, timer)
+	if err != nil {
+		return Round{}, err
+	}
+
+	var storyID string
+	if story != nil {
+		storyID = story.id.String()
+	}
+
+	err = g.raise(event.RoundStartedEvent{
+		ID:        round.ID().String(),
+		GameID:    g.id.String(),
+		Topic:     round.Topic(),
+		StoryID:   storyID,
+		Timer:     int(round.Timer() / roundTimerUnit),
+		Deadline:  round.Deadline(),
+		StartedAt: round.CreatedAt(),
+	})
+	if err != nil {
+		return Round{}, err
+	}
+
+	return *g.round, nil
+}
+
+// CastVote stores the card chosen by a member, replacing a previous vote in the same round.
+// The card must belong to the game deck.
+func (g *Game) CastVote(userID common.UID, value string, deck domain.CardDeck) error {
+	err := g.authorize(userID, Role.CanVote)
+	if err != nil {
+		return err
+	}
+
+	round, err := g.activeRound()
+	if err != nil {
+		return err
+	}
+
+	vote, err := NewVote(userID, value)
+	if err != nil {
+		return err
+	}
+
+	if !deck.HasCard(vote.Value()) {
+		return ErrCardNotInDeck
+	}
+
+	return g.raise(event.VoteCastEvent{
+		RoundID: round.ID().String(),
+		GameID:  g.id.String(),
+		UserID:  userID.String(),
+		Value:   vote.Value(),
+		CastAt:  vote.CreatedAt(),
+	})
+}
+
+// RevealVotes makes the votes of the current round visible.
+func (g *Game) RevealVotes(actorID common.UID) error {
+	err := g.authorize(actorID, Role.CanFacilitate)
+	if err != nil {
+		return err
+	}
+
+	round, err := g.activeRound()
+	if err != nil {
+		return err
+	}
+
+	return g.reveal(round, false)
+}
+
+// RevealExpiredRound reveals the votes of the round once its timer has expired.
+// Rounds that were revealed, reset or replaced in the meantime are left untouched.
+func (g *Game) RevealExpiredRound(roundID common.UID, now time.Time) error {
+	round, err := g.activeRound()
+	if err != nil {
+		return err
+	}
+
+	if round.id != roundID {
+		return ErrRoundNotFound
+	}
+
+	if round.status != RoundVoting {
+		return ErrRoundNotVoting
+	}
+
+	if !round.IsExpired(now) {
+		return ErrRoundTimerNotExpired
+	}
+
+	return g.reveal(round, true)
+}
+
+func (g *Game) reveal(round *Round, auto bool) error {
+	votes := make(map[string]string, len(round.votes))
+	for _, vote := range round.votes {
+		votes[vote.userID.String()] = vote.value
+	}
+
+	return g.raise(event.RoundRevealedEvent{
+		RoundID:    round.ID().String(),
+		GameID:     g.id.String(),
+		Votes:      votes,
+		Auto:       auto,
+		RevealedAt: time.Now().UTC(),
+	})
+}
+
+// ResetRound drops all votes of the current round and reopens voting.
+func (g *Game) ResetRound(actorID common.UID) error {
+	err := g.authorize(actorID, Role.CanFacilitate)
+	if err != nil {
+		return err
+	}
+
+	round, err := g.activeRound()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	return g.raise(event.RoundResetEvent{
+		RoundID:  round.ID().String(),
+		GameID:   g.id.String(),
+		Deadline: round.timerDeadline(now),
+		ResetAt:  now,
+	})
+}
+
+// CloseRound finishes the revealed round with the final estimate.
+func (g *Game) CloseRound(actorID common.UID, estimate string) error {
+	err := g.authorize(actorID, Role.CanFacilitate)
+	if err != nil {
+		return err
+	}
+
+	round, err := g.activeRound()
+	if err != nil {
+		return err
+	}
+
+	if round.status != RoundRevealed {
+		return ErrRoundNotRevealed
+	}
+
+	return g.raise(event.RoundClosedEvent{
+		RoundID:  round.ID().String(),
+		GameID:   g.id.String(),
+		Estimate: strings.TrimSpace(estimate),
+		ClosedAt: time.Now().UTC(),
+	})
+}
+
+func (g *Game) activeRound() (*Round, error) {
+	if g.round == nil || !g.round.IsActive() {
+		return nil, ErrRoundNotFound
+	}
+
+	return g.round, nil
+}
+
 func (g *Game) CreatedAt() time.Time {
 	return g.createdAt
 }
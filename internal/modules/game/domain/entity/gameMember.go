@@ -10,13 +10,38 @@ type GameMember struct {
 	name   string
 	userID common.UID
 	gameID common.UID
+	role   Role
 }
 
-func NewGroupMember(name string, userID, gameID common.UID) (GameMember, error) {
+func NewGroupMember(name string, userID, gameID common.UID, role Role) (GameMember, error) {
 	return GameMember{
 		id:     common.NewUID(),
 		name:   name,
 		userID: userID,
 		gameID: gameID,
+		role:   role,
 	}, nil
 }
+
+func HydrateMember(name string, userID, gameID common.UID, role Role) GameMember {
+	return GameMember{
+		id:     common.NewUID(),
+		name:   name,
+		userID: userID,
+		gameID: gameID,
+		role:   role,
+	}
+}
+
+func (m GameMember) UserID() common.UID {
+	return m.userID
+}
+
+func (m GameMember) Name() string {
+	return m.name
+}
+
+// Role returns the member role in the game.
+func (m GameMember) Role() Role {
+	return m.role
+}
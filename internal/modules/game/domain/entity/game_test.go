@@ -0,0 +1,319 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+)
+
+type deckMock struct {
+	cards []string
+}
+
+func (d *deckMock) HasCard(value string) bool {
+	for _, card := range d.cards {
+		if card == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+var fibonacci = &deckMock{cards: []string{"1", "2", "3", "5", "8", "13"}}
+
+func TestRoundLifecycle(t *testing.T) {
+	ownerID := common.NewUID()
+	memberID := common.NewUID()
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+	require.NoError(t, game.AddMember(memberID, "Bob"))
+
+	_, err = game.StartRound(ownerID, "Login page", 0)
+	require.NoError(t, err)
+
+	_, err = game.StartRound(ownerID, "Another story", 0)
+	assert.ErrorIs(t, err, entity.ErrRoundInProgress)
+
+	require.NoError(t, game.CastVote(ownerID, "3", fibonacci))
+	require.NoError(t, game.CastVote(memberID, "5", fibonacci))
+	require.NoError(t, game.CastVote(memberID, "8", fibonacci))
+
+	round, ok := game.CurrentRound()
+	require.True(t, ok)
+	assert.Len(t, round.Votes(), 2)
+	assert.True(t, round.HasVoted(memberID))
+
+	assert.ErrorIs(t, game.CloseRound(ownerID, "8"), entity.ErrRoundNotRevealed)
+	require.NoError(t, game.RevealVotes(ownerID))
+	assert.ErrorIs(t, game.CastVote(ownerID, "13", fibonacci), entity.ErrRoundNotVoting)
+
+	require.NoError(t, game.CloseRound(ownerID, "8"))
+
+	round, _ = game.CurrentRound()
+	assert.Equal(t, entity.RoundClosed, round.Status())
+	assert.Equal(t, "8", round.Estimate())
+	assert.ErrorIs(t, game.RevealVotes(ownerID), entity.ErrRoundNotFound)
+}
+
+func TestRoundReset(t *testing.T) {
+	ownerID := common.NewUID()
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+
+	_, err = game.StartRound(ownerID, "Login page", 0)
+	require.NoError(t, err)
+	require.NoError(t, game.CastVote(ownerID, "5", fibonacci))
+	require.NoError(t, game.RevealVotes(ownerID))
+	require.NoError(t, game.ResetRound(ownerID))
+
+	round, _ := game.CurrentRound()
+	assert.Equal(t, entity.RoundVoting, round.Status())
+	assert.Empty(t, round.Votes())
+}
+
+func TestRoundValidation(t *testing.T) {
+	ownerID := common.NewUID()
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+
+	_, err = game.StartRound(ownerID, " ", 0)
+	assert.ErrorIs(t, err, entity.ErrEmptyTopic)
+
+	_, err = game.StartRound(common.NewUID(), "Login page", 0)
+	assert.ErrorIs(t, err, entity.ErrNotGameMember)
+
+	_, err = game.StartRound(ownerID, "Login page", 0)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, game.CastVote(ownerID, "", fibonacci), entity.ErrEmptyCard)
+	assert.ErrorIs(t, game.CastVote(ownerID, "12345678901234567", fibonacci), entity.ErrCardTooLong)
+	assert.ErrorIs(t, game.CastVote(common.NewUID(), "3", fibonacci), entity.ErrNotGameMember)
+	assert.ErrorIs(t, game.CastVote(ownerID, "4", fibonacci), entity.ErrCardNotInDeck)
+}
+
+func TestMembership(t *testing.T) {
+	ownerID := common.NewUID()
+	memberID := common.NewUID()
+	guestID := common.NewUID()
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, game.InviteMember(memberID, guestID, "Eve"), entity.ErrNotGameOwner)
+	require.NoError(t, game.InviteMember(ownerID, memberID, "Bob"))
+	assert.ErrorIs(t, game.InviteMember(ownerID, memberID, "Bob"), entity.ErrAlreadyMember)
+
+	_, err = game.CreateJoinLink(memberID, time.Hour)
+	assert.ErrorIs(t, err, entity.ErrNotGameOwner)
+	_, err = game.CreateJoinLink(ownerID, time.Second)
+	assert.ErrorIs(t, err, entity.ErrJoinLinkTTL)
+
+	link, err := game.CreateJoinLink(ownerID, time.Hour)
+	require.NoError(t, err)
+	assert.Len(t, link.Code(), 10)
+	require.NoError(t, game.Join(link, guestID, "Eve"))
+	assert.True(t, game.IsMember(guestID))
+
+	expired := entity.HydrateJoinLink("expired123", game.ID(), ownerID, time.Now().Add(-time.Minute), time.Now())
+	assert.ErrorIs(t, game.Join(expired, common.NewUID(), "Mallory"), entity.ErrJoinLinkExpired)
+
+	foreign := entity.HydrateJoinLink("foreign123", common.NewUID(), ownerID, time.Now().Add(time.Hour), time.Now())
+	assert.ErrorIs(t, game.Join(foreign, common.NewUID(), "Mallory"), entity.ErrJoinLinkMismatch)
+
+	assert.ErrorIs(t, game.Leave(ownerID), entity.ErrOwnerCannotLeave)
+	require.NoError(t, game.Leave(guestID))
+	assert.False(t, game.IsMember(guestID))
+	assert.ErrorIs(t, game.Leave(guestID), entity.ErrNotGameMember)
+
+	assert.ErrorIs(t, game.RemoveMember(memberID, ownerID), entity.ErrNotGameOwner)
+	require.NoError(t, game.RemoveMember(ownerID, memberID))
+	assert.False(t, game.IsMember(memberID))
+}
+
+func TestRoles(t *testing.T) {
+	ownerID := common.NewUID()
+	facilitatorID := common.NewUID()
+	voterID := common.NewUID()
+	observerID := common.NewUID()
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+	require.NoError(t, game.AddMember(facilitatorID, "Alice"))
+	require.NoError(t, game.AddMember(voterID, "Bob"))
+	require.NoError(t, game.AddMember(observerID, "Carol"))
+
+	assert.ErrorIs(t, game.ChangeMemberRole(voterID, facilitatorID, entity.RoleFacilitator), entity.ErrPermissionDenied)
+	assert.ErrorIs(t, game.ChangeMemberRole(ownerID, facilitatorID, entity.RoleOwner), entity.ErrInvalidRole)
+	require.NoError(t, game.ChangeMemberRole(ownerID, facilitatorID, entity.RoleFacilitator))
+	require.NoError(t, game.ChangeMemberRole(ownerID, observerID, entity.RoleObserver))
+
+	role, ok := game.Role(observerID)
+	require.True(t, ok)
+	assert.Equal(t, entity.RoleObserver, role)
+
+	_, err = game.StartRound(voterID, "Login page", 0)
+	assert.ErrorIs(t, err, entity.ErrPermissionDenied)
+	_, err = game.StartRound(facilitatorID, "Login page", 0)
+	require.NoError(t, err)
+
+	require.NoError(t, game.CastVote(voterID, "5", fibonacci))
+	assert.ErrorIs(t, game.CastVote(observerID, "5", fibonacci), entity.ErrPermissionDenied)
+
+	assert.ErrorIs(t, game.RevealVotes(voterID), entity.ErrPermissionDenied)
+	require.NoError(t, game.RevealVotes(facilitatorID))
+
+	assert.ErrorIs(t, game.Delete(facilitatorID), entity.ErrPermissionDenied)
+	assert.ErrorIs(t, game.TransferOwnership(facilitatorID, voterID), entity.ErrPermissionDenied)
+	assert.ErrorIs(t, game.TransferOwnership(ownerID, ownerID), entity.ErrInvalidRole)
+	assert.ErrorIs(t, game.TransferOwnership(ownerID, common.NewUID()), entity.ErrNotGameMember)
+	assert.Equal(t, ownerID, game.OwnerID())
+	require.NoError(t, game.TransferOwnership(ownerID, voterID))
+	assert.Equal(t, voterID, game.OwnerID())
+	assert.ErrorIs(t, game.Delete(ownerID), entity.ErrNotGameOwner)
+	require.NoError(t, game.Delete(voterID))
+}
+
+func TestParseRole(t *testing.T) {
+	role, err := entity.ParseRole("observer")
+	require.NoError(t, err)
+	assert.Equal(t, entity.RoleObserver, role)
+
+	_, err = entity.ParseRole("admin")
+	assert.ErrorIs(t, err, entity.ErrInvalidRole)
+}
+
+func TestUpdateAndArchive(t *testing.T) {
+	ownerID := common.NewUID()
+	voterID := common.NewUID()
+	deckID := common.NewUID()
+
+	_, err := entity.NewGame(common.NewUID(), "  ", ownerID, "Owner", deckID)
+	assert.ErrorIs(t, err, entity.ErrEmptyGameName)
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", deckID)
+	require.NoError(t, err)
+	require.NoError(t, game.AddMember(voterID, "Bob"))
+
+	assert.ErrorIs(t, game.Update(voterID, "Sprint 43", deckID), entity.ErrPermissionDenied)
+	assert.ErrorIs(t, game.Update(ownerID, "", deckID), entity.ErrEmptyGameName)
+	require.NoError(t, game.Update(ownerID, "Sprint 43", deckID))
+	assert.Equal(t, "Sprint 43", game.Name())
+
+	_, err = game.StartRound(ownerID, "Login page", 0)
+	require.NoError(t, err)
+	assert.ErrorIs(t, game.Update(ownerID, "Sprint 43", common.NewUID()), entity.ErrRoundInProgress)
+	assert.ErrorIs(t, game.Archive(ownerID), entity.ErrRoundInProgress)
+	require.NoError(t, game.RevealVotes(ownerID))
+	require.NoError(t, game.CloseRound(ownerID, "5"))
+
+	assert.ErrorIs(t, game.Archive(voterID), entity.ErrNotGameOwner)
+	require.NoError(t, game.Archive(ownerID))
+	assert.True(t, game.IsArchived())
+	assert.ErrorIs(t, game.Archive(ownerID), entity.ErrGameArchived)
+
+	_, err = game.StartRound(ownerID, "Another story", 0)
+	assert.ErrorIs(t, err, entity.ErrGameArchived)
+	assert.ErrorIs(t, game.Update(ownerID, "Sprint 44", deckID), entity.ErrGameArchived)
+
+	// The members of an archived game are read-only as well
+	assert.ErrorIs(t, game.Leave(voterID), entity.ErrGameArchived)
+	assert.ErrorIs(t, game.RemoveMember(ownerID, voterID), entity.ErrGameArchived)
+	assert.ErrorIs(t, game.ChangeMemberRole(ownerID, voterID, entity.RoleObserver), entity.ErrGameArchived)
+	assert.ErrorIs(t, game.TransferOwnership(ownerID, voterID), entity.ErrGameArchived)
+	assert.True(t, game.IsMember(voterID))
+	assert.Equal(t, ownerID, game.OwnerID())
+}
+
+func TestStoryQueue(t *testing.T) {
+	ownerID := common.NewUID()
+	voterID := common.NewUID()
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+	require.NoError(t, game.AddMember(voterID, "Bob"))
+
+	_, _, err = game.StartNextRound(ownerID, 0)
+	assert.ErrorIs(t, err, entity.ErrNoPendingStories)
+
+	_, err = entity.NewStory(game.ID(), "PROJ-1", "Login", "", "javascript:alert(1)")
+	assert.ErrorIs(t, err, entity.ErrInvalidStoryLink)
+
+	first, err := entity.NewStory(game.ID(), "PROJ-1", "Login page", "", "https://tracker.local/PROJ-1")
+	require.NoError(t, err)
+	second, err := entity.NewStory(game.ID(), "", "Logout", "", "")
+	require.NoError(t, err)
+
+	_, err = game.AddStories(voterID, []entity.Story{first, second})
+	assert.ErrorIs(t, err, entity.ErrPermissionDenied)
+	added, err := game.AddStories(ownerID, []entity.Story{first, second})
+	require.NoError(t, err)
+	assert.Equal(t, 1, added[0].Position())
+	assert.Equal(t, 2, added[1].Position())
+
+	round, story, err := game.StartNextRound(ownerID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "PROJ-1 Login page", round.Topic())
+	assert.Equal(t, entity.StoryEstimating, story.Status())
+
+	require.NoError(t, game.RevealVotes(ownerID))
+	require.NoError(t, game.CloseRound(ownerID, "5"))
+
+	story, ok := game.StoryByRound(round.ID())
+	require.True(t, ok)
+	assert.Equal(t, entity.StoryEstimated, story.Status())
+	assert.Equal(t, "5", story.Estimate())
+
+	round, story, err = game.StartNextRound(ownerID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "Logout", round.Topic())
+	assert.Equal(t, 2, story.Position())
+}
+
+func TestRoundTimer(t *testing.T) {
+	ownerID := common.NewUID()
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+
+	_, err = game.StartRound(ownerID, "Login page", time.Second)
+	assert.ErrorIs(t, err, entity.ErrInvalidRoundTimer)
+
+	round, err := game.StartRound(ownerID, "Login page", time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, round.Deadline())
+	assert.Equal(t, time.Minute, round.Timer())
+
+	deadline, ok := entity.NewRoundRevealDeadline(round)
+	require.True(t, ok)
+	assert.Equal(t, entity.DeadlineRoundReveal, deadline.Kind())
+	assert.Equal(t, round.ID(), deadline.RoundID())
+	assert.Equal(t, *round.Deadline(), deadline.DueAt())
+
+	require.NoError(t, game.CastVote(ownerID, "5", fibonacci))
+
+	assert.ErrorIs(t, game.RevealExpiredRound(round.ID(), time.Now()), entity.ErrRoundTimerNotExpired)
+	assert.ErrorIs(t, game.RevealExpiredRound(common.NewUID(), deadline.DueAt()), entity.ErrRoundNotFound)
+	require.NoError(t, game.RevealExpiredRound(round.ID(), deadline.DueAt()))
+
+	round, _ = game.CurrentRound()
+	assert.Equal(t, entity.RoundRevealed, round.Status())
+	assert.Nil(t, round.Deadline())
+	assert.ErrorIs(t, game.RevealExpiredRound(round.ID(), deadline.DueAt()), entity.ErrRoundNotVoting)
+
+	_, ok = entity.NewRoundRevealDeadline(round)
+	assert.False(t, ok)
+
+	require.NoError(t, game.ResetRound(ownerID))
+	round, _ = game.CurrentRound()
+	require.NotNil(t, round.Deadline())
+	assert.True(t, round.Deadline().After(deadline.DueAt().Add(-time.Second)))
+}
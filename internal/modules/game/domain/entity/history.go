@@ -0,0 +1,339 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/event"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+var ErrUnexpectedEvent = errors.New("unexpected game event")
+
+// Rehydrate rebuilds the game by replaying the events recorded after the snapshot
+// onto it. The snapshot is nil when the game history is replayed from its creation.
+func Rehydrate(snapshot *Game, version int64, events []mediator.Event) (Game, error) {
+	game := Game{BaseAggregateRoot: &core.BaseAggregateRoot{}}
+	if snapshot != nil {
+		game = *snapshot
+	}
+	game.version = version
+
+	for _, e := range events {
+		if err := game.apply(e); err != nil {
+			return Game{}, fmt.Errorf("replay %s event %d: %w", e.Kind(), game.version+1, err)
+		}
+		game.version++
+	}
+
+	return game, nil
+}
+
+// Version returns the number of events the game state is built from. The events
+// that are not committed yet are included.
+func (g *Game) Version() int64 {
+	return g.version
+}
+
+// raise applies the event to the game state and records it as uncommitted.
+// The event is dropped when it can't be applied.
+func (g *Game) raise(e mediator.Event) error {
+	if err := g.apply(e); err != nil {
+		return err
+	}
+
+	g.version++
+	g.BaseAggregateRoot.AddEvent(e)
+
+	return nil
+}
+
+// apply changes the game state according to the event. It is the only place the
+// state changes, both for new events and for the replayed ones.
+//
+//nolint:gocyclo,cyclop,funlen // one case per game event
+func (g *Game) apply(e mediator.Event) error {
+	switch e := e.(type) {
+	case event.GameCreatedEvent:
+		return g.applyCreated(e)
+	case event.GameUpdatedEvent:
+		deckID, err := common.ParseUID(e.DeckID)
+		if err != nil {
+			return err
+		}
+
+		g.name = e.Name
+		g.deckID = deckID
+		g.updatedAt = e.UpdatedAt
+	case event.GameArchivedEvent:
+		archivedAt := e.ArchivedAt
+		g.archivedAt = &archivedAt
+		g.updatedAt = archivedAt
+	case event.GameDeletedEvent:
+		deletedAt := e.DeletedAt
+		g.deletedAt = &deletedAt
+	case event.MemberJoinedEvent:
+		userID, err := common.ParseUID(e.UserID)
+		if err != nil {
+			return err
+		}
+
+		member, err := NewGroupMember(e.Name, userID, g.id, RoleVoter)
+		if err != nil {
+			return err
+		}
+
+		g.gameMembers = append(g.gameMembers, member)
+	case event.MemberLeftEvent:
+		return g.applyMemberRemoved(e.UserID)
+	case event.MemberRemovedEvent:
+		return g.applyMemberRemoved(e.UserID)
+	case event.MemberRoleChangedEvent:
+		return g.applyRoleChanged(e)
+	case event.OwnershipTransferredEvent:
+		return g.applyOwnershipTransferred(e)
+	case event.MemberInvitedEvent, event.JoinLinkCreatedEvent:
+		// Recorded for the audit trail only
+	case event.StoriesImportedEvent:
+		return g.applyStoriesImported(e)
+	case event.RoundStartedEvent:
+		return g.applyRoundStarted(e)
+	case event.VoteCastEvent:
+		round, err := g.eventRound(e.RoundID)
+		if err != nil {
+			return err
+		}
+
+		userID, err := common.ParseUID(e.UserID)
+		if err != nil {
+			return err
+		}
+
+		return round.castVote(HydrateVote(userID, e.Value, e.CastAt))
+	case event.RoundRevealedEvent:
+		round, err := g.eventRound(e.RoundID)
+		if err != nil {
+			return err
+		}
+
+		return round.reveal(e.RevealedAt)
+	case event.RoundResetEvent:
+		round, err := g.eventRound(e.RoundID)
+		if err != nil {
+			return err
+		}
+
+		return round.reset(e.ResetAt)
+	case event.RoundClosedEvent:
+		return g.applyRoundClosed(e)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnexpectedEvent, e.Kind())
+	}
+
+	return nil
+}
+
+func (g *Game) applyCreated(e event.GameCreatedEvent) error {
+	id, err := common.ParseUID(e.ID)
+	if err != nil {
+		return err
+	}
+
+	organizationID, err := common.ParseUID(e.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	ownerID, err := common.ParseUID(e.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	deckID, err := common.ParseUID(e.DeckID)
+	if err != nil {
+		return err
+	}
+
+	owner, err := NewGroupMember(e.OwnerName, ownerID, id, RoleOwner)
+	if err != nil {
+		return err
+	}
+
+	g.id = id
+	g.organizationID = organizationID
+	g.name = e.Name
+	g.ownerID = ownerID
+	g.deckID = deckID
+	g.gameMembers = []GameMember{owner}
+	g.createdAt = e.CreatedAt
+	g.updatedAt = e.CreatedAt
+
+	return nil
+}
+
+func (g *Game) applyMemberRemoved(memberID string) error {
+	userID, err := common.ParseUID(memberID)
+	if err != nil {
+		return err
+	}
+
+	if !g.removeMember(userID) {
+		return ErrNotGameMember
+	}
+
+	return nil
+}
+
+func (g *Game) applyRoleChanged(e event.MemberRoleChangedEvent) error {
+	userID, err := common.ParseUID(e.UserID)
+	if err != nil {
+		return err
+	}
+
+	role, err := ParseRole(e.Role)
+	if err != nil {
+		return err
+	}
+
+	member := g.member(userID)
+	if member == nil {
+		return ErrNotGameMember
+	}
+
+	member.role = role
+
+	return nil
+}
+
+func (g *Game) applyOwnershipTransferred(e event.OwnershipTransferredEvent) error {
+	previousID, err := common.ParseUID(e.PreviousOwnerID)
+	if err != nil {
+		return err
+	}
+
+	ownerID, err := common.ParseUID(e.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	member := g.member(ownerID)
+	if member == nil {
+		return ErrNotGameMember
+	}
+
+	member.role = RoleOwner
+	if previous := g.member(previousID); previous != nil {
+		previous.role = RoleFacilitator
+	}
+
+	g.ownerID = ownerID
+	g.updatedAt = e.TransferredAt
+
+	return nil
+}
+
+func (g *Game) applyStoriesImported(e event.StoriesImportedEvent) error {
+	for _, imported := range e.Stories {
+		id, err := common.ParseUID(imported.ID)
+		if err != nil {
+			return err
+		}
+
+		g.stories = append(g.stories, HydrateStory(
+			id,
+			g.id,
+			imported.Key,
+			imported.Title,
+			imported.Description,
+			imported.Link,
+			imported.Position,
+			StoryPending,
+			nil,
+			"",
+			e.ImportedAt,
+			e.ImportedAt,
+		))
+	}
+
+	return nil
+}
+
+func (g *Game) applyRoundStarted(e event.RoundStartedEvent) error {
+	id, err := common.ParseUID(e.ID)
+	if err != nil {
+		return err
+	}
+
+	round := HydrateRound(
+		id,
+		g.id,
+		e.Topic,
+		RoundVoting,
+		make([]Vote, 0),
+		"",
+		time.Duration(e.Timer)*roundTimerUnit,
+		e.Deadline,
+		e.StartedAt,
+		e.StartedAt,
+	)
+
+	if e.StoryID != "" {
+		var storyID common.UID
+		storyID, err = common.ParseUID(e.StoryID)
+		if err != nil {
+			return err
+		}
+
+		story := g.story(storyID)
+		if story == nil {
+			return fmt.Errorf("%w: story %s is not in the game", ErrUnexpectedEvent, e.StoryID)
+		}
+
+		story.start(round.id, e.StartedAt)
+	}
+
+	g.round = &round
+
+	return nil
+}
+
+func (g *Game) applyRoundClosed(e event.RoundClosedEvent) error {
+	round, err := g.eventRound(e.RoundID)
+	if err != nil {
+		return err
+	}
+
+	if err = round.close(e.Estimate, e.ClosedAt); err != nil {
+		return err
+	}
+
+	for i := range g.stories {
+		if g.stories[i].roundID != nil && *g.stories[i].roundID == round.id {
+			g.stories[i].finish(round.estimate, e.ClosedAt)
+		}
+	}
+
+	return nil
+}
+
+// eventRound returns the current round when the event belongs to it.
+func (g *Game) eventRound(roundID string) (*Round, error) {
+	if g.round == nil || g.round.id.String() != roundID {
+		return nil, ErrRoundNotFound
+	}
+
+	return g.round, nil
+}
+
+func (g *Game) story(storyID common.UID) *Story {
+	for i := range g.stories {
+		if g.stories[i].id == storyID {
+			return &g.stories[i]
+		}
+	}
+
+	return nil
+}
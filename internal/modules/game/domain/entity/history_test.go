@@ -0,0 +1,91 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/event"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+func TestGameReplay(t *testing.T) {
+	ownerID := common.NewUID()
+	memberID := common.NewUID()
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+	require.NoError(t, game.AddMember(memberID, "Bob"))
+
+	story, err := entity.NewStory(game.ID(), "PRJ-1", "Login page", "", "")
+	require.NoError(t, err)
+	_, err = game.AddStories(ownerID, []entity.Story{story})
+	require.NoError(t, err)
+
+	_, _, err = game.StartNextRound(ownerID, time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, game.CastVote(ownerID, "3", fibonacci))
+	require.NoError(t, game.CastVote(memberID, "5", fibonacci))
+	require.NoError(t, game.RevealVotes(ownerID))
+	require.NoError(t, game.CloseRound(ownerID, "5"))
+	require.NoError(t, game.ChangeMemberRole(ownerID, memberID, entity.RoleFacilitator))
+	require.NoError(t, game.TransferOwnership(ownerID, memberID))
+	require.NoError(t, game.Update(memberID, "Sprint 43", game.DeckID()))
+	require.NoError(t, game.Archive(memberID))
+
+	events := game.Events()
+	assert.Equal(t, int64(len(events)), game.Version())
+
+	replayed, err := entity.Rehydrate(nil, 0, events)
+	require.NoError(t, err)
+	assertSameGame(t, game, replayed)
+
+	// Replaying the rest of the history onto a snapshot gives the same state
+	snapshot, err := entity.Rehydrate(nil, 0, events[:5])
+	require.NoError(t, err)
+	restored, err := entity.Rehydrate(&snapshot, 5, events[5:])
+	require.NoError(t, err)
+	assertSameGame(t, game, restored)
+
+	// Votes are part of the history
+	vote, ok := events[4].(event.VoteCastEvent)
+	require.True(t, ok)
+	assert.Equal(t, "3", vote.Value)
+}
+
+func TestGameReplayUnexpectedEvent(t *testing.T) {
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", common.NewUID(), "Owner", common.NewUID())
+	require.NoError(t, err)
+
+	_, err = entity.Rehydrate(&game, game.Version(), []mediator.Event{event.MemberOnlineEvent{}})
+	assert.ErrorIs(t, err, entity.ErrUnexpectedEvent)
+}
+
+func assertSameGame(t *testing.T, expected, actual entity.Game) {
+	t.Helper()
+
+	assert.Equal(t, expected.ID(), actual.ID())
+	assert.Equal(t, expected.Name(), actual.Name())
+	assert.Equal(t, expected.OwnerID(), actual.OwnerID())
+	assert.Equal(t, expected.DeckID(), actual.DeckID())
+	assert.Equal(t, expected.ArchivedAt(), actual.ArchivedAt())
+	assert.Equal(t, expected.CreatedAt(), actual.CreatedAt())
+	assert.Equal(t, expected.UpdatedAt(), actual.UpdatedAt())
+	assert.Equal(t, expected.Version(), actual.Version())
+	assert.Equal(t, expected.Stories(), actual.Stories())
+
+	expectedRound, _ := expected.CurrentRound()
+	actualRound, _ := actual.CurrentRound()
+	assert.Equal(t, expectedRound, actualRound)
+
+	require.Len(t, actual.GameMembers(), len(expected.GameMembers()))
+	for i, member := range expected.GameMembers() {
+		assert.Equal(t, member.UserID(), actual.GameMembers()[i].UserID())
+		assert.Equal(t, member.Name(), actual.GameMembers()[i].Name())
+		assert.Equal(t, member.Role(), actual.GameMembers()[i].Role())
+	}
+}
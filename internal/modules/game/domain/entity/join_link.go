@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+const (
+	joinCodeLength   = 10
+	joinCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	minJoinLinkTTL   = time.Minute
+	maxJoinLinkTTL   = 7 * 24 * time.Hour
+)
+
+var (
+	ErrJoinLinkExpired = errors.New("join link has expired")
+	ErrJoinLinkTTL     = errors.New("join link lifetime is out of range")
+)
+
+// JoinLink is an expiring code that lets any user join the game.
+type JoinLink struct {
+	code      string
+	gameID    common.UID
+	createdBy common.UID
+	expiresAt time.Time
+	createdAt time.Time
+}
+
+func NewJoinLink(gameID, createdBy common.UID, ttl time.Duration) (JoinLink, error) {
+	if ttl < minJoinLinkTTL || ttl > maxJoinLinkTTL {
+		return JoinLink{}, ErrJoinLinkTTL
+	}
+
+	code, err := generateJoinCode()
+	if err != nil {
+		return JoinLink{}, err
+	}
+
+	now := time.Now().UTC()
+
+	return JoinLink{
+		code:      code,
+		gameID:    gameID,
+		createdBy: createdBy,
+		expiresAt: now.Add(ttl),
+		createdAt: now,
+	}, nil
+}
+
+func HydrateJoinLink(code string, gameID, createdBy common.UID, expiresAt, createdAt time.Time) JoinLink {
+	return JoinLink{
+		code:      code,
+		gameID:    gameID,
+		createdBy: createdBy,
+		expiresAt: expiresAt,
+		createdAt: createdAt,
+	}
+}
+
+func (l JoinLink) Code() string {
+	return l.code
+}
+
+func (l JoinLink) GameID() common.UID {
+	return l.gameID
+}
+
+func (l JoinLink) CreatedBy() common.UID {
+	return l.createdBy
+}
+
+func (l JoinLink) ExpiresAt() time.Time {
+	return l.expiresAt
+}
+
+func (l JoinLink) CreatedAt() time.Time {
+	return l.createdAt
+}
+
+// IsExpired reports whether the link can no longer be used at the given time.
+func (l JoinLink) IsExpired(now time.Time) bool {
+	return !now.Before(l.expiresAt)
+}
+
+func generateJoinCode() (string, error) {
+	code := make([]byte, joinCodeLength)
+	alphabetLen := big.NewInt(int64(len(joinCodeAlphabet)))
+
+	for i := range code {
+		n, err := rand.Int(rand.Reader, alphabetLen)
+		if err != nil {
+			return "", err
+		}
+
+		code[i] = joinCodeAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
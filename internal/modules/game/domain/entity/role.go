@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrPermissionDenied = errors.New("user role does not permit the action")
+	ErrInvalidRole      = errors.New("invalid game role")
+)
+
+// Role is the member role stored in game_user.role.
+type Role int
+
+const (
+	RoleOwner Role = iota + 1
+	RoleFacilitator
+	RoleVoter
+	RoleObserver
+)
+
+// ParseRole converts the role name to Role.
+func ParseRole(name string) (Role, error) {
+	for _, role := range []Role{RoleOwner, RoleFacilitator, RoleVoter, RoleObserver} {
+		if role.String() == name {
+			return role, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrInvalidRole, name)
+}
+
+func (r Role) String() string {
+	switch r {
+	case RoleOwner:
+		return "owner"
+	case RoleFacilitator:
+		return "facilitator"
+	case RoleVoter:
+		return "voter"
+	case RoleObserver:
+		return "observer"
+	default:
+		return "unknown"
+	}
+}
+
+// CanFacilitate reports whether the role may drive rounds: start, reveal, reset and close them.
+func (r Role) CanFacilitate() bool {
+	return r == RoleOwner || r == RoleFacilitator
+}
+
+// CanVote reports whether the role may cast votes.
+func (r Role) CanVote() bool {
+	return r == RoleOwner || r == RoleFacilitator || r == RoleVoter
+}
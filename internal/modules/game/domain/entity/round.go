@@ -0,0 +1,317 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+const (
+	maxCardLength  = 16
+	maxRoundTimer  = time.Hour
+	minRoundTimer  = 5 * time.Second
+	roundTimerUnit = time.Second
+)
+
+var (
+	ErrRoundNotFound        = errors.New("round not found")
+	ErrRoundInProgress      = errors.New("round already in progress")
+	ErrRoundNotVoting       = errors.New("round is not accepting votes")
+	ErrRoundNotRevealed     = errors.New("round votes are not revealed")
+	ErrEmptyTopic           = errors.New("round topic cannot be empty")
+	ErrEmptyCard            = errors.New("card value cannot be empty")
+	ErrCardTooLong          = errors.New("card value is too long")
+	ErrNotGameMember        = errors.New("user is not a game member")
+	ErrCardNotInDeck        = errors.New("card is not in the game deck")
+	ErrInvalidRoundTimer    = errors.New("round timer must be between 5 seconds and 1 hour")
+	ErrRoundTimerNotExpired = errors.New("round timer has not expired")
+)
+
+// RoundStatus represents the stage of an estimation round.
+type RoundStatus int
+
+const (
+	RoundVoting RoundStatus = iota + 1
+	RoundRevealed
+	RoundClosed
+)
+
+func (s RoundStatus) String() string {
+	switch s {
+	case RoundVoting:
+		return "voting"
+	case RoundRevealed:
+		return "revealed"
+	case RoundClosed:
+		return "closed"
+	default:
+		return "unknown"
+	}
+}
+
+// Vote is a card value cast by a game member in a round.
+type Vote struct {
+	userID    common.UID
+	value     string
+	createdAt time.Time
+}
+
+func NewVote(userID common.UID, value string) (Vote, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Vote{}, ErrEmptyCard
+	}
+
+	if len(value) > maxCardLength {
+		return Vote{}, ErrCardTooLong
+	}
+
+	return Vote{
+		userID:    userID,
+		value:     value,
+		createdAt: time.Now().UTC(),
+	}, nil
+}
+
+func HydrateVote(userID common.UID, value string, createdAt time.Time) Vote {
+	return Vote{
+		userID:    userID,
+		value:     value,
+		createdAt: createdAt,
+	}
+}
+
+func (v Vote) UserID() common.UID {
+	return v.userID
+}
+
+func (v Vote) Value() string {
+	return v.value
+}
+
+func (v Vote) CreatedAt() time.Time {
+	return v.createdAt
+}
+
+// Round struct.
+type Round struct {
+	id        common.UID
+	gameID    common.UID
+	topic     string
+	status    RoundStatus
+	votes     []Vote
+	estimate  string
+	timer     time.Duration
+	deadline  *time.Time
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewRound opens a voting round. A non zero timer reveals the votes automatically
+// once it expires.
+func NewRound(gameID common.UID, topic string, timer time.Duration) (Round, error) {
+	topic = strings.TrimSpace(topic)
+	if topic == "" {
+		return Round{}, ErrEmptyTopic
+	}
+
+	if timer != 0 && (timer < minRoundTimer || timer > maxRoundTimer) {
+		return Round{}, ErrInvalidRoundTimer
+	}
+
+	now := time.Now().UTC()
+
+	round := Round{
+		id:        common.NewUID(),
+		gameID:    gameID,
+		topic:     topic,
+		status:    RoundVoting,
+		votes:     make([]Vote, 0),
+		timer:     timer.Truncate(roundTimerUnit),
+		createdAt: now,
+		updatedAt: now,
+	}
+	round.startTimer(now)
+
+	return round, nil
+}
+
+func HydrateRound(
+	id common.UID,
+	gameID common.UID,
+	topic string,
+	status RoundStatus,
+	votes []Vote,
+	estimate string,
+	timer time.Duration,
+	deadline *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Round {
+	return Round{
+		id:        id,
+		gameID:    gameID,
+		topic:     topic,
+		status:    status,
+		votes:     votes,
+		estimate:  estimate,
+		timer:     timer,
+		deadline:  deadline,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (r *Round) ID() common.UID {
+	return r.id
+}
+
+func (r *Round) GameID() common.UID {
+	return r.gameID
+}
+
+func (r *Round) Topic() string {
+	return r.topic
+}
+
+func (r *Round) Status() RoundStatus {
+	return r.status
+}
+
+// Votes returns the votes cast in the round.
+func (r *Round) Votes() []Vote {
+	return r.votes
+}
+
+// HasVoted reports whether the user has cast a vote in the round.
+func (r *Round) HasVoted(userID common.UID) bool {
+	for _, vote := range r.votes {
+		if vote.userID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Round) Estimate() string {
+	return r.estimate
+}
+
+// Timer returns the voting countdown of the round, zero when the round has no timer.
+func (r *Round) Timer() time.Duration {
+	return r.timer
+}
+
+// Deadline returns the moment the votes are revealed automatically, nil when no countdown is running.
+func (r *Round) Deadline() *time.Time {
+	return r.deadline
+}
+
+// IsExpired reports whether the countdown of the voting round is over.
+func (r *Round) IsExpired(now time.Time) bool {
+	return r.status == RoundVoting && r.deadline != nil && !now.Before(*r.deadline)
+}
+
+func (r *Round) IsActive() bool {
+	return r.status == RoundVoting || r.status == RoundRevealed
+}
+
+func (r *Round) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *Round) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+func (r *Round) castVote(vote Vote) error {
+	if r.status != RoundVoting {
+		return ErrRoundNotVoting
+	}
+
+	for i := range r.votes {
+		if r.votes[i].userID == vote.userID {
+			r.votes[i] = vote
+			r.touch(vote.createdAt)
+
+			return nil
+		}
+	}
+
+	r.votes = append(r.votes, vote)
+	r.touch(vote.createdAt)
+
+	return nil
+}
+
+func (r *Round) reveal(now time.Time) error {
+	if r.status != RoundVoting {
+		return ErrRoundNotVoting
+	}
+
+	r.status = RoundRevealed
+	r.deadline = nil
+	r.touch(now)
+
+	return nil
+}
+
+func (r *Round) reset(now time.Time) error {
+	if !r.IsActive() {
+		return ErrRoundNotFound
+	}
+
+	r.status = RoundVoting
+	r.votes = make([]Vote, 0)
+	r.touch(now)
+	r.startTimer(now)
+
+	return nil
+}
+
+func (r *Round) close(estimate string, now time.Time) error {
+	if r.status != RoundRevealed {
+		return ErrRoundNotRevealed
+	}
+
+	r.status = RoundClosed
+	r.estimate = strings.TrimSpace(estimate)
+	r.deadline = nil
+	r.touch(now)
+
+	return nil
+}
+
+func (r *Round) startTimer(now time.Time) {
+	r.deadline = r.timerDeadline(now)
+}
+
+// timerDeadline returns the end of the countdown started at the given time.
+func (r *Round) timerDeadline(now time.Time) *time.Time {
+	if r.timer == 0 {
+		return nil
+	}
+
+	deadline := now.Add(r.timer)
+
+	return &deadline
+}
+
+func (r *Round) touch(now time.Time) {
+	r.updatedAt = now
+}
+
+// String returns the string representation of the round.
+func (r *Round) String() string {
+	return fmt.Sprintf(
+		"Round{ID: %s, GameID: %s, Topic: %s, Status: %s}",
+		r.ID(),
+		r.GameID(),
+		r.Topic(),
+		r.Status(),
+	)
+}
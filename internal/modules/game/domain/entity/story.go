@@ -0,0 +1,233 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+const (
+	maxStoryKeyLength         = 32
+	maxStoryTitleLength       = 255
+	maxStoryDescriptionLength = 4000
+	maxStoryLinkLength        = 2048
+)
+
+var (
+	ErrEmptyStoryTitle      = errors.New("story title cannot be empty")
+	ErrStoryKeyTooLong      = errors.New("story key is too long")
+	ErrStoryTitleTooLong    = errors.New("story title is too long")
+	ErrStoryDescriptionLong = errors.New("story description is too long")
+	ErrInvalidStoryLink     = errors.New("story link must be an absolute http(s) url")
+	ErrNoPendingStories     = errors.New("game has no pending stories")
+	ErrInvalidStories       = errors.New("invalid stories")
+)
+
+// StoryStatus represents the stage of a story in the game queue.
+type StoryStatus int
+
+const (
+	StoryPending StoryStatus = iota + 1
+	StoryEstimating
+	StoryEstimated
+)
+
+func (s StoryStatus) String() string {
+	switch s {
+	case StoryPending:
+		return "pending"
+	case StoryEstimating:
+		return "estimating"
+	case StoryEstimated:
+		return "estimated"
+	default:
+		return "unknown"
+	}
+}
+
+// Story is a backlog item queued for estimation in the game.
+type Story struct {
+	id          common.UID
+	gameID      common.UID
+	key         string
+	title       string
+	description string
+	link        string
+	position    int
+	status      StoryStatus
+	roundID     *common.UID
+	estimate    string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewStory validates the backlog item. Position is assigned when the story is added to the game.
+func NewStory(gameID common.UID, key, title, description, link string) (Story, error) {
+	key = strings.TrimSpace(key)
+	if len(key) > maxStoryKeyLength {
+		return Story{}, ErrStoryKeyTooLong
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return Story{}, ErrEmptyStoryTitle
+	}
+
+	if len(title) > maxStoryTitleLength {
+		return Story{}, ErrStoryTitleTooLong
+	}
+
+	description = strings.TrimSpace(description)
+	if len(description) > maxStoryDescriptionLength {
+		return Story{}, ErrStoryDescriptionLong
+	}
+
+	link = strings.TrimSpace(link)
+	if link != "" {
+		u, err := url.Parse(link)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(link) > maxStoryLinkLength {
+			return Story{}, ErrInvalidStoryLink
+		}
+	}
+
+	now := time.Now().UTC()
+
+	return Story{
+		id:          common.NewUID(),
+		gameID:      gameID,
+		key:         key,
+		title:       title,
+		description: description,
+		link:        link,
+		status:      StoryPending,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+func HydrateStory(
+	id common.UID,
+	gameID common.UID,
+	key, title, description, link string,
+	position int,
+	status StoryStatus,
+	roundID *common.UID,
+	estimate string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Story {
+	return Story{
+		id:          id,
+		gameID:      gameID,
+		key:         key,
+		title:       title,
+		description: description,
+		link:        link,
+		position:    position,
+		status:      status,
+		roundID:     roundID,
+		estimate:    estimate,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+func (s Story) ID() common.UID {
+	return s.id
+}
+
+func (s Story) GameID() common.UID {
+	return s.gameID
+}
+
+func (s Story) Key() string {
+	return s.key
+}
+
+func (s Story) Title() string {
+	return s.title
+}
+
+func (s Story) Description() string {
+	return s.description
+}
+
+func (s Story) Link() string {
+	return s.link
+}
+
+// Position returns the story place in the game queue starting from 1.
+func (s Story) Position() int {
+	return s.position
+}
+
+func (s Story) Status() StoryStatus {
+	return s.status
+}
+
+// RoundID returns the round estimating the story, nil while the story is pending.
+func (s Story) RoundID() *common.UID {
+	return s.roundID
+}
+
+func (s Story) Estimate() string {
+	return s.estimate
+}
+
+func (s Story) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s Story) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// Topic returns the round topic for the story.
+func (s Story) Topic() string {
+	if s.key == "" {
+		return s.title
+	}
+
+	return s.key + " " + s.title
+}
+
+func (s *Story) start(roundID common.UID, now time.Time) {
+	s.status = StoryEstimating
+	s.roundID = &roundID
+	s.updatedAt = now
+}
+
+func (s *Story) finish(estimate string, now time.Time) {
+	s.status = StoryEstimated
+	s.estimate = estimate
+	s.updatedAt = now
+}
+
+// StoryRowError describes an invalid row of the imported backlog. Rows are numbered from 1.
+type StoryRowError struct {
+	Row   int
+	Field string
+	Value string
+	Err   error
+}
+
+func (e StoryRowError) Error() string {
+	return fmt.Sprintf("row %d: %s: %v", e.Row, e.Field, e.Err)
+}
+
+// StoryImportError collects every invalid row of the imported backlog.
+type StoryImportError struct {
+	Rows []StoryRowError
+}
+
+func (e *StoryImportError) Error() string {
+	return fmt.Sprintf("%v: %d invalid rows", ErrInvalidStories, len(e.Rows))
+}
+
+func (e *StoryImportError) Unwrap() error {
+	return ErrInvalidStories
+}
@@ -0,0 +1,23 @@
+package event
+
+import "time"
+
+const VoteCast = "VoteCast"
+
+// VoteCastEvent intentionally never serializes the card value, votes stay hidden
+// from the room until reveal. The value is kept only in the game event store.
+type VoteCastEvent struct {
+	RoundID string    `json:"roundId"`
+	GameID  string    `json:"gameId"`
+	UserID  string    `json:"userId"`
+	Value   string    `json:"-"`
+	CastAt  time.Time `json:"castAt"`
+}
+
+func (e VoteCastEvent) Kind() string {
+	return VoteCast
+}
+
+func (e VoteCastEvent) Room() string {
+	return e.GameID
+}
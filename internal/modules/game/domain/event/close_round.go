@@ -0,0 +1,20 @@
+package event
+
+import "time"
+
+const RoundClosed = "RoundClosed"
+
+type RoundClosedEvent struct {
+	RoundID  string    `json:"roundId"`
+	GameID   string    `json:"gameId"`
+	Estimate string    `json:"estimate"`
+	ClosedAt time.Time `json:"closedAt"`
+}
+
+func (e RoundClosedEvent) Kind() string {
+	return RoundClosed
+}
+
+func (e RoundClosedEvent) Room() string {
+	return e.GameID
+}
@@ -1,10 +1,17 @@
 package event
 
+import "time"
+
 const GameCreated = "GameCreated"
 
 type GameCreatedEvent struct {
-	ID   string
-	Name string
+	ID             string
+	OrganizationID string
+	Name           string
+	OwnerID        string
+	OwnerName      string
+	DeckID         string
+	CreatedAt      time.Time
 }
 
 func (e GameCreatedEvent) Kind() string {
@@ -0,0 +1,19 @@
+package event
+
+import "time"
+
+const GameArchived = "GameArchived"
+
+type GameArchivedEvent struct {
+	GameID     string    `json:"gameId"`
+	ArchivedBy string    `json:"archivedBy"`
+	ArchivedAt time.Time `json:"archivedAt"`
+}
+
+func (e GameArchivedEvent) Kind() string {
+	return GameArchived
+}
+
+func (e GameArchivedEvent) Room() string {
+	return e.GameID
+}
@@ -0,0 +1,19 @@
+package event
+
+import "time"
+
+const GameDeleted = "GameDeleted"
+
+type GameDeletedEvent struct {
+	GameID    string    `json:"gameId"`
+	DeletedBy string    `json:"deletedBy"`
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+func (e GameDeletedEvent) Kind() string {
+	return GameDeleted
+}
+
+func (e GameDeletedEvent) Room() string {
+	return e.GameID
+}
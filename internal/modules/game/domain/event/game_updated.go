@@ -0,0 +1,20 @@
+package event
+
+import "time"
+
+const GameUpdated = "GameUpdated"
+
+type GameUpdatedEvent struct {
+	GameID    string    `json:"gameId"`
+	Name      string    `json:"name"`
+	DeckID    string    `json:"deckId"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (e GameUpdatedEvent) Kind() string {
+	return GameUpdated
+}
+
+func (e GameUpdatedEvent) Room() string {
+	return e.GameID
+}
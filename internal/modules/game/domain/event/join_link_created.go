@@ -0,0 +1,16 @@
+package event
+
+import "time"
+
+const JoinLinkCreated = "JoinLinkCreated"
+
+type JoinLinkCreatedEvent struct {
+	GameID    string
+	Code      string
+	CreatedBy string
+	ExpiresAt time.Time
+}
+
+func (e JoinLinkCreatedEvent) Kind() string {
+	return JoinLinkCreated
+}
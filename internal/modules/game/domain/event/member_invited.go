@@ -0,0 +1,13 @@
+package event
+
+const MemberInvited = "MemberInvited"
+
+type MemberInvitedEvent struct {
+	GameID    string
+	UserID    string
+	InvitedBy string
+}
+
+func (e MemberInvitedEvent) Kind() string {
+	return MemberInvited
+}
@@ -0,0 +1,17 @@
+package event
+
+const MemberJoined = "MemberJoined"
+
+type MemberJoinedEvent struct {
+	GameID string `json:"gameId"`
+	UserID string `json:"userId"`
+	Name   string `json:"name"`
+}
+
+func (e MemberJoinedEvent) Kind() string {
+	return MemberJoined
+}
+
+func (e MemberJoinedEvent) Room() string {
+	return e.GameID
+}
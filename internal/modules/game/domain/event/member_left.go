@@ -0,0 +1,16 @@
+package event
+
+const MemberLeft = "MemberLeft"
+
+type MemberLeftEvent struct {
+	GameID string `json:"gameId"`
+	UserID string `json:"userId"`
+}
+
+func (e MemberLeftEvent) Kind() string {
+	return MemberLeft
+}
+
+func (e MemberLeftEvent) Room() string {
+	return e.GameID
+}
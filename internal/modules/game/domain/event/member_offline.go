@@ -0,0 +1,16 @@
+package event
+
+const MemberOffline = "MemberOffline"
+
+type MemberOfflineEvent struct {
+	GameID string `json:"gameId"`
+	UserID string `json:"userId"`
+}
+
+func (e MemberOfflineEvent) Kind() string {
+	return MemberOffline
+}
+
+func (e MemberOfflineEvent) Room() string {
+	return e.GameID
+}
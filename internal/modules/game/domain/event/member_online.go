@@ -0,0 +1,16 @@
+package event
+
+const MemberOnline = "MemberOnline"
+
+type MemberOnlineEvent struct {
+	GameID string `json:"gameId"`
+	UserID string `json:"userId"`
+}
+
+func (e MemberOnlineEvent) Kind() string {
+	return MemberOnline
+}
+
+func (e MemberOnlineEvent) Room() string {
+	return e.GameID
+}
@@ -0,0 +1,17 @@
+package event
+
+const MemberRemoved = "MemberRemoved"
+
+type MemberRemovedEvent struct {
+	GameID    string `json:"gameId"`
+	UserID    string `json:"userId"`
+	RemovedBy string `json:"removedBy"`
+}
+
+func (e MemberRemovedEvent) Kind() string {
+	return MemberRemoved
+}
+
+func (e MemberRemovedEvent) Room() string {
+	return e.GameID
+}
@@ -0,0 +1,17 @@
+package event
+
+const MemberRoleChanged = "MemberRoleChanged"
+
+type MemberRoleChangedEvent struct {
+	GameID string `json:"gameId"`
+	UserID string `json:"userId"`
+	Role   string `json:"role"`
+}
+
+func (e MemberRoleChangedEvent) Kind() string {
+	return MemberRoleChanged
+}
+
+func (e MemberRoleChangedEvent) Room() string {
+	return e.GameID
+}
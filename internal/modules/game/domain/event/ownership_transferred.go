@@ -0,0 +1,20 @@
+package event
+
+import "time"
+
+const OwnershipTransferred = "OwnershipTransferred"
+
+type OwnershipTransferredEvent struct {
+	GameID          string    `json:"gameId"`
+	PreviousOwnerID string    `json:"previousOwnerId"`
+	OwnerID         string    `json:"ownerId"`
+	TransferredAt   time.Time `json:"transferredAt"`
+}
+
+func (e OwnershipTransferredEvent) Kind() string {
+	return OwnershipTransferred
+}
+
+func (e OwnershipTransferredEvent) Room() string {
+	return e.GameID
+}
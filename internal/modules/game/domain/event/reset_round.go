@@ -0,0 +1,20 @@
+package event
+
+import "time"
+
+const RoundReset = "RoundReset"
+
+type RoundResetEvent struct {
+	RoundID  string     `json:"roundId"`
+	GameID   string     `json:"gameId"`
+	Deadline *time.Time `json:"deadline,omitempty"`
+	ResetAt  time.Time  `json:"resetAt"`
+}
+
+func (e RoundResetEvent) Kind() string {
+	return RoundReset
+}
+
+func (e RoundResetEvent) Room() string {
+	return e.GameID
+}
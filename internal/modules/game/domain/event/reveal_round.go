@@ -0,0 +1,22 @@
+package event
+
+import "time"
+
+const RoundRevealed = "RoundRevealed"
+
+type RoundRevealedEvent struct {
+	RoundID string            `json:"roundId"`
+	GameID  string            `json:"gameId"`
+	Votes   map[string]string `json:"votes"`
+	// Auto is set when the votes were revealed by the round timer.
+	Auto       bool      `json:"auto,omitempty"`
+	RevealedAt time.Time `json:"revealedAt"`
+}
+
+func (e RoundRevealedEvent) Kind() string {
+	return RoundRevealed
+}
+
+func (e RoundRevealedEvent) Room() string {
+	return e.GameID
+}
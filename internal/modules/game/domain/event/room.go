@@ -0,0 +1,7 @@
+package event
+
+// RoomEvent is a game event streamed to the clients connected to the game room.
+type RoomEvent interface {
+	Kind() string
+	Room() string
+}
@@ -0,0 +1,24 @@
+package event
+
+import "time"
+
+const RoundStarted = "RoundStarted"
+
+type RoundStartedEvent struct {
+	ID      string `json:"id"`
+	GameID  string `json:"gameId"`
+	Topic   string `json:"topic"`
+	StoryID string `json:"storyId,omitempty"`
+	// Timer is the voting countdown in seconds, zero when the round has no timer.
+	Timer     int        `json:"timer,omitempty"`
+	Deadline  *time.Time `json:"deadline,omitempty"`
+	StartedAt time.Time  `json:"startedAt"`
+}
+
+func (e RoundStartedEvent) Kind() string {
+	return RoundStarted
+}
+
+func (e RoundStartedEvent) Room() string {
+	return e.GameID
+}
@@ -0,0 +1,30 @@
+package event
+
+import "time"
+
+const StoriesImported = "StoriesImported"
+
+// ImportedStory is a backlog item appended to the game queue.
+type ImportedStory struct {
+	ID          string `json:"id"`
+	Key         string `json:"key,omitempty"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Link        string `json:"link,omitempty"`
+	Position    int    `json:"position"`
+}
+
+type StoriesImportedEvent struct {
+	GameID     string          `json:"gameId"`
+	Count      int             `json:"count"`
+	Stories    []ImportedStory `json:"stories"`
+	ImportedAt time.Time       `json:"importedAt"`
+}
+
+func (e StoriesImportedEvent) Kind() string {
+	return StoriesImported
+}
+
+func (e StoriesImportedEvent) Room() string {
+	return e.GameID
+}
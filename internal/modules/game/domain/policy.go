@@ -0,0 +1,6 @@
+package domain
+
+// CardDeck is the set of cards allowed in a game.
+type CardDeck interface {
+	HasCard(value string) bool
+}
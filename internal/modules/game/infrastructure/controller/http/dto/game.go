@@ -1,30 +1,184 @@
 package dto
 
 type GameShortDTO struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	User      string `json:"user"`
-	CreatedAt string `json:"createdAt"`
-	UpdatedAt string `json:"updateAt"`
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	User           string `json:"user"`
+	Archived       bool   `json:"archived"`
+	MemberCount    int    `json:"memberCount"`
+	RoundStatus    string `json:"roundStatus,omitempty"`
+	LastActivityAt string `json:"lastActivityAt"`
+	CreatedAt      string `json:"createdAt"`
+	UpdatedAt      string `json:"updateAt"`
 }
 
 type GameDTO struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	User      string `json:"user"`
-	CreatedAt string `json:"createdAt"`
-	UpdatedAt string `json:"updateAt"`
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	DeckID     string      `json:"deckId"`
+	User       string      `json:"user"`
+	Members    []MemberDTO `json:"members"`
+	ArchivedAt string      `json:"archivedAt,omitempty"`
+	CreatedAt  string      `json:"createdAt"`
+	UpdatedAt  string      `json:"updateAt"`
+}
+
+type MemberDTO struct {
+	UserID string `json:"userId"`
+	Name   string `json:"name"`
+	Role   string `json:"role"`
+}
+
+// PresenceDTO is the presence of game members in the game room.
+type PresenceDTO struct {
+	// RoundID is the active round the votes are reported for.
+	RoundID string              `json:"roundId,omitempty"`
+	Members []MemberPresenceDTO `json:"members"`
+}
+
+type MemberPresenceDTO struct {
+	UserID string `json:"userId"`
+	Name   string `json:"name"`
+	Role   string `json:"role"`
+	Online bool   `json:"online"`
+	Voted  bool   `json:"voted"`
+}
+
+type UpdateGameDTO struct {
+	Name   *string `json:"name" validate:"omitempty,min=1,max=255"`
+	DeckID *string `json:"deckId" validate:"omitempty,uuid"`
 }
 
 type CreateGameDTO struct {
-	Name string `json:"name" validate:"required"`
+	Name   string `json:"name" validate:"required"`
+	DeckID string `json:"deckId" validate:"omitempty,uuid"`
 }
 
 type FetchGamesDTO struct {
-	Limit  int64 `query:"limit" validate:"gte=0,lte=1000"`
-	Offset int64 `query:"offset" validate:"gte=0,lte=1000"`
+	Limit    int64 `query:"limit" validate:"gte=0,lte=1000"`
+	Offset   int64 `query:"offset" validate:"gte=0,lte=1000"`
+	Archived bool  `query:"archived"`
 }
 
 type FetchGameDTO struct {
 	ID string `json:"id"`
 }
+
+type VoteDTO struct {
+	UserID string `json:"userId"`
+	Name   string `json:"name"`
+	Voted  bool   `json:"voted"`
+	Value  string `json:"value,omitempty"`
+}
+
+type RoundDTO struct {
+	ID       string    `json:"id"`
+	GameID   string    `json:"gameId"`
+	Topic    string    `json:"topic"`
+	Status   string    `json:"status"`
+	Estimate string    `json:"estimate,omitempty"`
+	Votes    []VoteDTO `json:"votes"`
+	// Timer is the voting countdown in seconds.
+	Timer     int    `json:"timer,omitempty"`
+	Deadline  string `json:"deadline,omitempty"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updateAt"`
+}
+
+// StartRoundDTO starts a round. Timer is an optional countdown in seconds
+// after which the votes are revealed automatically.
+type StartRoundDTO struct {
+	Topic string `json:"topic" validate:"required,max=255"`
+	Timer int    `json:"timer" validate:"omitempty,min=5,max=3600"`
+}
+
+type StartNextRoundDTO struct {
+	Timer int `json:"timer" validate:"omitempty,min=5,max=3600"`
+}
+
+type CastVoteDTO struct {
+	Value string `json:"value" validate:"required,max=16"`
+}
+
+type CloseRoundDTO struct {
+	Estimate string `json:"estimate" validate:"max=16"`
+}
+
+// RoomMessageDTO is a message pushed to the clients of the game room.
+type RoomMessageDTO struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+type InviteMemberDTO struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type CreateJoinLinkDTO struct {
+	// ExpiresIn is the link lifetime in seconds.
+	ExpiresIn int64 `json:"expiresIn" validate:"omitempty,gte=60,lte=604800"`
+}
+
+type JoinLinkDTO struct {
+	Code      string `json:"code"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+type ChangeMemberRoleDTO struct {
+	Role string `json:"role" validate:"required,oneof=facilitator voter observer"`
+}
+
+type TransferOwnershipDTO struct {
+	UserID string `json:"userId" validate:"required,uuid"`
+}
+
+// StatsDTO holds the revealed votes statistics. Average, median and spread are
+// omitted when there are no numeric cards.
+type StatsDTO struct {
+	Votes     int      `json:"votes"`
+	Average   *float64 `json:"average,omitempty"`
+	Median    *float64 `json:"median,omitempty"`
+	Spread    *float64 `json:"spread,omitempty"`
+	Mode      []string `json:"mode"`
+	Consensus bool     `json:"consensus"`
+}
+
+type EstimationDTO struct {
+	ID         string    `json:"id"`
+	RoundID    string    `json:"roundId"`
+	Topic      string    `json:"topic"`
+	Estimate   string    `json:"estimate,omitempty"`
+	Votes      []VoteDTO `json:"votes"`
+	Stats      StatsDTO  `json:"stats"`
+	RevealedAt string    `json:"revealedAt"`
+}
+
+type FetchHistoryDTO struct {
+	Limit  int64 `query:"limit" validate:"gte=0,lte=1000"`
+	Offset int64 `query:"offset" validate:"gte=0"`
+}
+
+type HistoryDTO struct {
+	Items  []EstimationDTO `json:"items"`
+	Total  int64           `json:"total"`
+	Limit  int64           `json:"limit"`
+	Offset int64           `json:"offset"`
+}
+
+type GameExportDTO struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	ExportedAt  string          `json:"exportedAt"`
+	Estimations []EstimationDTO `json:"estimations"`
+}
+
+type StoryDTO struct {
+	ID          string `json:"id"`
+	Key         string `json:"key,omitempty"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Link        string `json:"link,omitempty"`
+	Position    int    `json:"position"`
+	Status      string `json:"status"`
+	Estimate    string `json:"estimate,omitempty"`
+}
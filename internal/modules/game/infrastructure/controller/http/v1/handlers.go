@@ -14,9 +14,11 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/KyKyPy3/clean/internal/application/core"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
 	http_dto "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
 	"github.com/KyKyPy3/clean/internal/modules/game/application/command"
 	"github.com/KyKyPy3/clean/internal/modules/game/application/query"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
 	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
 	"github.com/KyKyPy3/clean/pkg/logger"
 )
@@ -51,18 +53,45 @@ func NewGameHandlers(v1 *echo.Group, commands CommandBus, queries QueryBus, logg
 
 	v1.GET("/game", handlers.Fetch)
 	v1.POST("/game", handlers.Create)
+	v1.GET("/game/:id", handlers.FetchOne)
+	v1.PATCH("/game/:id", handlers.Update)
+	v1.DELETE("/game/:id", handlers.Delete)
+	v1.POST("/game/:id/archive", handlers.Archive)
+	v1.POST("/game/:id/transfer", handlers.TransferOwnership)
+	v1.GET("/game/:id/presence", handlers.FetchPresence)
+	v1.GET("/game/:id/round", handlers.FetchRound)
+	v1.POST("/game/:id/round", handlers.StartRound)
+	v1.POST("/game/:id/round/vote", handlers.CastVote)
+	v1.POST("/game/:id/round/reveal", handlers.RevealRound)
+	v1.POST("/game/:id/round/reset", handlers.ResetRound)
+	v1.POST("/game/:id/round/close", handlers.CloseRound)
+	v1.POST("/game/:id/round/next", handlers.StartNextRound)
+	v1.GET("/game/:id/stories", handlers.FetchStories)
+	v1.POST("/game/:id/stories", handlers.ImportStories)
+	v1.GET("/game/:id/round/:roundId/stats", handlers.FetchRoundStats)
+	v1.GET("/game/:id/history", handlers.FetchHistory)
+	v1.GET("/game/:id/export", handlers.Export)
+	v1.POST("/game/:id/invite", handlers.InviteMember)
+	v1.POST("/game/:id/link", handlers.CreateJoinLink)
+	v1.POST("/game/join/:code", handlers.JoinGame)
+	v1.POST("/game/:id/leave", handlers.LeaveGame)
+	v1.DELETE("/game/:id/member/:userId", handlers.RemoveMember)
+	v1.PUT("/game/:id/member/:userId/role", handlers.ChangeMemberRole)
 }
 
 // Fetch godoc
 // @Summary Fetch games
-// @Description Fetch games handler
+// @Description Fetch games handler, archived games are skipped unless archived=true
 // @Tags Game
 // @Accept json
 // @Produce json
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Param archived query bool false "Include archived games"
 // @Success 201 {object} entity.Game
 // @Router /game [get]
 func (g *GameHandlers) Fetch(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
 	defer cancel()
 
 	var errorList []*http_dto.ValidationError
@@ -76,6 +105,7 @@ func (g *GameHandlers) Fetch(c echo.Context) error {
 		FailFast(false).
 		Int64("limit", &opts.Limit).
 		Int64("offset", &opts.Offset).
+		Bool("archived", &opts.Archived).
 		BindErrors()
 	if errs != nil {
 		for _, err := range errs {
@@ -123,8 +153,9 @@ func (g *GameHandlers) Fetch(c echo.Context) error {
 	}
 
 	q := query.FetchGamesQuery{
-		Offset: opts.Offset,
-		Limit:  opts.Limit,
+		Offset:   opts.Offset,
+		Limit:    opts.Limit,
+		Archived: opts.Archived,
 	}
 	games, err := g.Queries.Ask(ctx, q)
 	if err != nil {
@@ -138,9 +169,9 @@ func (g *GameHandlers) Fetch(c echo.Context) error {
 		)
 	}
 
-	gamesList, ok := games.([]dto.GameDTO)
+	gamesList, ok := games.([]dto.GameShortDTO)
 	if !ok {
-		return errors.New("invalid type assertion: expected []dto.GameDTO")
+		return errors.New("invalid type assertion: expected []dto.GameShortDTO")
 	}
 
 	return c.JSON(
@@ -229,7 +260,18 @@ func (g *GameHandlers) Create(c echo.Context) error {
 		)
 	}
 
-	cmd := command.NewCreateGameCommand(params.Name, userID)
+	organizationID, ok := c.Get("organization_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	cmd := command.NewCreateGameCommand(organizationID, params.Name, params.DeckID, userID)
 	_, err = g.Commands.Dispatch(ctx, cmd)
 	if err != nil {
 		g.Logger.Errorf("Failed to create registration %w", err)
@@ -252,3 +294,669 @@ func (g *GameHandlers) Create(c echo.Context) error {
 		},
 	)
 }
+
+// FetchRound godoc
+// @Summary Fetch current round
+// @Description Fetch current round of the game. Card values are hidden until the round is revealed
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200 {object} dto.RoundDTO
+// @Router /game/{id}/round [get]
+func (g *GameHandlers) FetchRound(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.FetchRound")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	guest, _ := c.Get("guest").(bool)
+	round, err := g.Queries.Ask(ctx, query.FetchRoundQuery{GameID: c.Param("id"), UserID: userID, Guest: guest})
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data: map[string]interface{}{
+				"round": round,
+			},
+		},
+	)
+}
+
+// StartRound godoc
+// @Summary Start round
+// @Description Start round handler
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200
+// @Router /game/{id}/round [post]
+func (g *GameHandlers) StartRound(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.StartRound")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.StartRoundDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	roundID, err := g.Commands.Dispatch(ctx, command.NewStartRoundCommand(
+		c.Param("id"),
+		userID,
+		params.Topic,
+		time.Duration(params.Timer)*time.Second,
+	))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusCreated,
+		http_dto.ResponseDTO{
+			Status:  http.StatusCreated,
+			Message: "success",
+			Data: map[string]interface{}{
+				"id": roundID,
+			},
+		},
+	)
+}
+
+// CastVote godoc
+// @Summary Cast vote
+// @Description Cast vote handler
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200
+// @Router /game/{id}/round/vote [post]
+func (g *GameHandlers) CastVote(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.CastVote")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.CastVoteDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err = g.Commands.Dispatch(ctx, command.NewCastVoteCommand(c.Param("id"), userID, params.Value))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// RevealRound godoc
+// @Summary Reveal votes
+// @Description Reveal votes handler
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200
+// @Router /game/{id}/round/reveal [post]
+func (g *GameHandlers) RevealRound(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.RevealRound")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err := g.Commands.Dispatch(ctx, command.NewRevealRoundCommand(c.Param("id"), userID))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// ResetRound godoc
+// @Summary Reset round
+// @Description Reset round handler
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200
+// @Router /game/{id}/round/reset [post]
+func (g *GameHandlers) ResetRound(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.ResetRound")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err := g.Commands.Dispatch(ctx, command.NewResetRoundCommand(c.Param("id"), userID))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// CloseRound godoc
+// @Summary Close round
+// @Description Close round handler
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200
+// @Router /game/{id}/round/close [post]
+func (g *GameHandlers) CloseRound(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.CloseRound")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.CloseRoundDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err = g.Commands.Dispatch(ctx, command.NewCloseRoundCommand(c.Param("id"), userID, params.Estimate))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// Delete godoc
+// @Summary Delete game
+// @Description Delete game handler, allowed to the game owner only
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200
+// @Router /game/{id} [delete]
+func (g *GameHandlers) Delete(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.Delete")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err := g.Commands.Dispatch(ctx, command.NewDeleteGameCommand(c.Param("id"), userID))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// FetchOne godoc
+// @Summary Fetch game
+// @Description Fetch game with its members
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200 {object} dto.GameDTO
+// @Router /game/{id} [get]
+func (g *GameHandlers) FetchOne(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.FetchOne")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	guest, _ := c.Get("guest").(bool)
+	game, err := g.Queries.Ask(ctx, query.FetchGameQuery{ID: c.Param("id"), UserID: userID, Guest: guest})
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data:    game,
+		},
+	)
+}
+
+// Update godoc
+// @Summary Update game
+// @Description Rename game or switch its deck
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Param params body dto.UpdateGameDTO true "Game params"
+// @Success 200
+// @Router /game/{id} [patch]
+func (g *GameHandlers) Update(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.Update")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.UpdateGameDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err = g.Commands.Dispatch(
+		ctx,
+		command.NewUpdateGameCommand(c.Param("id"), userID, params.Name, params.DeckID),
+	)
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// Archive godoc
+// @Summary Archive game
+// @Description Make game read-only and hide it from the games list
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200
+// @Router /game/{id}/archive [post]
+func (g *GameHandlers) Archive(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.Archive")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err := g.Commands.Dispatch(ctx, command.NewArchiveGameCommand(c.Param("id"), userID))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// errorResponse maps domain errors to http status codes.
+func (g *GameHandlers) errorResponse(c echo.Context, err error) error {
+	var importErr *entity.StoryImportError
+	if errors.As(err, &importErr) {
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  storyImportErrors(importErr),
+			},
+		)
+	}
+
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain_core.ErrNotFound), errors.Is(err, entity.ErrRoundNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, entity.ErrNotGameMember),
+		errors.Is(err, entity.ErrDeckNotAvailable),
+		errors.Is(err, entity.ErrPermissionDenied):
+		status = http.StatusForbidden
+	case errors.Is(err, entity.ErrJoinLinkExpired):
+		status = http.StatusGone
+	case errors.Is(err, domain_core.ErrConcurrentModification),
+		errors.Is(err, entity.ErrRoundInProgress),
+		errors.Is(err, entity.ErrGameArchived),
+		errors.Is(err, entity.ErrNoPendingStories),
+		errors.Is(err, entity.ErrAlreadyMember),
+		errors.Is(err, entity.ErrOwnerCannotLeave),
+		errors.Is(err, entity.ErrRoundNotVoting),
+		errors.Is(err, entity.ErrRoundNotRevealed):
+		status = http.StatusConflict
+	case errors.Is(err, entity.ErrEmptyTopic),
+		errors.Is(err, entity.ErrInvalidRoundTimer),
+		errors.Is(err, entity.ErrEmptyGameName),
+		errors.Is(err, command.ErrUnsupportedStoriesFormat),
+		errors.Is(err, entity.ErrEmptyCard),
+		errors.Is(err, entity.ErrCardTooLong),
+		errors.Is(err, entity.ErrCardNotInDeck),
+		errors.Is(err, entity.ErrJoinLinkTTL),
+		errors.Is(err, entity.ErrJoinLinkMismatch),
+		errors.Is(err, entity.ErrInvalidRole):
+		status = http.StatusBadRequest
+	default:
+		g.Logger.Errorf("game request failed: %v", err)
+	}
+
+	return c.JSON(
+		status,
+		http_dto.ResponseDTO{
+			Status:  status,
+			Message: "error",
+			Error:   err.Error(),
+		},
+	)
+}
@@ -0,0 +1,309 @@
+//nolint:godot // file has comments for swagger doc
+package v1
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator"
+	"github.com/labstack/echo/v4"
+
+	http_dto "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/query"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+)
+
+const (
+	exportFormatCSV  = "csv"
+	exportFormatJSON = "json"
+)
+
+// FetchHistory godoc
+// @Summary Fetch estimation history
+// @Description Fetch revealed rounds of the game with votes and statistics
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Param limit query int false "Page size"
+// @Param offset query int false "Page offset"
+// @Success 200 {object} dto.HistoryDTO
+// @Router /game/{id}/history [get]
+func (g *GameHandlers) FetchHistory(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.FetchHistory")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	opts := dto.FetchHistoryDTO{
+		Limit:  defaultPageSize,
+		Offset: 0,
+	}
+
+	// Parse given params
+	errs := echo.QueryParamsBinder(c).
+		FailFast(false).
+		Int64("limit", &opts.Limit).
+		Int64("offset", &opts.Offset).
+		BindErrors()
+	if errs != nil {
+		for _, err := range errs {
+			var bindingError *echo.BindingError
+			if errors.As(err, &bindingError) {
+				errorList = append(errorList, &http_dto.ValidationError{
+					Field:  bindingError.Field,
+					Value:  bindingError.Values,
+					Reason: "parse",
+				})
+			}
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	err := c.Validate(opts)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	history, err := g.Queries.Ask(ctx, query.FetchHistoryQuery{
+		GameID: c.Param("id"),
+		UserID: userID,
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	})
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data:    history,
+		},
+	)
+}
+
+// FetchRoundStats godoc
+// @Summary Fetch round statistics
+// @Description Fetch average, median, mode, spread and consensus of the latest round reveal
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Param roundId path string true "Round ID"
+// @Success 200 {object} dto.StatsDTO
+// @Router /game/{id}/round/{roundId}/stats [get]
+func (g *GameHandlers) FetchRoundStats(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.FetchRoundStats")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	stats, err := g.Queries.Ask(ctx, query.FetchRoundStatsQuery{
+		GameID:  c.Param("id"),
+		RoundID: c.Param("roundId"),
+		UserID:  userID,
+	})
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data:    stats,
+		},
+	)
+}
+
+// Export godoc
+// @Summary Export game session
+// @Description Export every revealed round of the game as CSV or JSON file
+// @Tags Game
+// @Produce json
+// @Produce text/csv
+// @Param id path string true "Game ID"
+// @Param format query string false "Export format: json (default) or csv"
+// @Success 200 {object} dto.GameExportDTO
+// @Router /game/{id}/export [get]
+func (g *GameHandlers) Export(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.Export")
+	defer span.End()
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = exportFormatJSON
+	}
+
+	if format != exportFormatJSON && format != exportFormatCSV {
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors: []*http_dto.ValidationError{{
+					Field:  "format",
+					Value:  format,
+					Reason: "oneof",
+				}},
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	res, err := g.Queries.Ask(ctx, query.ExportGameQuery{GameID: c.Param("id"), UserID: userID})
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	export, ok := res.(dto.GameExportDTO)
+	if !ok {
+		return errors.New("invalid type assertion: expected dto.GameExportDTO")
+	}
+
+	c.Response().Header().Set(
+		echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=%q", "game-"+export.ID+"."+format),
+	)
+
+	if format == exportFormatJSON {
+		return c.JSON(http.StatusOK, export)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	c.Response().WriteHeader(http.StatusOK)
+
+	return writeExportCSV(c.Response(), export)
+}
+
+// writeExportCSV writes one row per revealed round. Votes are joined into a single
+// "name: value" column so the file can be pasted into trackers as is. The text of
+// the users is neutralized by csvText.
+func writeExportCSV(w io.Writer, export dto.GameExportDTO) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write([]string{
+		"revealed_at", "round_id", "topic", "estimate",
+		"votes", "average", "median", "mode", "spread", "consensus",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, estimation := range export.Estimations {
+		votes := make([]string, 0, len(estimation.Votes))
+		for _, vote := range estimation.Votes {
+			votes = append(votes, vote.Name+": "+vote.Value)
+		}
+
+		err = writer.Write([]string{
+			estimation.RevealedAt,
+			estimation.RoundID,
+			csvText(estimation.Topic),
+			csvText(estimation.Estimate),
+			csvText(strings.Join(votes, "; ")),
+			formatStat(estimation.Stats.Average),
+			formatStat(estimation.Stats.Median),
+			csvText(strings.Join(estimation.Stats.Mode, " ")),
+			formatStat(estimation.Stats.Spread),
+			strconv.FormatBool(estimation.Stats.Consensus),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// csvText keeps the spreadsheets from running the text of the users as a formula,
+// the cells starting with a formula character are prefixed with a quote.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+func formatStat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
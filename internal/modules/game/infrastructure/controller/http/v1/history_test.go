@@ -0,0 +1,89 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+	handlers "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/v1"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+type queryBusStub struct {
+	resp any
+}
+
+func (q queryBusStub) Ask(_ context.Context, _ core.Query) (any, error) {
+	return q.resp, nil
+}
+
+func TestExportCSV(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	average := 6.5
+	export := dto.GameExportDTO{
+		ID:   "game-1",
+		Name: "Sprint 42",
+		Estimations: []dto.EstimationDTO{{
+			RoundID:  "round-1",
+			Topic:    "Login, page",
+			Estimate: "8",
+			Votes: []dto.VoteDTO{
+				{Name: "Alice", Value: "5"},
+				{Name: "Bob", Value: "8"},
+			},
+			Stats: dto.StatsDTO{
+				Votes:   2,
+				Average: &average,
+				Mode:    []string{"5", "8"},
+			},
+			RevealedAt: "2024-01-01",
+		}, {
+			// The spreadsheets must not run the text of the users
+			RoundID:  "round-2",
+			Topic:    "=HYPERLINK(\"https://evil.local\")",
+			Estimate: "-1",
+			Votes: []dto.VoteDTO{
+				{Name: "@Mallory", Value: "+1"},
+			},
+			Stats: dto.StatsDTO{
+				Votes: 1,
+				Mode:  []string{"\t1"},
+			},
+			RevealedAt: "2024-01-02",
+		}},
+	}
+
+	e := echo.New()
+	group := e.Group("", func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("user_id", "user-1")
+			return next(c)
+		}
+	})
+	handlers.NewGameHandlers(group, nil, queryBusStub{resp: export}, log)
+
+	req := httptest.NewRequest(http.MethodGet, "/game/game-1/export?format=csv", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "game-game-1.csv")
+	assert.Equal(
+		t,
+		"revealed_at,round_id,topic,estimate,votes,average,median,mode,spread,consensus\n"+
+			"2024-01-01,round-1,\"Login, page\",8,Alice: 5; Bob: 8,6.5,,5 8,,false\n"+
+			"2024-01-02,round-2,\"'=HYPERLINK(\"\"https://evil.local\"\")\",'-1,'@Mallory: +1,,,'\t1,,false\n",
+		rec.Body.String(),
+	)
+}
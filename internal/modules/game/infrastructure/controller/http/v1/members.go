@@ -0,0 +1,505 @@
+//nolint:godot // file has comments for swagger doc
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/labstack/echo/v4"
+
+	http_dto "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+)
+
+const defaultJoinLinkTTL = 24 * time.Hour
+
+// InviteMember godoc
+// @Summary Invite member
+// @Description Invite registered user to the game by email
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Param params body dto.InviteMemberDTO true "Invite params"
+// @Success 200
+// @Router /game/{id}/invite [post]
+func (g *GameHandlers) InviteMember(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.InviteMember")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.InviteMemberDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err = g.Commands.Dispatch(ctx, command.NewInviteMemberCommand(c.Param("id"), userID, params.Email))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// CreateJoinLink godoc
+// @Summary Create join link
+// @Description Create expiring join code for the game
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Param params body dto.CreateJoinLinkDTO false "Join link params"
+// @Success 201 {object} dto.JoinLinkDTO
+// @Router /game/{id}/link [post]
+func (g *GameHandlers) CreateJoinLink(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.CreateJoinLink")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.CreateJoinLinkDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	ttl := defaultJoinLinkTTL
+	if params.ExpiresIn > 0 {
+		ttl = time.Duration(params.ExpiresIn) * time.Second
+	}
+
+	link, err := g.Commands.Dispatch(ctx, command.NewCreateJoinLinkCommand(c.Param("id"), userID, ttl))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusCreated,
+		http_dto.ResponseDTO{
+			Status:  http.StatusCreated,
+			Message: "success",
+			Data:    link,
+		},
+	)
+}
+
+// JoinGame godoc
+// @Summary Join game
+// @Description Join game using join link code
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param code path string true "Join code"
+// @Success 200 {string} string "Game ID"
+// @Router /game/join/{code} [post]
+func (g *GameHandlers) JoinGame(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.JoinGame")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	gameID, err := g.Commands.Dispatch(ctx, command.NewJoinGameCommand(c.Param("code"), userID))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data:    gameID,
+		},
+	)
+}
+
+// LeaveGame godoc
+// @Summary Leave game
+// @Description Leave game handler
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200
+// @Router /game/{id}/leave [post]
+func (g *GameHandlers) LeaveGame(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.LeaveGame")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err := g.Commands.Dispatch(ctx, command.NewLeaveGameCommand(c.Param("id"), userID))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// RemoveMember godoc
+// @Summary Remove member
+// @Description Remove member from the game
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Param userId path string true "Member ID"
+// @Success 200
+// @Router /game/{id}/member/{userId} [delete]
+func (g *GameHandlers) RemoveMember(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.RemoveMember")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err := g.Commands.Dispatch(
+		ctx,
+		command.NewRemoveMemberCommand(c.Param("id"), userID, c.Param("userId")),
+	)
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// ChangeMemberRole godoc
+// @Summary Change member role
+// @Description Assign facilitator, voter or observer role to the game member
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Param userId path string true "Member ID"
+// @Param params body dto.ChangeMemberRoleDTO true "Role params"
+// @Success 200
+// @Router /game/{id}/member/{userId}/role [put]
+func (g *GameHandlers) ChangeMemberRole(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.ChangeMemberRole")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.ChangeMemberRoleDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err = g.Commands.Dispatch(
+		ctx,
+		command.NewChangeMemberRoleCommand(c.Param("id"), userID, c.Param("userId"), params.Role),
+	)
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// TransferOwnership godoc
+// @Summary Transfer ownership
+// @Description Hand the game over to another member
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Param params body dto.TransferOwnershipDTO true "New owner"
+// @Success 200
+// @Router /game/{id}/transfer [post]
+func (g *GameHandlers) TransferOwnership(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.TransferOwnership")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.TransferOwnershipDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err = g.Commands.Dispatch(ctx, command.NewTransferOwnershipCommand(c.Param("id"), userID, params.UserID))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
@@ -0,0 +1,59 @@
+//nolint:godot // file has comments for swagger doc
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	http_dto "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/query"
+)
+
+// FetchPresence godoc
+// @Summary Fetch game presence
+// @Description Fetch game members with their online state and whether they have voted in the active round
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200 {object} dto.PresenceDTO
+// @Router /game/{id}/presence [get]
+func (g *GameHandlers) FetchPresence(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.FetchPresence")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	guest, _ := c.Get("guest").(bool)
+	presence, err := g.Queries.Ask(ctx, query.FetchPresenceQuery{
+		GameID: c.Param("id"),
+		UserID: userID,
+		Guest:  guest,
+	})
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data:    presence,
+		},
+	)
+}
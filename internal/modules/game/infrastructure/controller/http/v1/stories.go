@@ -0,0 +1,292 @@
+//nolint:godot // file has comments for swagger doc
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/labstack/echo/v4"
+
+	http_dto "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/query"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+)
+
+const maxStoriesFileSize = 1 << 20
+
+var errStoriesFileTooLarge = errors.New("stories file is too large")
+
+// ImportStories godoc
+// @Summary Import stories
+// @Description Append stories from CSV or JSON file to the game queue.
+// @Description The file is sent as multipart "file" field or as request body.
+// @Description CSV file must have a header with key, title, description and link columns,
+// @Description JSON file is an array of objects with the same fields.
+// @Tags Game
+// @Accept multipart/form-data
+// @Accept text/csv
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Param format query string false "File format: csv or json, detected from the file name or content type by default"
+// @Success 201 {integer} integer "Imported stories count"
+// @Router /game/{id}/stories [post]
+func (g *GameHandlers) ImportStories(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.ImportStories")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	format, data, err := readStoriesFile(c)
+	if err != nil {
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   err.Error(),
+			},
+		)
+	}
+
+	count, err := g.Commands.Dispatch(ctx, command.NewImportStoriesCommand(c.Param("id"), userID, format, data))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusCreated,
+		http_dto.ResponseDTO{
+			Status:  http.StatusCreated,
+			Message: "success",
+			Data:    count,
+		},
+	)
+}
+
+// FetchStories godoc
+// @Summary Fetch stories
+// @Description Fetch the game story queue
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Success 200 {array} dto.StoryDTO
+// @Router /game/{id}/stories [get]
+func (g *GameHandlers) FetchStories(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.FetchStories")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	guest, _ := c.Get("guest").(bool)
+	stories, err := g.Queries.Ask(ctx, query.FetchStoriesQuery{GameID: c.Param("id"), UserID: userID, Guest: guest})
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data:    stories,
+		},
+	)
+}
+
+// StartNextRound godoc
+// @Summary Start next round
+// @Description Start round for the next pending story of the game queue
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "Game ID"
+// @Param params body dto.StartNextRoundDTO false "Round timer"
+// @Success 201 {string} string "Round ID"
+// @Router /game/{id}/round/next [post]
+func (g *GameHandlers) StartNextRound(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := g.tracer.Start(ctx, "GameHandlers.StartNextRound")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.StartNextRoundDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	roundID, err := g.Commands.Dispatch(ctx, command.NewStartNextRoundCommand(
+		c.Param("id"),
+		userID,
+		time.Duration(params.Timer)*time.Second,
+	))
+	if err != nil {
+		return g.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusCreated,
+		http_dto.ResponseDTO{
+			Status:  http.StatusCreated,
+			Message: "success",
+			Data:    roundID,
+		},
+	)
+}
+
+// storyImportErrors converts invalid backlog rows to validation errors.
+func storyImportErrors(importErr *entity.StoryImportError) []*http_dto.ValidationError {
+	errorList := make([]*http_dto.ValidationError, 0, len(importErr.Rows))
+	for _, row := range importErr.Rows {
+		field := row.Field
+		if row.Row > 0 {
+			field = fmt.Sprintf("rows[%d].%s", row.Row, row.Field)
+		}
+
+		errorList = append(errorList, &http_dto.ValidationError{
+			Field:  field,
+			Value:  row.Value,
+			Reason: row.Err.Error(),
+		})
+	}
+
+	return errorList
+}
+
+// readStoriesFile reads the uploaded file either from the multipart form or from the request body.
+func readStoriesFile(c echo.Context) (string, []byte, error) {
+	format := strings.ToLower(c.QueryParam("format"))
+	contentType := c.Request().Header.Get(echo.HeaderContentType)
+
+	var reader io.Reader = c.Request().Body
+	if strings.HasPrefix(contentType, echo.MIMEMultipartForm) {
+		header, err := c.FormFile("file")
+		if err != nil {
+			return "", nil, err
+		}
+
+		file, err := header.Open()
+		if err != nil {
+			return "", nil, err
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+
+		reader = file
+		contentType = header.Header.Get(echo.HeaderContentType)
+		if format == "" {
+			format = strings.TrimPrefix(strings.ToLower(filepath.Ext(header.Filename)), ".")
+		}
+	}
+
+	if format == "" {
+		switch {
+		case strings.HasPrefix(contentType, echo.MIMEApplicationJSON):
+			format = command.StoriesFormatJSON
+		case strings.HasPrefix(contentType, "text/csv"):
+			format = command.StoriesFormatCSV
+		}
+	}
+
+	if format != command.StoriesFormatCSV && format != command.StoriesFormatJSON {
+		return "", nil, command.ErrUnsupportedStoriesFormat
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, maxStoriesFileSize+1))
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(data) > maxStoriesFileSize {
+		return "", nil, errStoriesFileTooLarge
+	}
+
+	return format, data, nil
+}
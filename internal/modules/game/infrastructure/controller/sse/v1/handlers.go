@@ -0,0 +1,240 @@
+//nolint:godot // file has comments for swagger doc
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	http_dto "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/query"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/sse"
+)
+
+const (
+	requestTimeout    = 10 * time.Second
+	heartbeatInterval = 15 * time.Second
+	// ResyncMessage tells the client that missed events are gone and the state must be refetched.
+	ResyncMessage = "Resync"
+)
+
+type QueryBus interface {
+	Ask(context.Context, core.Query) (any, error)
+}
+
+type RoomReplayer interface {
+	Subscribe(gameID string) (<-chan ports.RoomMessage, func())
+	Replay(ctx context.Context, gameID string, afterID int64) ([]ports.RoomMessage, bool, error)
+}
+
+// PresenceTracker keeps the user online in the game room while the stream is open.
+type PresenceTracker interface {
+	Track(ctx context.Context, gameID, userID string) func()
+}
+
+type EventsHandlers struct {
+	Queries  QueryBus
+	room     RoomReplayer
+	presence PresenceTracker
+	tracer   trace.Tracer
+	Logger   logger.Logger
+}
+
+func NewEventsHandlers(
+	v1 *echo.Group,
+	queries QueryBus,
+	room RoomReplayer,
+	presence PresenceTracker,
+	logger logger.Logger,
+) {
+	handlers := &EventsHandlers{
+		Queries:  queries,
+		room:     room,
+		presence: presence,
+		Logger:   logger,
+		tracer:   otel.Tracer(""),
+	}
+
+	v1.GET("/game/:id/events", handlers.Stream)
+}
+
+// Stream godoc
+// @Summary Game events stream
+// @Description Stream game events with Server-Sent Events. Missed events are replayed after Last-Event-ID
+// @Tags Game
+// @Produce text/event-stream
+// @Param id path string true "Game ID"
+// @Param Last-Event-ID header int false "Last received event id"
+// @Success 200
+// @Router /game/{id}/events [get]
+func (e *EventsHandlers) Stream(c echo.Context) error {
+	ctx, span := e.tracer.Start(c.Request().Context(), "EventsHandlers.Stream")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	gameID := c.Param("id")
+	guest, _ := c.Get("guest").(bool)
+	if err := e.checkMembership(ctx, gameID, userID, guest); err != nil {
+		return e.errorResponse(c, err)
+	}
+
+	lastID, resume, err := lastEventID(c)
+	if err != nil {
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   "invalid Last-Event-ID",
+			},
+		)
+	}
+
+	// Subscribe before replay, so no event is lost between them.
+	messages, unsubscribe := e.room.Subscribe(gameID)
+	defer unsubscribe()
+
+	var replay []ports.RoomMessage
+	complete := true
+	if resume {
+		replay, complete, err = e.room.Replay(ctx, gameID, lastID)
+		if err != nil {
+			return e.errorResponse(c, err)
+		}
+	}
+
+	stream, err := sse.NewStream(c.Response())
+	if err != nil {
+		return err
+	}
+
+	// Guests watch the room as spectators, only the members are reported online
+	if !guest {
+		leave := e.presence.Track(ctx, gameID, userID)
+		defer leave()
+	}
+
+	if !complete {
+		lastID = 0
+		if err = e.sendResync(stream); err != nil {
+			return nil //nolint:nilerr // client has gone away
+		}
+	}
+
+	for _, message := range replay {
+		if err = stream.SendID(message.ID, "", message.Payload); err != nil {
+			return nil //nolint:nilerr // client has gone away
+		}
+		lastID = message.ID
+	}
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			// Already delivered by the replay.
+			if message.ID <= lastID {
+				continue
+			}
+
+			if err = stream.SendID(message.ID, "", message.Payload); err != nil {
+				return nil //nolint:nilerr // client has gone away
+			}
+			lastID = message.ID
+		case <-ticker.C:
+			if err = stream.Heartbeat(); err != nil {
+				return nil //nolint:nilerr // client has gone away
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (e *EventsHandlers) checkMembership(ctx context.Context, gameID, userID string, guest bool) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	_, err := e.Queries.Ask(ctx, query.CheckMembershipQuery{GameID: gameID, UserID: userID, Guest: guest})
+
+	return err
+}
+
+func (e *EventsHandlers) sendResync(stream *sse.Stream) error {
+	payload, err := json.Marshal(dto.RoomMessageDTO{Type: ResyncMessage})
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(sse.Event{Data: payload})
+}
+
+// lastEventID reads the resume position from the Last-Event-ID header, or from
+// the lastEventId query param used by EventSource polyfills.
+func lastEventID(c echo.Context) (int64, bool, error) {
+	value := c.Request().Header.Get("Last-Event-ID")
+	if value == "" {
+		value = c.QueryParam("lastEventId")
+	}
+
+	if value == "" {
+		return 0, false, nil
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id < 0 {
+		return 0, false, errors.New("invalid event id")
+	}
+
+	return id, true, nil
+}
+
+func (e *EventsHandlers) errorResponse(c echo.Context, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain_core.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, entity.ErrNotGameMember):
+		status = http.StatusForbidden
+	default:
+		e.Logger.Errorf("game events request failed: %v", err)
+	}
+
+	return c.JSON(
+		status,
+		http_dto.ResponseDTO{
+			Status:  status,
+			Message: "error",
+			Error:   err.Error(),
+		},
+	)
+}
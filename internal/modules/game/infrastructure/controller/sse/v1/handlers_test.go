@@ -0,0 +1,152 @@
+package v1_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	handlers "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/sse/v1"
+	game_redis "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/gateway/redis"
+	"github.com/KyKyPy3/clean/pkg/latch"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const (
+	gameID = "6f2b0c1e-4a52-4bd8-9e1a-8f4f3f1b2c3d"
+	userID = "1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed"
+)
+
+type queryBusMock struct{}
+
+func (q *queryBusMock) Ask(_ context.Context, _ core.Query) (any, error) {
+	return true, nil
+}
+
+type presenceMock struct {
+	tracked atomic.Int32
+}
+
+func (p *presenceMock) Track(_ context.Context, _, _ string) func() {
+	p.tracked.Add(1)
+	return func() {}
+}
+
+func TestStreamResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log := logger.NewLogger(logger.Config{Mode: "test"})
+	log.Init()
+
+	rd := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: rd.Addr()})
+	defer client.Close()
+
+	hub := game_redis.NewRoomHub(client, log)
+	require.NoError(t, hub.Start(ctx, latch.NewCountDownLatch()))
+
+	e := echo.New()
+	group := e.Group("/api/v1", func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("user_id", userID)
+			return next(c)
+		}
+	})
+	handlers.NewEventsHandlers(group, &queryBusMock{}, hub, &presenceMock{}, log)
+
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	for _, payload := range []string{`{"type":"RoundStarted"}`, `{"type":"VoteCast"}`} {
+		require.NoError(t, hub.Publish(ctx, gameID, []byte(payload)))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/game/"+gameID+"/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "1")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	readEvent := func() string {
+		var lines []string
+		for {
+			line, readErr := reader.ReadString('\n')
+			require.NoError(t, readErr)
+			if line == "\n" {
+				return strings.Join(lines, "")
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	// Missed event is replayed.
+	assert.Equal(t, "id: 2\ndata: {\"type\":\"VoteCast\"}\n", readEvent())
+
+	// Live events follow the replay, the stream has subscribed before replaying.
+	require.NoError(t, hub.Publish(ctx, gameID, []byte(`{"type":"RoundRevealed"}`)))
+	assert.Equal(t, "id: 3\ndata: {\"type\":\"RoundRevealed\"}\n", readEvent())
+}
+
+func TestStreamGuestNotTracked(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log := logger.NewLogger(logger.Config{Mode: "test"})
+	log.Init()
+
+	rd := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: rd.Addr()})
+	defer client.Close()
+
+	hub := game_redis.NewRoomHub(client, log)
+	require.NoError(t, hub.Start(ctx, latch.NewCountDownLatch()))
+
+	e := echo.New()
+	group := e.Group("/api/v1", func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("user_id", userID)
+			c.Set("guest", true)
+			return next(c)
+		}
+	})
+	presence := &presenceMock{}
+	handlers.NewEventsHandlers(group, &queryBusMock{}, hub, presence, log)
+
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	require.NoError(t, hub.Publish(ctx, gameID, []byte(`{"type":"RoundStarted"}`)))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/game/"+gameID+"/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "0")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// The replayed event is sent after the presence would be tracked
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "id: 1\n", line)
+
+	// Guests watch the room without being reported online
+	assert.Zero(t, presence.tracked.Load())
+}
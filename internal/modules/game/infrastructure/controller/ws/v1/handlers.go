@@ -0,0 +1,214 @@
+//nolint:godot // file has comments for swagger doc
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	http_dto "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/query"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const (
+	requestTimeout = 10 * time.Second
+	writeWait      = 10 * time.Second
+	pongWait       = 60 * time.Second
+	pingPeriod     = pongWait * 9 / 10
+	maxMessageSize = 512
+)
+
+type QueryBus interface {
+	Ask(context.Context, core.Query) (any, error)
+}
+
+type RoomSubscriber interface {
+	Subscribe(gameID string) (<-chan ports.RoomMessage, func())
+}
+
+// PresenceTracker keeps the user online in the game room while the connection is open.
+type PresenceTracker interface {
+	Track(ctx context.Context, gameID, userID string) func()
+}
+
+type RoomHandlers struct {
+	Queries  QueryBus
+	room     RoomSubscriber
+	presence PresenceTracker
+	upgrader websocket.Upgrader
+	tracer   trace.Tracer
+	Logger   logger.Logger
+}
+
+func NewRoomHandlers(
+	v1 *echo.Group,
+	queries QueryBus,
+	room RoomSubscriber,
+	presence PresenceTracker,
+	allowedOrigins []string,
+	logger logger.Logger,
+) {
+	handlers := &RoomHandlers{
+		Queries:  queries,
+		room:     room,
+		presence: presence,
+		Logger:   logger,
+		tracer:   otel.Tracer(""),
+		upgrader: websocket.Upgrader{
+			CheckOrigin: checkOrigin(allowedOrigins),
+		},
+	}
+
+	v1.GET("/game/:id/ws", handlers.Connect)
+}
+
+// Connect godoc
+// @Summary Game room
+// @Description Stream game events of the room over WebSocket
+// @Tags Game
+// @Param id path string true "Game ID"
+// @Success 101
+// @Router /game/{id}/ws [get]
+func (r *RoomHandlers) Connect(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := r.tracer.Start(ctx, "RoomHandlers.Connect")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	gameID := c.Param("id")
+	guest, _ := c.Get("guest").(bool)
+	_, err := r.Queries.Ask(ctx, query.CheckMembershipQuery{GameID: gameID, UserID: userID, Guest: guest})
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain_core.ErrNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, entity.ErrNotGameMember):
+			status = http.StatusForbidden
+		}
+
+		return c.JSON(
+			status,
+			http_dto.ResponseDTO{
+				Status:  status,
+				Message: "error",
+				Error:   err.Error(),
+			},
+		)
+	}
+
+	conn, err := r.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// Upgrader has already replied with an http error.
+		r.Logger.Debugf("can't upgrade game room connection, err: %v", err)
+		return nil
+	}
+
+	messages, unsubscribe := r.room.Subscribe(gameID)
+	defer unsubscribe()
+
+	// Guests watch the room as spectators, only the members are reported online
+	if !guest {
+		leave := r.presence.Track(ctx, gameID, userID)
+		defer leave()
+	}
+
+	done := make(chan struct{})
+	go r.read(conn, done)
+	r.write(conn, messages, done)
+
+	return nil
+}
+
+// read drains client frames to process control messages and detect disconnects.
+func (r *RoomHandlers) read(conn *websocket.Conn, done chan<- struct{}) {
+	defer close(done)
+
+	conn.SetReadLimit(maxMessageSize)
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				r.Logger.Debugf("game room connection closed, err: %v", err)
+			}
+
+			return
+		}
+	}
+}
+
+func (r *RoomHandlers) write(conn *websocket.Conn, messages <-chan ports.RoomMessage, done <-chan struct{}) {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		_ = conn.Close()
+	}()
+
+	for {
+		select {
+		case message, ok := <-messages:
+			_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				_ = conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+
+			if err := conn.WriteMessage(websocket.TextMessage, message.Payload); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// checkOrigin accepts same-origin requests and the configured client origins.
+func checkOrigin(allowedOrigins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || slices.Contains(allowedOrigins, origin) {
+			return true
+		}
+
+		u, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+
+		return u.Host == r.Host
+	}
+}
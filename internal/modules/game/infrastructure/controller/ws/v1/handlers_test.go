@@ -0,0 +1,124 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	handlers "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/controller/ws/v1"
+	game_redis "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/gateway/redis"
+	"github.com/KyKyPy3/clean/pkg/latch"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const (
+	gameID = "6f2b0c1e-4a52-4bd8-9e1a-8f4f3f1b2c3d"
+	userID = "1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed"
+)
+
+type queryBusMock struct {
+	err error
+}
+
+func (q *queryBusMock) Ask(_ context.Context, _ core.Query) (any, error) {
+	return true, q.err
+}
+
+type presenceMock struct{}
+
+func (p *presenceMock) Track(_ context.Context, _, _ string) func() {
+	return func() {}
+}
+
+func newInstance(
+	t *testing.T,
+	ctx context.Context,
+	addr string,
+	queries handlers.QueryBus,
+	log logger.Logger,
+) (*game_redis.RoomHub, *httptest.Server) {
+	t.Helper()
+
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	t.Cleanup(func() { _ = client.Close() })
+
+	hub := game_redis.NewRoomHub(client, log)
+	require.NoError(t, hub.Start(ctx, latch.NewCountDownLatch()))
+
+	e := echo.New()
+	group := e.Group("/api/v1", func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("user_id", userID)
+			return next(c)
+		}
+	})
+	handlers.NewRoomHandlers(group, queries, hub, &presenceMock{}, nil, log)
+
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+
+	return hub, server
+}
+
+func wsURL(server *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(server.URL, "http") + "/api/v1/game/" + gameID + "/ws"
+}
+
+func TestRoomFanOut(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log := logger.NewLogger(logger.Config{Mode: "test"})
+	log.Init()
+
+	rd := miniredis.RunT(t)
+
+	// Two instances share one Redis, like two replicas behind a load balancer.
+	_, serverA := newInstance(t, ctx, rd.Addr(), &queryBusMock{}, log)
+	hubB, _ := newInstance(t, ctx, rd.Addr(), &queryBusMock{}, log)
+
+	conn, resp, err := websocket.DefaultDialer.Dial(wsURL(serverA), nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	defer conn.Close()
+
+	// Subscription of the connection is registered right after the upgrade.
+	time.Sleep(100 * time.Millisecond)
+
+	message := `{"type":"VoteCast","data":{"gameId":"` + gameID + `"}}`
+	require.NoError(t, hubB.Publish(ctx, gameID, []byte(message)))
+	require.NoError(t, hubB.Publish(ctx, "another-game", []byte(`{"type":"VoteCast"}`)))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	_, data, err := conn.ReadMessage()
+	require.NoError(t, err)
+	assert.JSONEq(t, message, string(data))
+}
+
+func TestRoomForbidden(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log := logger.NewLogger(logger.Config{Mode: "test"})
+	log.Init()
+
+	rd := miniredis.RunT(t)
+	_, server := newInstance(t, ctx, rd.Addr(), &queryBusMock{err: entity.ErrNotGameMember}, log)
+
+	_, resp, err := websocket.DefaultDialer.Dial(wsURL(server), nil)
+	require.ErrorIs(t, err, websocket.ErrBadHandshake)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+// deadlinesLockKey identifies the advisory lock held by the instance executing game deadlines.
+const deadlinesLockKey = 7301
+
+// ScheduleDeadline store new game deadline.
+func (g *gamePgStorage) ScheduleDeadline(ctx context.Context, deadline entity.Deadline) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.ScheduleDeadline")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "ScheduleDeadline.Organization")
+	}
+
+	dbDeadline := DeadlineToDB(deadline)
+	if _, err = g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		CreateDeadlineSQL,
+		dbDeadline.ID,
+		dbDeadline.GameID,
+		dbDeadline.RoundID,
+		dbDeadline.Kind,
+		dbDeadline.DueAt,
+		dbDeadline.CreatedAt,
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "ScheduleDeadline.ExecContext")
+	}
+
+	return nil
+}
+
+// CancelDeadlines drop all pending deadlines of the round.
+func (g *gamePgStorage) CancelDeadlines(ctx context.Context, roundID common.UID) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.CancelDeadlines")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "CancelDeadlines.Organization")
+	}
+
+	if _, err = g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		CancelDeadlinesSQL,
+		roundID.String(),
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "CancelDeadlines.ExecContext")
+	}
+
+	return nil
+}
+
+// DeleteDeadline drop executed deadline.
+func (g *gamePgStorage) DeleteDeadline(ctx context.Context, id common.UID) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.DeleteDeadline")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "DeleteDeadline.Organization")
+	}
+
+	if _, err = g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		DeleteDeadlineSQL,
+		id.String(),
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "DeleteDeadline.ExecContext")
+	}
+
+	return nil
+}
+
+// LockDeadlines try to acquire the deadlines advisory lock until the end of the current transaction.
+func (g *gamePgStorage) LockDeadlines(ctx context.Context) (bool, error) {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.LockDeadlines")
+	defer span.End()
+
+	locked := false
+	if err := g.getter.DefaultTrOrDB(ctx, g.db).GetContext(ctx, &locked, LockDeadlinesSQL, deadlinesLockKey); err != nil {
+		return false, errors.Wrap(err, "LockDeadlines.GetContext")
+	}
+
+	return locked, nil
+}
+
+// FetchDueDeadlines select deadlines due at the given time and lock them until the end of the current transaction.
+func (g *gamePgStorage) FetchDueDeadlines(ctx context.Context, now time.Time, limit int64) ([]entity.Deadline, error) {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.FetchDueDeadlines")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchDueDeadlines.Organization")
+	}
+
+	dbDeadlines := make([]DBDeadline, 0)
+	if err = g.getter.DefaultTrOrDB(ctx, g.db).SelectContext(
+		ctx,
+		&dbDeadlines,
+		FetchDueDeadlinesSQL,
+		now,
+		limit,
+		organizationID,
+	); err != nil {
+		return nil, errors.Wrap(err, "FetchDueDeadlines.SelectContext")
+	}
+
+	deadlines := make([]entity.Deadline, 0, len(dbDeadlines))
+	for _, dbDeadline := range dbDeadlines {
+		deadline, err := DeadlineFromDB(dbDeadline)
+		if err != nil {
+			return nil, errors.Wrap(err, "FetchDueDeadlines.DeadlineFromDB")
+		}
+
+		deadlines = append(deadlines, deadline)
+	}
+
+	return deadlines, nil
+}
@@ -1,38 +1,201 @@
 package postgres
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
 	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/event"
+	"github.com/KyKyPy3/clean/pkg/mediator"
 )
 
 // DBGame Database game representation.
 type DBGame struct {
+	ID             string     `db:"id"`
+	OrganizationID string     `db:"organization_id"`
+	Name           string     `db:"name"`
+	OwnerID        string     `db:"owner_id"`
+	DeckID         string     `db:"deck_id"`
+	ArchivedAt     *time.Time `db:"archived_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+// DBMember Database game member representation.
+type DBMember struct {
+	UserID string `db:"user_id"`
+	Name   string `db:"name"`
+	Role   int    `db:"role"`
+}
+
+// DBRound Database round representation.
+type DBRound struct {
+	ID        string     `db:"id"`
+	GameID    string     `db:"game_id"`
+	Topic     string     `db:"topic"`
+	Status    int        `db:"status"`
+	Estimate  string     `db:"estimate"`
+	Timer     int        `db:"timer"`
+	Deadline  *time.Time `db:"deadline"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+}
+
+// DBDeadline Database game deadline representation.
+type DBDeadline struct {
 	ID        string    `db:"id"`
-	Name      string    `db:"name"`
-	OwnerID   string    `db:"owner_id"`
+	GameID    string    `db:"game_id"`
+	RoundID   string    `db:"round_id"`
+	Kind      int       `db:"kind"`
+	DueAt     time.Time `db:"due_at"`
 	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// DBVote Database vote representation.
+type DBVote struct {
+	UserID    string    `db:"user_id"`
+	Value     string    `db:"value"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// DBStory Database story representation.
+type DBStory struct {
+	ID          string    `db:"id"`
+	GameID      string    `db:"game_id"`
+	Key         string    `db:"key"`
+	Title       string    `db:"title"`
+	Description string    `db:"description"`
+	Link        string    `db:"link"`
+	Position    int       `db:"position"`
+	Status      int       `db:"status"`
+	RoundID     *string   `db:"round_id"`
+	Estimate    string    `db:"estimate"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+// DBEstimation Database estimation representation.
+type DBEstimation struct {
+	ID         string    `db:"id"`
+	GameID     string    `db:"game_id"`
+	RoundID    string    `db:"round_id"`
+	Topic      string    `db:"topic"`
+	Estimate   string    `db:"estimate"`
+	RevealedAt time.Time `db:"revealed_at"`
+}
+
+// DBEstimationVote Database estimation vote representation.
+type DBEstimationVote struct {
+	UserID string `db:"user_id"`
+	Name   string `db:"name"`
+	Value  string `db:"value"`
+}
+
+// DBJoinLink Database join link representation.
+type DBJoinLink struct {
+	Code      string    `db:"code"`
+	GameID    string    `db:"game_id"`
+	CreatedBy string    `db:"created_by"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// DBEvent Database game event representation.
+type DBEvent struct {
+	Version int64  `db:"version"`
+	Kind    string `db:"kind"`
+	Payload string `db:"payload"`
+}
+
+// DBSnapshot Database game snapshot representation.
+type DBSnapshot struct {
+	Version int64  `db:"version"`
+	Payload string `db:"payload"`
+}
+
+// DBGameState Game state stored in the snapshot payload.
+type DBGameState struct {
+	Game    DBGame     `json:"game"`
+	Members []DBMember `json:"members"`
+	Round   *DBRound   `json:"round,omitempty"`
+	Votes   []DBVote   `json:"votes,omitempty"`
+	Stories []DBStory  `json:"stories"`
+}
+
+// DBVoteCast Stored vote cast event. The event hides the card value from json to keep
+// votes secret in the game room, so the value is stored next to it.
+type DBVoteCast struct {
+	event.VoteCastEvent
+	Value string `json:"value"`
 }
 
 // GameFromDB Convert database game model to domain model.
 func GameFromDB(dbGame DBGame) (entity.Game, error) {
+	return GameWithDetailsFromDB(dbGame, nil, nil, nil)
+}
+
+// GameWithDetailsFromDB Convert database game model with its members, current round and stories to domain model.
+func GameWithDetailsFromDB(
+	dbGame DBGame,
+	dbMembers []DBMember,
+	round *entity.Round,
+	dbStories []DBStory,
+) (entity.Game, error) {
 	entityID, err := common.ParseUID(dbGame.ID)
 	if err != nil {
 		return entity.Game{}, err
 	}
 
+	organizationID, err := common.ParseUID(dbGame.OrganizationID)
+	if err != nil {
+		return entity.Game{}, err
+	}
+
 	ownerID, err := common.ParseUID(dbGame.OwnerID)
 	if err != nil {
 		return entity.Game{}, err
 	}
 
+	deckID, err := common.ParseUID(dbGame.DeckID)
+	if err != nil {
+		return entity.Game{}, err
+	}
+
+	members := make([]entity.GameMember, 0, len(dbMembers))
+	for _, dbMember := range dbMembers {
+		var userID common.UID
+		userID, err = common.ParseUID(dbMember.UserID)
+		if err != nil {
+			return entity.Game{}, err
+		}
+
+		members = append(members, entity.HydrateMember(dbMember.Name, userID, entityID, entity.Role(dbMember.Role)))
+	}
+
+	stories := make([]entity.Story, 0, len(dbStories))
+	for _, dbStory := range dbStories {
+		var story entity.Story
+		story, err = StoryFromDB(dbStory)
+		if err != nil {
+			return entity.Game{}, err
+		}
+
+		stories = append(stories, story)
+	}
+
 	game := entity.Hydrate(
 		entityID,
+		organizationID,
 		dbGame.Name,
 		ownerID,
-		make([]entity.GameMember, 0),
+		deckID,
+		members,
+		round,
+		stories,
+		dbGame.ArchivedAt,
 		dbGame.CreatedAt,
 		dbGame.UpdatedAt,
 	)
@@ -43,8 +206,444 @@ func GameFromDB(dbGame DBGame) (entity.Game, error) {
 // GameToDB Convert domain game model to database model.
 func GameToDB(game entity.Game) DBGame {
 	return DBGame{
-		ID:      game.ID().String(),
-		Name:    game.Name(),
-		OwnerID: game.OwnerID().String(),
+		ID:             game.ID().String(),
+		OrganizationID: game.OrganizationID().String(),
+		Name:           game.Name(),
+		OwnerID:        game.OwnerID().String(),
+		DeckID:         game.DeckID().String(),
+		ArchivedAt:     game.ArchivedAt(),
+	}
+}
+
+// RoundFromDB Convert database round model with votes to domain model.
+func RoundFromDB(dbRound DBRound, dbVotes []DBVote) (entity.Round, error) {
+	entityID, err := common.ParseUID(dbRound.ID)
+	if err != nil {
+		return entity.Round{}, err
 	}
+
+	gameID, err := common.ParseUID(dbRound.GameID)
+	if err != nil {
+		return entity.Round{}, err
+	}
+
+	votes := make([]entity.Vote, 0, len(dbVotes))
+	for _, dbVote := range dbVotes {
+		var userID common.UID
+		userID, err = common.ParseUID(dbVote.UserID)
+		if err != nil {
+			return entity.Round{}, err
+		}
+
+		votes = append(votes, entity.HydrateVote(userID, dbVote.Value, dbVote.CreatedAt))
+	}
+
+	round := entity.HydrateRound(
+		entityID,
+		gameID,
+		dbRound.Topic,
+		entity.RoundStatus(dbRound.Status),
+		votes,
+		dbRound.Estimate,
+		time.Duration(dbRound.Timer)*time.Second,
+		dbRound.Deadline,
+		dbRound.CreatedAt,
+		dbRound.UpdatedAt,
+	)
+
+	return round, nil
+}
+
+// RoundToDB Convert domain round model to database model.
+func RoundToDB(round entity.Round) DBRound {
+	return DBRound{
+		ID:        round.ID().String(),
+		GameID:    round.GameID().String(),
+		Topic:     round.Topic(),
+		Status:    int(round.Status()),
+		Estimate:  round.Estimate(),
+		Timer:     int(round.Timer().Seconds()),
+		Deadline:  round.Deadline(),
+		CreatedAt: round.CreatedAt(),
+		UpdatedAt: round.UpdatedAt(),
+	}
+}
+
+// DeadlineFromDB Convert database deadline model to domain model.
+func DeadlineFromDB(dbDeadline DBDeadline) (entity.Deadline, error) {
+	id, err := common.ParseUID(dbDeadline.ID)
+	if err != nil {
+		return entity.Deadline{}, err
+	}
+
+	gameID, err := common.ParseUID(dbDeadline.GameID)
+	if err != nil {
+		return entity.Deadline{}, err
+	}
+
+	roundID, err := common.ParseUID(dbDeadline.RoundID)
+	if err != nil {
+		return entity.Deadline{}, err
+	}
+
+	return entity.HydrateDeadline(
+		id,
+		gameID,
+		roundID,
+		entity.DeadlineKind(dbDeadline.Kind),
+		dbDeadline.DueAt,
+		dbDeadline.CreatedAt,
+	), nil
+}
+
+// DeadlineToDB Convert domain deadline model to database model.
+func DeadlineToDB(deadline entity.Deadline) DBDeadline {
+	return DBDeadline{
+		ID:        deadline.ID().String(),
+		GameID:    deadline.GameID().String(),
+		RoundID:   deadline.RoundID().String(),
+		Kind:      int(deadline.Kind()),
+		DueAt:     deadline.DueAt(),
+		CreatedAt: deadline.CreatedAt(),
+	}
+}
+
+// JoinLinkFromDB Convert database join link model to domain model.
+func JoinLinkFromDB(dbLink DBJoinLink) (entity.JoinLink, error) {
+	gameID, err := common.ParseUID(dbLink.GameID)
+	if err != nil {
+		return entity.JoinLink{}, err
+	}
+
+	createdBy, err := common.ParseUID(dbLink.CreatedBy)
+	if err != nil {
+		return entity.JoinLink{}, err
+	}
+
+	return entity.HydrateJoinLink(dbLink.Code, gameID, createdBy, dbLink.ExpiresAt, dbLink.CreatedAt), nil
+}
+
+// JoinLinkToDB Convert domain join link model to database model.
+func JoinLinkToDB(link entity.JoinLink) DBJoinLink {
+	return DBJoinLink{
+		Code:      link.Code(),
+		GameID:    link.GameID().String(),
+		CreatedBy: link.CreatedBy().String(),
+		ExpiresAt: link.ExpiresAt(),
+		CreatedAt: link.CreatedAt(),
+	}
+}
+
+// EstimationFromDB Convert database estimation model with votes to domain model.
+func EstimationFromDB(dbEstimation DBEstimation, dbVotes []DBEstimationVote) (entity.Estimation, error) {
+	entityID, err := common.ParseUID(dbEstimation.ID)
+	if err != nil {
+		return entity.Estimation{}, err
+	}
+
+	gameID, err := common.ParseUID(dbEstimation.GameID)
+	if err != nil {
+		return entity.Estimation{}, err
+	}
+
+	roundID, err := common.ParseUID(dbEstimation.RoundID)
+	if err != nil {
+		return entity.Estimation{}, err
+	}
+
+	votes := make([]entity.EstimationVote, 0, len(dbVotes))
+	for _, dbVote := range dbVotes {
+		var userID common.UID
+		userID, err = common.ParseUID(dbVote.UserID)
+		if err != nil {
+			return entity.Estimation{}, err
+		}
+
+		votes = append(votes, entity.HydrateEstimationVote(userID, dbVote.Name, dbVote.Value))
+	}
+
+	return entity.HydrateEstimation(
+		entityID,
+		gameID,
+		roundID,
+		dbEstimation.Topic,
+		votes,
+		dbEstimation.Estimate,
+		dbEstimation.RevealedAt,
+	), nil
+}
+
+// EstimationToDB Convert domain estimation model to database model.
+func EstimationToDB(estimation entity.Estimation) DBEstimation {
+	return DBEstimation{
+		ID:         estimation.ID().String(),
+		GameID:     estimation.GameID().String(),
+		RoundID:    estimation.RoundID().String(),
+		Topic:      estimation.Topic(),
+		Estimate:   estimation.Estimate(),
+		RevealedAt: estimation.RevealedAt(),
+	}
+}
+
+// StoryFromDB Convert database story model to domain model.
+func StoryFromDB(dbStory DBStory) (entity.Story, error) {
+	entityID, err := common.ParseUID(dbStory.ID)
+	if err != nil {
+		return entity.Story{}, err
+	}
+
+	gameID, err := common.ParseUID(dbStory.GameID)
+	if err != nil {
+		return entity.Story{}, err
+	}
+
+	var roundID *common.UID
+	if dbStory.RoundID != nil {
+		var id common.UID
+		id, err = common.ParseUID(*dbStory.RoundID)
+		if err != nil {
+			return entity.Story{}, err
+		}
+
+		roundID = &id
+	}
+
+	return entity.HydrateStory(
+		entityID,
+		gameID,
+		dbStory.Key,
+		dbStory.Title,
+		dbStory.Description,
+		dbStory.Link,
+		dbStory.Position,
+		entity.StoryStatus(dbStory.Status),
+		roundID,
+		dbStory.Estimate,
+		dbStory.CreatedAt,
+		dbStory.UpdatedAt,
+	), nil
+}
+
+// StoryToDB Convert domain story model to database model.
+func StoryToDB(story entity.Story) DBStory {
+	var roundID *string
+	if story.RoundID() != nil {
+		id := story.RoundID().String()
+		roundID = &id
+	}
+
+	return DBStory{
+		ID:          story.ID().String(),
+		GameID:      story.GameID().String(),
+		Key:         story.Key(),
+		Title:       story.Title(),
+		Description: story.Description(),
+		Link:        story.Link(),
+		Position:    story.Position(),
+		Status:      int(story.Status()),
+		RoundID:     roundID,
+		Estimate:    story.Estimate(),
+		CreatedAt:   story.CreatedAt(),
+		UpdatedAt:   story.UpdatedAt(),
+	}
+}
+
+// SnapshotFromDB Convert database game snapshot to domain model.
+func SnapshotFromDB(dbSnapshot DBSnapshot) (entity.Game, error) {
+	state := DBGameState{}
+	if err := json.Unmarshal([]byte(dbSnapshot.Payload), &state); err != nil { //nolint:musttag // stored as is
+		return entity.Game{}, err
+	}
+
+	var round *entity.Round
+	if state.Round != nil {
+		current, err := RoundFromDB(*state.Round, state.Votes)
+		if err != nil {
+			return entity.Game{}, err
+		}
+
+		round = &current
+	}
+
+	return GameWithDetailsFromDB(state.Game, state.Members, round, state.Stories)
+}
+
+// SnapshotToDB Convert domain game model to database snapshot.
+func SnapshotToDB(game entity.Game) (DBSnapshot, error) {
+	state := DBGameState{
+		Game:    GameToDB(game),
+		Members: make([]DBMember, 0, len(game.GameMembers())),
+		Stories: make([]DBStory, 0, len(game.Stories())),
+	}
+	state.Game.CreatedAt = game.CreatedAt()
+	state.Game.UpdatedAt = game.UpdatedAt()
+
+	for _, member := range game.GameMembers() {
+		state.Members = append(state.Members, DBMember{
+			UserID: member.UserID().String(),
+			Name:   member.Name(),
+			Role:   int(member.Role()),
+		})
+	}
+
+	if round, ok := game.CurrentRound(); ok {
+		dbRound := RoundToDB(round)
+		state.Round = &dbRound
+
+		for _, vote := range round.Votes() {
+			state.Votes = append(state.Votes, DBVote{
+				UserID:    vote.UserID().String(),
+				Value:     vote.Value(),
+				CreatedAt: vote.CreatedAt(),
+			})
+		}
+	}
+
+	for _, story := range game.Stories() {
+		state.Stories = append(state.Stories, StoryToDB(story))
+	}
+
+	payload, err := json.Marshal(state) //nolint:musttag // stored as is
+	if err != nil {
+		return DBSnapshot{}, err
+	}
+
+	return DBSnapshot{Version: game.Version(), Payload: string(payload)}, nil
+}
+
+// EventFromDB Convert database game event to domain event.
+func EventFromDB(dbEvent DBEvent) (mediator.Event, error) {
+	decode, ok := eventDecoders[dbEvent.Kind]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", entity.ErrUnexpectedEvent, dbEvent.Kind)
+	}
+
+	return decode([]byte(dbEvent.Payload))
+}
+
+// EventToDB Convert domain event to database game event.
+func EventToDB(version int64, e mediator.Event) (DBEvent, error) {
+	var stored any = e
+	if vote, ok := e.(event.VoteCastEvent); ok {
+		stored = DBVoteCast{VoteCastEvent: vote, Value: vote.Value}
+	}
+
+	payload, err := json.Marshal(stored) //nolint:musttag // untagged events
+	if err != nil {
+		return DBEvent{}, err
+	}
+
+	return DBEvent{Version: version, Kind: e.Kind(), Payload: string(payload)}, nil
+}
+
+// eventDecoders Decode stored payload of the game event by its kind.
+var eventDecoders = map[string]func(payload []byte) (mediator.Event, error){
+	event.GameCreated:          decodeEvent[event.GameCreatedEvent],
+	event.GameUpdated:          decodeEvent[event.GameUpdatedEvent],
+	event.GameArchived:         decodeEvent[event.GameArchivedEvent],
+	event.GameDeleted:          decodeEvent[event.GameDeletedEvent],
+	event.MemberJoined:         decodeEvent[event.MemberJoinedEvent],
+	event.MemberInvited:        decodeEvent[event.MemberInvitedEvent],
+	event.MemberLeft:           decodeEvent[event.MemberLeftEvent],
+	event.MemberRemoved:        decodeEvent[event.MemberRemovedEvent],
+	event.MemberRoleChanged:    decodeEvent[event.MemberRoleChangedEvent],
+	event.OwnershipTransferred: decodeEvent[event.OwnershipTransferredEvent],
+	event.JoinLinkCreated:      decodeEvent[event.JoinLinkCreatedEvent],
+	event.StoriesImported:      decodeEvent[event.StoriesImportedEvent],
+	event.RoundStarted:         decodeEvent[event.RoundStartedEvent],
+	event.VoteCast:             decodeVoteCast,
+	event.RoundRevealed:        decodeEvent[event.RoundRevealedEvent],
+	event.RoundReset:           decodeEvent[event.RoundResetEvent],
+	event.RoundClosed:          decodeEvent[event.RoundClosedEvent],
+}
+
+func decodeEvent[T mediator.Event](payload []byte) (mediator.Event, error) {
+	var e T
+	if err := json.Unmarshal(payload, &e); err != nil { //nolint:musttag // untagged events
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func decodeVoteCast(payload []byte) (mediator.Event, error) {
+	stored := DBVoteCast{}
+	if err := json.Unmarshal(payload, &stored); err != nil {
+		return nil, err
+	}
+	stored.VoteCastEvent.Value = stored.Value
+
+	return stored.VoteCastEvent, nil
+}
+
+// DBGameSummary Database game summary representation.
+type DBGameSummary struct {
+	GameID         string     `db:"game_id"`
+	OrganizationID string     `db:"organization_id"`
+	Name           string     `db:"name"`
+	OwnerID        string     `db:"owner_id"`
+	OwnerName      string     `db:"owner_name"`
+	MemberCount    int        `db:"member_count"`
+	RoundStatus    *string    `db:"round_status"`
+	ArchivedAt     *time.Time `db:"archived_at"`
+	LastActivityAt time.Time  `db:"last_activity_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+// SummaryFromDB Convert database game summary to read model.
+func SummaryFromDB(dbSummary DBGameSummary) (ports.GameSummary, error) {
+	gameID, err := common.ParseUID(dbSummary.GameID)
+	if err != nil {
+		return ports.GameSummary{}, err
+	}
+
+	organizationID, err := common.ParseUID(dbSummary.OrganizationID)
+	if err != nil {
+		return ports.GameSummary{}, err
+	}
+
+	ownerID, err := common.ParseUID(dbSummary.OwnerID)
+	if err != nil {
+		return ports.GameSummary{}, err
+	}
+
+	summary := ports.GameSummary{
+		GameID:         gameID,
+		OrganizationID: organizationID,
+		Name:           dbSummary.Name,
+		OwnerID:        ownerID,
+		OwnerName:      dbSummary.OwnerName,
+		MemberCount:    dbSummary.MemberCount,
+		ArchivedAt:     dbSummary.ArchivedAt,
+		LastActivityAt: dbSummary.LastActivityAt,
+		CreatedAt:      dbSummary.CreatedAt,
+		UpdatedAt:      dbSummary.UpdatedAt,
+	}
+	if dbSummary.RoundStatus != nil {
+		summary.RoundStatus = *dbSummary.RoundStatus
+	}
+
+	return summary, nil
+}
+
+// SummaryToDB Convert game summary read model to database model.
+func SummaryToDB(summary ports.GameSummary) DBGameSummary {
+	dbSummary := DBGameSummary{
+		GameID:         summary.GameID.String(),
+		OrganizationID: summary.OrganizationID.String(),
+		Name:           summary.Name,
+		OwnerID:        summary.OwnerID.String(),
+		OwnerName:      summary.OwnerName,
+		MemberCount:    summary.MemberCount,
+		ArchivedAt:     summary.ArchivedAt,
+		LastActivityAt: summary.LastActivityAt,
+		CreatedAt:      summary.CreatedAt,
+		UpdatedAt:      summary.UpdatedAt,
+	}
+	if summary.RoundStatus != "" {
+		dbSummary.RoundStatus = &summary.RoundStatus
+	}
+
+	return dbSummary
 }
@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+// SaveEstimation store revealed round snapshot with its votes.
+func (g *gamePgStorage) SaveEstimation(ctx context.Context, estimation entity.Estimation) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.SaveEstimation")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "SaveEstimation.Organization")
+	}
+
+	db := g.getter.DefaultTrOrDB(ctx, g.db)
+	dbEstimation := EstimationToDB(estimation)
+
+	if _, err = db.ExecContext(
+		ctx,
+		CreateEstimationSQL,
+		dbEstimation.ID,
+		dbEstimation.GameID,
+		dbEstimation.RoundID,
+		dbEstimation.Topic,
+		dbEstimation.Estimate,
+		dbEstimation.RevealedAt,
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "SaveEstimation.ExecContext")
+	}
+
+	for _, vote := range estimation.Votes() {
+		if _, err = db.ExecContext(
+			ctx,
+			AddEstimationVoteSQL,
+			dbEstimation.ID,
+			vote.UserID().String(),
+			vote.Name(),
+			vote.Value(),
+			organizationID,
+		); err != nil {
+			return errors.Wrap(err, "SaveEstimation.AddVote")
+		}
+	}
+
+	return nil
+}
+
+// CloseEstimation set final estimate to the latest estimation of the round.
+func (g *gamePgStorage) CloseEstimation(ctx context.Context, roundID common.UID, estimate string) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.CloseEstimation")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "CloseEstimation.Organization")
+	}
+
+	if _, err = g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		CloseEstimationSQL,
+		roundID.String(),
+		estimate,
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "CloseEstimation.ExecContext")
+	}
+
+	return nil
+}
+
+// FetchEstimations Fetch game estimations in reveal order with given limit.
+func (g *gamePgStorage) FetchEstimations(
+	ctx context.Context,
+	gameID common.UID,
+	limit, offset int64,
+) ([]entity.Estimation, error) {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.FetchEstimations")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchEstimations.Organization")
+	}
+
+	estimations := make([]DBEstimation, 0)
+	if err = g.getter.DefaultTrOrDB(ctx, g.db).SelectContext(
+		ctx,
+		&estimations,
+		FetchEstimationsSQL,
+		gameID.String(),
+		limit,
+		offset,
+		organizationID,
+	); err != nil {
+		return nil, errors.Wrap(err, "FetchEstimations.SelectContext")
+	}
+
+	result := make([]entity.Estimation, 0, len(estimations))
+	for _, dbEstimation := range estimations {
+		estimation, err := g.estimationWithVotes(ctx, dbEstimation, organizationID)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, estimation)
+	}
+
+	return result, nil
+}
+
+// CountEstimations Count game estimations.
+func (g *gamePgStorage) CountEstimations(ctx context.Context, gameID common.UID) (int64, error) {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.CountEstimations")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "CountEstimations.Organization")
+	}
+
+	var count int64
+	if err = g.getter.DefaultTrOrDB(ctx, g.db).GetContext(
+		ctx,
+		&count,
+		CountEstimationsSQL,
+		gameID.String(),
+		organizationID,
+	); err != nil {
+		return 0, errors.Wrap(err, "CountEstimations.GetContext")
+	}
+
+	return count, nil
+}
+
+// GetLatestEstimation Get the latest estimation of the round.
+func (g *gamePgStorage) GetLatestEstimation(ctx context.Context, roundID common.UID) (entity.Estimation, error) {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.GetLatestEstimation")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return entity.Estimation{}, errors.Wrap(err, "GetLatestEstimation.Organization")
+	}
+
+	estimations := make([]DBEstimation, 0)
+	if err = g.getter.DefaultTrOrDB(ctx, g.db).SelectContext(
+		ctx,
+		&estimations,
+		GetLatestEstimationSQL,
+		roundID.String(),
+		organizationID,
+	); err != nil {
+		return entity.Estimation{}, errors.Wrap(err, "GetLatestEstimation.SelectContext")
+	}
+
+	if len(estimations) == 0 {
+		return entity.Estimation{}, core.ErrNotFound
+	}
+
+	return g.estimationWithVotes(ctx, estimations[0], organizationID)
+}
+
+func (g *gamePgStorage) estimationWithVotes(
+	ctx context.Context,
+	dbEstimation DBEstimation,
+	organizationID string,
+) (entity.Estimation, error) {
+	votes := make([]DBEstimationVote, 0)
+	if err := g.getter.DefaultTrOrDB(ctx, g.db).SelectContext(
+		ctx,
+		&votes,
+		GetEstimationVotesSQL,
+		dbEstimation.ID,
+		organizationID,
+	); err != nil {
+		return entity.Estimation{}, errors.Wrap(err, "estimationWithVotes.SelectContext")
+	}
+
+	estimation, err := EstimationFromDB(dbEstimation, votes)
+	if err != nil {
+		return entity.Estimation{}, errors.Wrap(err, "estimationWithVotes.EstimationFromDB")
+	}
+
+	return estimation, nil
+}
@@ -0,0 +1,216 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/event"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+// ErrUntrackedGame is returned for the changes of a game created before the event
+// store until its snapshot is backfilled.
+var ErrUntrackedGame = errors.New("game is not in the event store, backfill the game snapshots")
+
+// GetByID Rebuild game from its latest snapshot and the events recorded after it.
+// Games created before the event store are loaded from their tables, nothing is
+// written while reading, their snapshots are backfilled by BackfillSnapshots.
+func (g *gamePgStorage) GetByID(ctx context.Context, id common.UID) (entity.Game, error) {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.GetByID")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return entity.Game{}, errors.Wrap(err, "GetByID.Organization")
+	}
+
+	db := g.getter.DefaultTrOrDB(ctx, g.db)
+
+	dbSnapshots := make([]DBSnapshot, 0)
+	if err = db.SelectContext(ctx, &dbSnapshots, GetSnapshotSQL, id.String(), organizationID); err != nil {
+		return entity.Game{}, errors.Wrap(err, "GetByID.SelectSnapshot")
+	}
+
+	var snapshot *entity.Game
+	var version int64
+	if len(dbSnapshots) > 0 {
+		game, err := SnapshotFromDB(dbSnapshots[0])
+		if err != nil {
+			return entity.Game{}, errors.Wrap(err, "GetByID.SnapshotFromDB")
+		}
+
+		snapshot = &game
+		version = dbSnapshots[0].Version
+	}
+
+	dbEvents := make([]DBEvent, 0)
+	if err = db.SelectContext(
+		ctx,
+		&dbEvents,
+		GetEventsSQL,
+		id.String(),
+		version,
+		organizationID,
+	); err != nil {
+		return entity.Game{}, errors.Wrap(err, "GetByID.SelectEvents")
+	}
+
+	if snapshot == nil && len(dbEvents) == 0 {
+		return g.getState(ctx, id)
+	}
+
+	events := make([]mediator.Event, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		e, err := EventFromDB(dbEvent)
+		if err != nil {
+			return entity.Game{}, errors.Wrap(err, "GetByID.EventFromDB")
+		}
+
+		events = append(events, e)
+	}
+
+	game, err := entity.Rehydrate(snapshot, version, events)
+	if err != nil {
+		return entity.Game{}, errors.Wrap(err, "GetByID.Rehydrate")
+	}
+
+	if game.IsDeleted() {
+		return entity.Game{}, core.ErrNotFound
+	}
+
+	return game, nil
+}
+
+// AppendEvents Store game events after the expected version.
+func (g *gamePgStorage) AppendEvents(
+	ctx context.Context,
+	gameID common.UID,
+	expectedVersion int64,
+	events []mediator.Event,
+) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.AppendEvents")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "AppendEvents.Organization")
+	}
+
+	db := g.getter.DefaultTrOrDB(ctx, g.db)
+
+	// The history of a game created before the event store starts from its snapshot,
+	// the events recorded without it could not be replayed
+	if expectedVersion == 0 && len(events) > 0 && events[0].Kind() != event.GameCreated {
+		dbSnapshots := make([]DBSnapshot, 0)
+		if err = db.SelectContext(ctx, &dbSnapshots, GetSnapshotSQL, gameID.String(), organizationID); err != nil {
+			return errors.Wrap(err, "AppendEvents.SelectSnapshot")
+		}
+
+		if len(dbSnapshots) == 0 {
+			return ErrUntrackedGame
+		}
+	}
+
+	for i, e := range events {
+		dbEvent, err := EventToDB(expectedVersion+int64(i)+1, e)
+		if err != nil {
+			return errors.Wrap(err, "AppendEvents.EventToDB")
+		}
+
+		res, err := db.ExecContext(
+			ctx,
+			AppendEventSQL,
+			gameID.String(),
+			dbEvent.Version,
+			dbEvent.Kind,
+			dbEvent.Payload,
+			organizationID,
+		)
+		if err != nil {
+			return errors.Wrap(err, "AppendEvents.ExecContext")
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "AppendEvents.RowsAffected")
+		}
+
+		// Another transaction has already recorded an event with this version,
+		// or the game is not owned by the organization
+		if affected == 0 {
+			return core.ErrConcurrentModification
+		}
+	}
+
+	return nil
+}
+
+// SaveSnapshot Store game state unless a newer snapshot exists.
+func (g *gamePgStorage) SaveSnapshot(ctx context.Context, game entity.Game) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.SaveSnapshot")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "SaveSnapshot.Organization")
+	}
+
+	dbSnapshot, err := SnapshotToDB(game)
+	if err != nil {
+		return errors.Wrap(err, "SaveSnapshot.SnapshotToDB")
+	}
+
+	if _, err = g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		SaveSnapshotSQL,
+		game.ID().String(),
+		dbSnapshot.Version,
+		dbSnapshot.Payload,
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "SaveSnapshot.ExecContext")
+	}
+
+	return nil
+}
+
+// FetchUntrackedGameIDs Get ids of the games created before the event store, they
+// have neither a snapshot nor events.
+func (g *gamePgStorage) FetchUntrackedGameIDs(ctx context.Context, afterID string, limit int64) ([]common.UID, error) {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.FetchUntrackedGameIDs")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchUntrackedGameIDs.Organization")
+	}
+
+	dbIDs := make([]string, 0)
+	if err := g.getter.DefaultTrOrDB(ctx, g.db).SelectContext(
+		ctx,
+		&dbIDs,
+		FetchUntrackedGameIDsSQL,
+		afterID,
+		limit,
+		organizationID,
+	); err != nil {
+		return nil, errors.Wrap(err, "FetchUntrackedGameIDs.SelectContext")
+	}
+
+	ids := make([]common.UID, 0, len(dbIDs))
+	for _, dbID := range dbIDs {
+		id, err := common.ParseUID(dbID)
+		if err != nil {
+			return nil, errors.Wrap(err, "FetchUntrackedGameIDs.ParseUID")
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,167 @@
+package postgres_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/game/infrastructure/gateway/postgres"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+type deckMock struct{}
+
+func (deckMock) HasCard(_ string) bool {
+	return true
+}
+
+func newStorage(t *testing.T) (*sqlx.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = mockDB.Close()
+	})
+
+	return sqlx.NewDb(mockDB, "sqlmock"), mock
+}
+
+func TestGetByIDReplaysEvents(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	ownerID := common.NewUID()
+	memberID := common.NewUID()
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", ownerID, "Owner", common.NewUID())
+	require.NoError(t, err)
+	require.NoError(t, game.AddMember(memberID, "Bob"))
+	_, err = game.StartRound(ownerID, "Login page", 0)
+	require.NoError(t, err)
+	require.NoError(t, game.CastVote(memberID, "5", deckMock{}))
+
+	// The first events are folded into the snapshot
+	events := game.Events()
+	snapshotGame, err := entity.Rehydrate(nil, 0, events[:2])
+	require.NoError(t, err)
+	snapshot, err := postgres.SnapshotToDB(snapshotGame)
+	require.NoError(t, err)
+
+	rows := sqlmock.NewRows([]string{"version", "kind", "payload"})
+	for i, e := range events[2:] {
+		var dbEvent postgres.DBEvent
+		dbEvent, err = postgres.EventToDB(int64(i+3), e)
+		require.NoError(t, err)
+		rows.AddRow(dbEvent.Version, dbEvent.Kind, dbEvent.Payload)
+	}
+
+	db, mock := newStorage(t)
+	mock.ExpectQuery(regexp.QuoteMeta(postgres.GetSnapshotSQL)).
+		WithArgs(game.ID().String(), game.OrganizationID().String()).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "payload"}).AddRow(snapshot.Version, snapshot.Payload))
+	mock.ExpectQuery(regexp.QuoteMeta(postgres.GetEventsSQL)).
+		WithArgs(game.ID().String(), int64(2), game.OrganizationID().String()).
+		WillReturnRows(rows)
+
+	storage := postgres.NewGamePgStorage(db, trmsqlx.DefaultCtxGetter, log)
+	ctx := tenant.WithOrganization(context.Background(), game.OrganizationID().String())
+	loaded, err := storage.GetByID(ctx, game.ID())
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	assert.Equal(t, game.Version(), loaded.Version())
+	assert.True(t, loaded.IsMember(memberID))
+
+	round, ok := loaded.CurrentRound()
+	require.True(t, ok)
+	require.Len(t, round.Votes(), 1)
+	assert.Equal(t, "5", round.Votes()[0].Value())
+}
+
+func TestAppendEventsConflict(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", common.NewUID(), "Owner", common.NewUID())
+	require.NoError(t, err)
+	require.NoError(t, game.Update(game.OwnerID(), "Sprint 43", game.DeckID()))
+	events := game.Events()
+
+	db, mock := newStorage(t)
+	mock.ExpectExec(regexp.QuoteMeta(postgres.AppendEventSQL)).
+		WithArgs(game.ID().String(), int64(1), "GameCreated", sqlmock.AnyArg(), "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(postgres.AppendEventSQL)).
+		WithArgs(game.ID().String(), int64(2), "GameUpdated", sqlmock.AnyArg(), "").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	storage := postgres.NewGamePgStorage(db, trmsqlx.DefaultCtxGetter, log)
+	err = storage.AppendEvents(tenant.Unscoped(context.Background()), game.ID(), 0, events)
+	require.ErrorIs(t, err, core.ErrConcurrentModification)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetByIDRequiresOrganization(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	db, mock := newStorage(t)
+
+	storage := postgres.NewGamePgStorage(db, trmsqlx.DefaultCtxGetter, log)
+	_, err := storage.GetByID(context.Background(), common.NewUID())
+	require.ErrorIs(t, err, tenant.ErrNoOrganization)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAppendEventsUntrackedGame(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	game, err := entity.NewGame(common.NewUID(), "Sprint 42", common.NewUID(), "Owner", common.NewUID())
+	require.NoError(t, err)
+	require.NoError(t, game.Update(game.OwnerID(), "Sprint 43", game.DeckID()))
+	// The game predates the event store, its creation was never recorded
+	events := game.Events()[1:]
+
+	db, mock := newStorage(t)
+	mock.ExpectQuery(regexp.QuoteMeta(postgres.GetSnapshotSQL)).
+		WithArgs(game.ID().String(), "").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "payload"}))
+
+	storage := postgres.NewGamePgStorage(db, trmsqlx.DefaultCtxGetter, log)
+	err = storage.AppendEvents(tenant.Unscoped(context.Background()), game.ID(), 0, events)
+	require.ErrorIs(t, err, postgres.ErrUntrackedGame)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// The events are recorded once the snapshot is backfilled
+	snapshot, err := postgres.SnapshotToDB(game)
+	require.NoError(t, err)
+	mock.ExpectQuery(regexp.QuoteMeta(postgres.GetSnapshotSQL)).
+		WithArgs(game.ID().String(), "").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "payload"}).AddRow(0, snapshot.Payload))
+	mock.ExpectExec(regexp.QuoteMeta(postgres.AppendEventSQL)).
+		WithArgs(game.ID().String(), int64(1), "GameUpdated", sqlmock.AnyArg(), "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = storage.AppendEvents(tenant.Unscoped(context.Background()), game.ID(), 0, events)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jmoiron/sqlx"
@@ -14,6 +15,7 @@ import (
 	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
 	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
 	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
 )
 
 type gamePgStorage struct {
@@ -32,63 +34,16 @@ func NewGamePgStorage(db *sqlx.DB, getter *trmsqlx.CtxGetter, logger logger.Logg
 	}
 }
 
-// Fetch users with given limit.
-// TODO: think about offset - use numeric or time offset?
-func (g *gamePgStorage) Fetch(ctx context.Context, limit, offset int64) ([]entity.Game, error) {
-	ctx, span := g.tracer.Start(ctx, "gamePgStorage.Fetch")
-	defer span.End()
-
-	stmt, err := g.getter.DefaultTrOrDB(ctx, g.db).PreparexContext(ctx, FetchSQL)
-	if err != nil {
-		return nil, errors.Wrap(err, "Fetch.PreparexContext")
-	}
-	defer func() {
-		err = stmt.Close()
-		if err != nil {
-			g.logger.Errorf("[gamePgStorage.Fetch] can't close fetch statement, err: %v", err)
-		}
-	}()
-
-	rows, err := stmt.QueryxContext(ctx, limit, offset)
-	if err != nil || rows.Err() != nil {
-		g.logger.Errorf("[gamePgStorage.Fetch] Can't fetch gmae with limit %d and offset %d, err: %v", limit, offset, err)
-		return nil, errors.Wrap(err, "Fetch.QueryxContext")
-	}
-
-	defer func() {
-		errRow := rows.Close()
-		if errRow != nil {
-			g.logger.Errorf("[gamePgStorage.Fetch] Can't close fetched game rows, err: %v", errRow)
-		}
-	}()
-
-	result := make([]entity.Game, 0)
-	for rows.Next() {
-		game := DBGame{}
-
-		err = rows.StructScan(&game)
-		if err != nil {
-			g.logger.Errorf("[gamePgStorage.Fetch] Can't scan game data. err: %v", err)
-			return nil, errors.Wrap(err, "Fetch.StructScan")
-		}
-
-		var gameEntity entity.Game
-		gameEntity, err = GameFromDB(game)
-		if err != nil {
-			g.logger.Errorf("[gamePgStorage.Fetch] Can't convert game data to domain entity. err: %v", err)
-			return nil, errors.Wrap(err, "Fetch.GameFromDB")
-		}
-		result = append(result, gameEntity)
-	}
-
-	return result, nil
-}
-
 // Create new game.
 func (g *gamePgStorage) Create(ctx context.Context, d entity.Game) error {
 	ctx, span := g.tracer.Start(ctx, "gamePgStorage.Create")
 	defer span.End()
 
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Create.Organization")
+	}
+
 	// Create game
 	createStmt, err := g.getter.DefaultTrOrDB(ctx, g.db).PreparexContext(ctx, CreateSQL)
 	if err != nil {
@@ -105,9 +60,16 @@ func (g *gamePgStorage) Create(ctx context.Context, d entity.Game) error {
 	if err = createStmt.QueryRowxContext(
 		ctx,
 		game.ID,
+		game.OrganizationID,
 		game.Name,
 		game.OwnerID,
+		game.DeckID,
+		organizationID,
 	).StructScan(&game); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return core.ErrNotFound
+		}
+
 		return errors.Wrap(err, "Create.QueryRowxContext")
 	}
 
@@ -123,7 +85,13 @@ func (g *gamePgStorage) Create(ctx context.Context, d entity.Game) error {
 		}
 	}()
 
-	if _, err = addUserStmt.ExecContext(ctx, game.ID, game.OwnerID, 1); err != nil {
+	if _, err = addUserStmt.ExecContext(
+		ctx,
+		game.ID,
+		game.OwnerID,
+		int(entity.RoleOwner),
+		organizationID,
+	); err != nil {
 		g.logger.Errorf("can't add user to game, err: %v", err)
 		return errors.Wrap(err, "Create.ExecContext")
 	}
@@ -131,25 +99,87 @@ func (g *gamePgStorage) Create(ctx context.Context, d entity.Game) error {
 	return nil
 }
 
-func (g *gamePgStorage) GetByID(ctx context.Context, id common.UID) (entity.Game, error) {
-	ctx, span := g.tracer.Start(ctx, "gamePgStorage.GetByID")
+// Update Store game attributes.
+func (g *gamePgStorage) Update(ctx context.Context, d entity.Game) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.Update")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Update.Organization")
+	}
+
+	game := GameToDB(d)
+	res, err := g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		UpdateSQL,
+		game.ID,
+		game.Name,
+		game.OwnerID,
+		game.DeckID,
+		game.ArchivedAt,
+		organizationID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Update.ExecContext")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "Update.RowsAffected")
+	}
+
+	if affected == 0 {
+		return core.ErrNotFound
+	}
+
+	return nil
+}
+
+// Delete Remove game with its members, rounds and join links.
+func (g *gamePgStorage) Delete(ctx context.Context, id common.UID) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.Delete")
 	defer span.End()
 
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Delete.Organization")
+	}
+
+	if _, err = g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		DeleteSQL,
+		id.String(),
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "Delete.ExecContext")
+	}
+
+	return nil
+}
+
+// getState Get game by id with its members, current round and stories from the game tables.
+func (g *gamePgStorage) getState(ctx context.Context, id common.UID) (entity.Game, error) {
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return entity.Game{}, errors.Wrap(err, "getState.Organization")
+	}
+
 	stmt, err := g.getter.DefaultTrOrDB(ctx, g.db).PreparexContext(ctx, GetByIDSQL)
 	if err != nil {
-		return entity.Game{}, errors.Wrap(err, "getByParam.PreparexContext")
+		return entity.Game{}, errors.Wrap(err, "getState.PreparexContext")
 	}
 	defer func() {
 		err = stmt.Close()
 		if err != nil {
-			g.logger.Errorf("can't close GetByID statement, err: %v", err)
+			g.logger.Errorf("can't close getState statement, err: %v", err)
 		}
 	}()
 
-	rows, err := stmt.QueryxContext(ctx, id)
+	rows, err := stmt.QueryxContext(ctx, id.String(), organizationID)
 	if err != nil || rows.Err() != nil {
 		g.logger.Errorf("Can't fetch game by id, err: %v", err)
-		return entity.Game{}, errors.Wrap(err, "getByParam.QueryxContext")
+		return entity.Game{}, errors.Wrap(err, "getState.QueryxContext")
 	}
 
 	defer func() {
@@ -159,29 +189,268 @@ func (g *gamePgStorage) GetByID(ctx context.Context, id common.UID) (entity.Game
 		}
 	}()
 
-	result := make([]entity.Game, 0)
+	result := make([]DBGame, 0)
 	for rows.Next() {
 		game := DBGame{}
 
 		err = rows.StructScan(&game)
 		if err != nil {
 			g.logger.Errorf("Can't scan game data. err: %v", err)
-			return entity.Game{}, errors.Wrap(err, "getByParam.StructScan")
+			return entity.Game{}, errors.Wrap(err, "getState.StructScan")
 		}
 
-		var gameEntity entity.Game
-		gameEntity, err = GameFromDB(game)
-		if err != nil {
-			g.logger.Errorf("Can't convert game data to domain entity. err: %v", err)
-			return entity.Game{}, errors.Wrap(err, "getByParam.GameFromDB")
-		}
+		result = append(result, game)
+	}
+
+	if len(result) == 0 {
+		return entity.Game{}, core.ErrNotFound
+	}
+
+	members := make([]DBMember, 0)
+	err = g.getter.DefaultTrOrDB(ctx, g.db).SelectContext(
+		ctx,
+		&members,
+		GetMembersSQL,
+		id.String(),
+		organizationID,
+	)
+	if err != nil {
+		return entity.Game{}, errors.Wrap(err, "getState.SelectMembers")
+	}
+
+	round, err := g.getCurrentRound(ctx, id, organizationID)
+	if err != nil {
+		return entity.Game{}, err
+	}
+
+	stories := make([]DBStory, 0)
+	err = g.getter.DefaultTrOrDB(ctx, g.db).SelectContext(
+		ctx,
+		&stories,
+		GetStoriesSQL,
+		id.String(),
+		organizationID,
+	)
+	if err != nil {
+		return entity.Game{}, errors.Wrap(err, "getState.SelectStories")
+	}
+
+	gameEntity, err := GameWithDetailsFromDB(result[0], members, round, stories)
+	if err != nil {
+		g.logger.Errorf("Can't convert game data to domain entity. err: %v", err)
+		return entity.Game{}, errors.Wrap(err, "getState.GameWithDetailsFromDB")
+	}
+
+	return gameEntity, nil
+}
+
+// SaveRound upsert round state and replace its votes.
+func (g *gamePgStorage) SaveRound(ctx context.Context, round entity.Round) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.SaveRound")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "SaveRound.Organization")
+	}
 
-		if len(result) == 0 {
-			return entity.Game{}, core.ErrNotFound
+	db := g.getter.DefaultTrOrDB(ctx, g.db)
+	dbRound := RoundToDB(round)
+
+	if _, err = db.ExecContext(
+		ctx,
+		SaveRoundSQL,
+		dbRound.ID,
+		dbRound.GameID,
+		dbRound.Topic,
+		dbRound.Status,
+		dbRound.Estimate,
+		dbRound.Timer,
+		dbRound.Deadline,
+		dbRound.CreatedAt,
+		dbRound.UpdatedAt,
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "SaveRound.ExecContext")
+	}
+
+	if _, err = db.ExecContext(ctx, DeleteVotesSQL, dbRound.ID, organizationID); err != nil {
+		return errors.Wrap(err, "SaveRound.DeleteVotes")
+	}
+
+	for _, vote := range round.Votes() {
+		if _, err = db.ExecContext(
+			ctx,
+			AddVoteSQL,
+			dbRound.ID,
+			vote.UserID().String(),
+			vote.Value(),
+			vote.CreatedAt(),
+			organizationID,
+		); err != nil {
+			return errors.Wrap(err, "SaveRound.AddVote")
 		}
+	}
+
+	return nil
+}
+
+func (g *gamePgStorage) getCurrentRound(
+	ctx context.Context,
+	gameID common.UID,
+	organizationID string,
+) (*entity.Round, error) {
+	db := g.getter.DefaultTrOrDB(ctx, g.db)
+
+	rounds := make([]DBRound, 0)
+	if err := db.SelectContext(ctx, &rounds, GetCurrentRoundSQL, gameID.String(), organizationID); err != nil {
+		return nil, errors.Wrap(err, "getCurrentRound.SelectContext")
+	}
+
+	if len(rounds) == 0 {
+		return nil, nil //nolint:nilnil // game has no rounds yet
+	}
+
+	votes := make([]DBVote, 0)
+	if err := db.SelectContext(ctx, &votes, GetVotesSQL, rounds[0].ID, organizationID); err != nil {
+		return nil, errors.Wrap(err, "getCurrentRound.SelectVotes")
+	}
+
+	round, err := RoundFromDB(rounds[0], votes)
+	if err != nil {
+		return nil, errors.Wrap(err, "getCurrentRound.RoundFromDB")
+	}
+
+	return &round, nil
+}
+
+// AddMember add user to the game members with the given role.
+func (g *gamePgStorage) AddMember(ctx context.Context, gameID, userID common.UID, role entity.Role) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.AddMember")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "AddMember.Organization")
+	}
+
+	if _, err = g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		AddUserSQL,
+		gameID.String(),
+		userID.String(),
+		int(role),
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "AddMember.ExecContext")
+	}
+
+	return nil
+}
 
-		result = append(result, gameEntity)
+// UpdateMemberRole change role of the game member.
+func (g *gamePgStorage) UpdateMemberRole(ctx context.Context, gameID, userID common.UID, role entity.Role) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.UpdateMemberRole")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "UpdateMemberRole.Organization")
+	}
+
+	if _, err = g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		UpdateUserRoleSQL,
+		gameID.String(),
+		userID.String(),
+		int(role),
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "UpdateMemberRole.ExecContext")
+	}
+
+	return nil
+}
+
+// RemoveMember remove user from the game members.
+func (g *gamePgStorage) RemoveMember(ctx context.Context, gameID, userID common.UID) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.RemoveMember")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "RemoveMember.Organization")
+	}
+
+	if _, err = g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		RemoveUserSQL,
+		gameID.String(),
+		userID.String(),
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "RemoveMember.ExecContext")
+	}
+
+	return nil
+}
+
+// CreateJoinLink store new join link.
+func (g *gamePgStorage) CreateJoinLink(ctx context.Context, link entity.JoinLink) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.CreateJoinLink")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "CreateJoinLink.Organization")
+	}
+
+	dbLink := JoinLinkToDB(link)
+	if _, err = g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		CreateJoinLinkSQL,
+		dbLink.Code,
+		dbLink.GameID,
+		dbLink.CreatedBy,
+		dbLink.ExpiresAt,
+		dbLink.CreatedAt,
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "CreateJoinLink.ExecContext")
+	}
+
+	return nil
+}
+
+// GetJoinLink Get join link by code.
+func (g *gamePgStorage) GetJoinLink(ctx context.Context, code string) (entity.JoinLink, error) {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.GetJoinLink")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return entity.JoinLink{}, errors.Wrap(err, "GetJoinLink.Organization")
+	}
+
+	links := make([]DBJoinLink, 0)
+	if err = g.getter.DefaultTrOrDB(ctx, g.db).SelectContext(
+		ctx,
+		&links,
+		GetJoinLinkSQL,
+		code,
+		organizationID,
+	); err != nil {
+		return entity.JoinLink{}, errors.Wrap(err, "GetJoinLink.SelectContext")
+	}
+
+	if len(links) == 0 {
+		return entity.JoinLink{}, core.ErrNotFound
+	}
+
+	link, err := JoinLinkFromDB(links[0])
+	if err != nil {
+		return entity.JoinLink{}, errors.Wrap(err, "GetJoinLink.JoinLinkFromDB")
 	}
 
-	return result[0], nil
+	return link, nil
 }
@@ -3,15 +3,126 @@ package postgres
 import _ "embed"
 
 var (
-	//go:embed query/fetch.sql
-	FetchSQL string
-
 	//go:embed query/create.sql
 	CreateSQL string
 
+	//go:embed query/update.sql
+	UpdateSQL string
+
+	//go:embed query/delete.sql
+	DeleteSQL string
+
 	//go:embed query/addUser.sql
 	AddUserSQL string
 
+	//go:embed query/removeUser.sql
+	RemoveUserSQL string
+
+	//go:embed query/updateUserRole.sql
+	UpdateUserRoleSQL string
+
+	//go:embed query/createJoinLink.sql
+	CreateJoinLinkSQL string
+
+	//go:embed query/getJoinLink.sql
+	GetJoinLinkSQL string
+
 	//go:embed query/getByID.sql
 	GetByIDSQL string
+
+	//go:embed query/getMembers.sql
+	GetMembersSQL string
+
+	//go:embed query/getCurrentRound.sql
+	GetCurrentRoundSQL string
+
+	//go:embed query/getVotes.sql
+	GetVotesSQL string
+
+	//go:embed query/saveRound.sql
+	SaveRoundSQL string
+
+	//go:embed query/deleteVotes.sql
+	DeleteVotesSQL string
+
+	//go:embed query/addVote.sql
+	AddVoteSQL string
+
+	//go:embed query/createDeadline.sql
+	CreateDeadlineSQL string
+
+	//go:embed query/cancelDeadlines.sql
+	CancelDeadlinesSQL string
+
+	//go:embed query/deleteDeadline.sql
+	DeleteDeadlineSQL string
+
+	//go:embed query/fetchDueDeadlines.sql
+	FetchDueDeadlinesSQL string
+
+	//go:embed query/lockDeadlines.sql
+	LockDeadlinesSQL string
+
+	//go:embed query/getStories.sql
+	GetStoriesSQL string
+
+	//go:embed query/addStory.sql
+	AddStorySQL string
+
+	//go:embed query/updateStory.sql
+	UpdateStorySQL string
+
+	//go:embed query/createEstimation.sql
+	CreateEstimationSQL string
+
+	//go:embed query/addEstimationVote.sql
+	AddEstimationVoteSQL string
+
+	//go:embed query/closeEstimation.sql
+	CloseEstimationSQL string
+
+	//go:embed query/fetchEstimations.sql
+	FetchEstimationsSQL string
+
+	//go:embed query/countEstimations.sql
+	CountEstimationsSQL string
+
+	//go:embed query/getLatestEstimation.sql
+	GetLatestEstimationSQL string
+
+	//go:embed query/getEstimationVotes.sql
+	GetEstimationVotesSQL string
+
+	//go:embed query/appendEvent.sql
+	AppendEventSQL string
+
+	//go:embed query/getEvents.sql
+	GetEventsSQL string
+
+	//go:embed query/saveSnapshot.sql
+	SaveSnapshotSQL string
+
+	//go:embed query/getSnapshot.sql
+	GetSnapshotSQL string
+
+	//go:embed query/saveSummary.sql
+	SaveSummarySQL string
+
+	//go:embed query/getSummary.sql
+	GetSummarySQL string
+
+	//go:embed query/fetchSummaries.sql
+	FetchSummariesSQL string
+
+	//go:embed query/deleteSummary.sql
+	DeleteSummarySQL string
+
+	//go:embed query/clearSummaries.sql
+	ClearSummariesSQL string
+
+	//go:embed query/fetchGameIDs.sql
+	FetchGameIDsSQL string
+
+	//go:embed query/fetchUntrackedGameIDs.sql
+	FetchUntrackedGameIDsSQL string
 )
@@ -0,0 +1,6 @@
+INSERT INTO game_estimation_votes (estimation_id, user_id, name, value)
+SELECT $1::varchar, $2::varchar, $3::varchar, $4::varchar
+WHERE ($5 = '' OR $1 IN (SELECT e.id
+                       FROM game_estimations e
+                                JOIN games g ON g.id = e.game_id
+                       WHERE g.organization_id = $5))
\ No newline at end of file
@@ -0,0 +1,4 @@
+INSERT INTO game_stories (id, game_id, key, title, description, link, position, status, created_at, updated_at)
+SELECT $1::varchar, $2::varchar, $3::varchar, $4::varchar, $5::text, $6::varchar, $7::integer, $8::smallint,
+       $9::timestamptz, $10::timestamptz
+WHERE $11 = '' OR EXISTS (SELECT 1 FROM games WHERE id = $2 AND organization_id = $11)
\ No newline at end of file
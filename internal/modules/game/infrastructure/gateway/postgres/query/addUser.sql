@@ -1,2 +1,3 @@
 INSERT INTO game_user (game_id, user_id, role)
-VALUES ($1, $2, $3)
\ No newline at end of file
+SELECT $1::varchar, $2::varchar, $3::numeric
+WHERE $4 = '' OR EXISTS (SELECT 1 FROM games WHERE id = $1 AND organization_id = $4)
\ No newline at end of file
@@ -0,0 +1,6 @@
+INSERT INTO game_votes (round_id, user_id, value, created_at)
+SELECT $1::varchar, $2::varchar, $3::varchar, $4::timestamptz
+WHERE ($5 = '' OR $1 IN (SELECT r.id
+                       FROM game_rounds r
+                                JOIN games g ON g.id = r.game_id
+                       WHERE g.organization_id = $5))
\ No newline at end of file
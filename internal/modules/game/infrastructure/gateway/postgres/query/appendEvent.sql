@@ -0,0 +1,4 @@
+INSERT INTO game_events (game_id, version, kind, payload)
+SELECT $1::varchar, $2::bigint, $3::varchar, $4::jsonb
+WHERE $5 = '' OR EXISTS (SELECT 1 FROM games WHERE id = $1 AND organization_id = $5)
+ON CONFLICT (game_id, version) DO NOTHING
\ No newline at end of file
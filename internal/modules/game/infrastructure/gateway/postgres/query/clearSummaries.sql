@@ -0,0 +1,3 @@
+DELETE
+FROM game_summary
+WHERE ($1 = '' OR organization_id = $1)
\ No newline at end of file
@@ -0,0 +1,8 @@
+UPDATE game_estimations
+SET estimate = $2
+WHERE id = (SELECT id
+            FROM game_estimations
+            WHERE round_id = $1
+            ORDER BY revealed_at DESC
+            LIMIT 1)
+  AND ($3 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $3))
\ No newline at end of file
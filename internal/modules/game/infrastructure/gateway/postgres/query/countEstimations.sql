@@ -0,0 +1,4 @@
+SELECT COUNT(*)
+FROM game_estimations
+WHERE game_id = $1
+  AND ($2 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $2))
\ No newline at end of file
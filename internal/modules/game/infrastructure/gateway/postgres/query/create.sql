@@ -1,3 +1,4 @@
-INSERT INTO games (id, name, owner_id)
-VALUES ($1, $2, $3)
+INSERT INTO games (id, organization_id, name, owner_id, deck_id)
+SELECT $1::varchar, $2::varchar, $3::varchar, $4::varchar, $5::varchar
+WHERE $6 = '' OR $2 = $6
 RETURNING id
\ No newline at end of file
@@ -0,0 +1,3 @@
+INSERT INTO game_estimations (id, game_id, round_id, topic, estimate, revealed_at)
+SELECT $1::varchar, $2::varchar, $3::varchar, $4::text, $5::varchar, $6::timestamptz
+WHERE $7 = '' OR EXISTS (SELECT 1 FROM games WHERE id = $2 AND organization_id = $7)
\ No newline at end of file
@@ -0,0 +1,3 @@
+INSERT INTO game_join_links (code, game_id, created_by, expires_at, created_at)
+SELECT $1::varchar, $2::varchar, $3::varchar, $4::timestamptz, $5::timestamptz
+WHERE $6 = '' OR EXISTS (SELECT 1 FROM games WHERE id = $2 AND organization_id = $6)
\ No newline at end of file
@@ -0,0 +1,4 @@
+DELETE
+FROM game_deadlines
+WHERE id = $1
+  AND ($2 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $2))
\ No newline at end of file
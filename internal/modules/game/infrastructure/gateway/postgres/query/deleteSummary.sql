@@ -0,0 +1,4 @@
+DELETE
+FROM game_summary
+WHERE game_id = $1
+  AND ($2 = '' OR organization_id = $2)
\ No newline at end of file
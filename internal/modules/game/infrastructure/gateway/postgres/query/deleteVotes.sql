@@ -0,0 +1,6 @@
+DELETE FROM game_votes
+WHERE round_id = $1
+  AND ($2 = '' OR round_id IN (SELECT r.id
+                       FROM game_rounds r
+                                JOIN games g ON g.id = r.game_id
+                       WHERE g.organization_id = $2))
\ No newline at end of file
@@ -0,0 +1,11 @@
+SELECT id,
+       game_id,
+       round_id,
+       kind,
+       due_at,
+       created_at
+FROM game_deadlines
+WHERE due_at <= $1
+  AND ($3 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $3))
+ORDER BY due_at
+LIMIT $2 FOR UPDATE SKIP LOCKED
\ No newline at end of file
@@ -0,0 +1,11 @@
+SELECT id,
+       game_id,
+       round_id,
+       topic,
+       estimate,
+       revealed_at
+FROM game_estimations
+WHERE game_id = $1
+  AND ($4 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $4))
+ORDER BY revealed_at
+LIMIT $2 OFFSET $3
\ No newline at end of file
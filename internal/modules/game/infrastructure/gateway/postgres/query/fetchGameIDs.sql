@@ -0,0 +1,6 @@
+SELECT id
+FROM games
+WHERE id > $1
+  AND ($3 = '' OR organization_id = $3)
+ORDER BY id
+LIMIT $2
\ No newline at end of file
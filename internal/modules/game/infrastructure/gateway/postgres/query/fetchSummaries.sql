@@ -0,0 +1,16 @@
+SELECT game_id,
+       organization_id,
+       name,
+       owner_id,
+       owner_name,
+       member_count,
+       round_status,
+       archived_at,
+       last_activity_at,
+       created_at,
+       updated_at
+FROM game_summary
+WHERE ($3 OR archived_at IS NULL)
+  AND ($4 = '' OR organization_id = $4)
+ORDER BY created_at
+LIMIT $1 OFFSET $2
\ No newline at end of file
@@ -0,0 +1,8 @@
+SELECT id
+FROM games
+WHERE id > $1
+  AND ($3 = '' OR organization_id = $3)
+  AND NOT EXISTS (SELECT 1 FROM game_snapshots WHERE game_id = games.id)
+  AND NOT EXISTS (SELECT 1 FROM game_events WHERE game_id = games.id)
+ORDER BY id
+LIMIT $2
\ No newline at end of file
@@ -1,7 +1,11 @@
 SELECT id,
+       organization_id,
        name,
        owner_id,
+       deck_id,
+       archived_at,
        created_at,
        updated_at
 FROM games
-WHERE id = $1
\ No newline at end of file
+WHERE id = $1
+  AND ($2 = '' OR organization_id = $2)
\ No newline at end of file
@@ -0,0 +1,14 @@
+SELECT id,
+       game_id,
+       topic,
+       status,
+       estimate,
+       timer,
+       deadline,
+       created_at,
+       updated_at
+FROM game_rounds
+WHERE game_id = $1
+  AND ($2 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $2))
+ORDER BY created_at DESC
+LIMIT 1
\ No newline at end of file
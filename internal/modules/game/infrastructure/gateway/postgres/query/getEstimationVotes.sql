@@ -0,0 +1,10 @@
+SELECT user_id,
+       name,
+       value
+FROM game_estimation_votes
+WHERE estimation_id = $1
+  AND ($2 = '' OR estimation_id IN (SELECT e.id
+                       FROM game_estimations e
+                                JOIN games g ON g.id = e.game_id
+                       WHERE g.organization_id = $2))
+ORDER BY name, user_id
\ No newline at end of file
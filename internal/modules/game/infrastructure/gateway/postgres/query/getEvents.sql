@@ -0,0 +1,6 @@
+SELECT version, kind, payload
+FROM game_events
+WHERE game_id = $1
+  AND version > $2
+  AND ($3 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $3))
+ORDER BY version
\ No newline at end of file
@@ -0,0 +1,8 @@
+SELECT code,
+       game_id,
+       created_by,
+       expires_at,
+       created_at
+FROM game_join_links
+WHERE code = $1
+  AND ($2 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $2))
\ No newline at end of file
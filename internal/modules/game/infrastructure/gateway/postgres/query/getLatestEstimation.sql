@@ -0,0 +1,11 @@
+SELECT id,
+       game_id,
+       round_id,
+       topic,
+       estimate,
+       revealed_at
+FROM game_estimations
+WHERE round_id = $1
+  AND ($2 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $2))
+ORDER BY revealed_at DESC
+LIMIT 1
\ No newline at end of file
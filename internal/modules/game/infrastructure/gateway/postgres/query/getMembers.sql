@@ -0,0 +1,7 @@
+SELECT gu.user_id,
+       u.name,
+       gu.role::int AS role
+FROM game_user gu
+         JOIN users u ON u.id = gu.user_id
+WHERE gu.game_id = $1
+  AND ($2 = '' OR gu.game_id IN (SELECT id FROM games WHERE organization_id = $2))
\ No newline at end of file
@@ -0,0 +1,4 @@
+SELECT version, payload
+FROM game_snapshots
+WHERE game_id = $1
+  AND ($2 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $2))
\ No newline at end of file
@@ -0,0 +1,16 @@
+SELECT id,
+       game_id,
+       key,
+       title,
+       description,
+       link,
+       position,
+       status,
+       round_id,
+       estimate,
+       created_at,
+       updated_at
+FROM game_stories
+WHERE game_id = $1
+  AND ($2 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $2))
+ORDER BY position
\ No newline at end of file
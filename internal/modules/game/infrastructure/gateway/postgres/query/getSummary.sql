@@ -0,0 +1,14 @@
+SELECT game_id,
+       organization_id,
+       name,
+       owner_id,
+       owner_name,
+       member_count,
+       round_status,
+       archived_at,
+       last_activity_at,
+       created_at,
+       updated_at
+FROM game_summary
+WHERE game_id = $1
+  AND ($2 = '' OR organization_id = $2)
\ No newline at end of file
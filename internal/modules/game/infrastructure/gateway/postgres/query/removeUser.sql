@@ -0,0 +1,5 @@
+DELETE
+FROM game_user
+WHERE game_id = $1
+  AND user_id = $2
+  AND ($3 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $3))
\ No newline at end of file
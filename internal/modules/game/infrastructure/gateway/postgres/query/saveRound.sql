@@ -0,0 +1,9 @@
+INSERT INTO game_rounds (id, game_id, topic, status, estimate, timer, deadline, created_at, updated_at)
+SELECT $1::varchar, $2::varchar, $3::text, $4::smallint, $5::varchar, $6::integer, $7::timestamptz,
+       $8::timestamptz, $9::timestamptz
+WHERE $10 = '' OR EXISTS (SELECT 1 FROM games WHERE id = $2 AND organization_id = $10)
+ON CONFLICT (id) DO UPDATE
+    SET status     = EXCLUDED.status,
+        estimate   = EXCLUDED.estimate,
+        deadline   = EXCLUDED.deadline,
+        updated_at = EXCLUDED.updated_at
\ No newline at end of file
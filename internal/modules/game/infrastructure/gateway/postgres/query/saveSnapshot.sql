@@ -0,0 +1,7 @@
+INSERT INTO game_snapshots (game_id, version, payload)
+SELECT $1::varchar, $2::bigint, $3::jsonb
+WHERE $4 = '' OR EXISTS (SELECT 1 FROM games WHERE id = $1 AND organization_id = $4)
+ON CONFLICT (game_id) DO UPDATE SET version    = EXCLUDED.version,
+                                    payload    = EXCLUDED.payload,
+                                    created_at = NOW()
+WHERE game_snapshots.version < EXCLUDED.version
\ No newline at end of file
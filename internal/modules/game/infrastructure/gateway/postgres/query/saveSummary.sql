@@ -0,0 +1,15 @@
+INSERT INTO game_summary (game_id, organization_id, name, owner_id, owner_name, member_count, round_status,
+                          archived_at, last_activity_at, created_at, updated_at)
+SELECT $1::varchar, $2::varchar, $3::varchar, $4::varchar, $5::text, $6::integer, $7::varchar,
+       $8::timestamptz,
+       GREATEST($10::timestamptz, (SELECT MAX(created_at) FROM game_events WHERE game_id = $1)),
+       $9::timestamptz, $10::timestamptz
+WHERE $11 = '' OR $2 = $11
+ON CONFLICT (game_id) DO UPDATE SET name             = EXCLUDED.name,
+                                    owner_id         = EXCLUDED.owner_id,
+                                    owner_name       = EXCLUDED.owner_name,
+                                    member_count     = EXCLUDED.member_count,
+                                    round_status     = EXCLUDED.round_status,
+                                    archived_at      = EXCLUDED.archived_at,
+                                    last_activity_at = EXCLUDED.last_activity_at,
+                                    updated_at       = EXCLUDED.updated_at
\ No newline at end of file
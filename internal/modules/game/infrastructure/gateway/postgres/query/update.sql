@@ -0,0 +1,8 @@
+UPDATE games
+SET name        = $2,
+    owner_id    = $3,
+    deck_id     = $4,
+    archived_at = $5,
+    updated_at  = NOW()
+WHERE id = $1
+  AND ($6 = '' OR organization_id = $6)
\ No newline at end of file
@@ -0,0 +1,7 @@
+UPDATE game_stories
+SET status     = $2,
+    round_id   = $3,
+    estimate   = $4,
+    updated_at = $5
+WHERE id = $1
+  AND ($6 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $6))
\ No newline at end of file
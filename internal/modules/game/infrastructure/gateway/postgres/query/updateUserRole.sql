@@ -0,0 +1,5 @@
+UPDATE game_user
+SET role = $3
+WHERE game_id = $1
+  AND user_id = $2
+  AND ($4 = '' OR game_id IN (SELECT id FROM games WHERE organization_id = $4))
\ No newline at end of file
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+// AddStories store new stories of the game queue.
+func (g *gamePgStorage) AddStories(ctx context.Context, stories []entity.Story) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.AddStories")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "AddStories.Organization")
+	}
+
+	db := g.getter.DefaultTrOrDB(ctx, g.db)
+	for _, story := range stories {
+		dbStory := StoryToDB(story)
+		if _, err = db.ExecContext(
+			ctx,
+			AddStorySQL,
+			dbStory.ID,
+			dbStory.GameID,
+			dbStory.Key,
+			dbStory.Title,
+			dbStory.Description,
+			dbStory.Link,
+			dbStory.Position,
+			dbStory.Status,
+			dbStory.CreatedAt,
+			dbStory.UpdatedAt,
+			organizationID,
+		); err != nil {
+			return errors.Wrap(err, "AddStories.ExecContext")
+		}
+	}
+
+	return nil
+}
+
+// UpdateStory store story estimation progress.
+func (g *gamePgStorage) UpdateStory(ctx context.Context, story entity.Story) error {
+	ctx, span := g.tracer.Start(ctx, "gamePgStorage.UpdateStory")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "UpdateStory.Organization")
+	}
+
+	dbStory := StoryToDB(story)
+	if _, err = g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		UpdateStorySQL,
+		dbStory.ID,
+		dbStory.Status,
+		dbStory.RoundID,
+		dbStory.Estimate,
+		dbStory.UpdatedAt,
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "UpdateStory.ExecContext")
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+type gameSummaryPgStorage struct {
+	db     *sqlx.DB
+	logger logger.Logger
+	tracer trace.Tracer
+	getter *trmsqlx.CtxGetter
+}
+
+func NewGameSummaryPgStorage(db *sqlx.DB, getter *trmsqlx.CtxGetter, logger logger.Logger) ports.GameSummaryStorage {
+	return &gameSummaryPgStorage{
+		db:     db,
+		logger: logger,
+		getter: getter,
+		tracer: otel.Tracer(""),
+	}
+}
+
+// Fetch game summaries with given limit. Archived games are skipped unless withArchived is set.
+func (g *gameSummaryPgStorage) Fetch(
+	ctx context.Context,
+	limit, offset int64,
+	withArchived bool,
+) ([]ports.GameSummary, error) {
+	ctx, span := g.tracer.Start(ctx, "gameSummaryPgStorage.Fetch")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "Fetch.Organization")
+	}
+
+	dbSummaries := make([]DBGameSummary, 0)
+	if err := g.getter.DefaultTrOrDB(ctx, g.db).SelectContext(
+		ctx,
+		&dbSummaries,
+		FetchSummariesSQL,
+		limit,
+		offset,
+		withArchived,
+		organizationID,
+	); err != nil {
+		return nil, errors.Wrap(err, "Fetch.SelectContext")
+	}
+
+	result := make([]ports.GameSummary, 0, len(dbSummaries))
+	for _, dbSummary := range dbSummaries {
+		summary, err := SummaryFromDB(dbSummary)
+		if err != nil {
+			return nil, errors.Wrap(err, "Fetch.SummaryFromDB")
+		}
+
+		result = append(result, summary)
+	}
+
+	return result, nil
+}
+
+// GetByID Get game summary by game id.
+func (g *gameSummaryPgStorage) GetByID(ctx context.Context, gameID common.UID) (ports.GameSummary, error) {
+	ctx, span := g.tracer.Start(ctx, "gameSummaryPgStorage.GetByID")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return ports.GameSummary{}, errors.Wrap(err, "GetByID.Organization")
+	}
+
+	dbSummaries := make([]DBGameSummary, 0)
+	if err := g.getter.DefaultTrOrDB(ctx, g.db).SelectContext(
+		ctx,
+		&dbSummaries,
+		GetSummarySQL,
+		gameID.String(),
+		organizationID,
+	); err != nil {
+		return ports.GameSummary{}, errors.Wrap(err, "GetByID.SelectContext")
+	}
+
+	if len(dbSummaries) == 0 {
+		return ports.GameSummary{}, core.ErrNotFound
+	}
+
+	summary, err := SummaryFromDB(dbSummaries[0])
+	if err != nil {
+		return ports.GameSummary{}, errors.Wrap(err, "GetByID.SummaryFromDB")
+	}
+
+	return summary, nil
+}
+
+// Save Insert or update game summary.
+func (g *gameSummaryPgStorage) Save(ctx context.Context, summary ports.GameSummary) error {
+	ctx, span := g.tracer.Start(ctx, "gameSummaryPgStorage.Save")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Save.Organization")
+	}
+
+	dbSummary := SummaryToDB(summary)
+	if _, err := g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		SaveSummarySQL,
+		dbSummary.GameID,
+		dbSummary.OrganizationID,
+		dbSummary.Name,
+		dbSummary.OwnerID,
+		dbSummary.OwnerName,
+		dbSummary.MemberCount,
+		dbSummary.RoundStatus,
+		dbSummary.ArchivedAt,
+		dbSummary.CreatedAt,
+		dbSummary.UpdatedAt,
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "Save.ExecContext")
+	}
+
+	return nil
+}
+
+// Delete game summary.
+func (g *gameSummaryPgStorage) Delete(ctx context.Context, gameID common.UID) error {
+	ctx, span := g.tracer.Start(ctx, "gameSummaryPgStorage.Delete")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Delete.Organization")
+	}
+
+	if _, err := g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(
+		ctx,
+		DeleteSummarySQL,
+		gameID.String(),
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "Delete.ExecContext")
+	}
+
+	return nil
+}
+
+// Clear Delete all game summaries of the organization.
+func (g *gameSummaryPgStorage) Clear(ctx context.Context) error {
+	ctx, span := g.tracer.Start(ctx, "gameSummaryPgStorage.Clear")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Clear.Organization")
+	}
+
+	if _, err := g.getter.DefaultTrOrDB(ctx, g.db).ExecContext(ctx, ClearSummariesSQL, organizationID); err != nil {
+		return errors.Wrap(err, "Clear.ExecContext")
+	}
+
+	return nil
+}
+
+// FetchGameIDs Get ids of the stored games.
+func (g *gameSummaryPgStorage) FetchGameIDs(ctx context.Context, afterID string, limit int64) ([]common.UID, error) {
+	ctx, span := g.tracer.Start(ctx, "gameSummaryPgStorage.FetchGameIDs")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchGameIDs.Organization")
+	}
+
+	dbIDs := make([]string, 0)
+	if err := g.getter.DefaultTrOrDB(ctx, g.db).SelectContext(
+		ctx,
+		&dbIDs,
+		FetchGameIDsSQL,
+		afterID,
+		limit,
+		organizationID,
+	); err != nil {
+		return nil, errors.Wrap(err, "FetchGameIDs.SelectContext")
+	}
+
+	ids := make([]common.UID, 0, len(dbIDs))
+	for _, dbID := range dbIDs {
+		id, err := common.ParseUID(dbID)
+		if err != nil {
+			return nil, errors.Wrap(err, "FetchGameIDs.ParseUID")
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
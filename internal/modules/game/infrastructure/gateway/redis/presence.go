@@ -0,0 +1,176 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const (
+	presencePrefix = "game:presence:"
+	// presenceSetTTL removes connection sets of rooms nobody has visited for a long time.
+	presenceSetTTL = 24 * time.Hour
+)
+
+// connection is a member of the game presence set.
+type connection struct {
+	member string
+	userID string
+	connID string
+}
+
+// PresenceStorage keeps a key with ttl for every room connection and a per-game
+// set of connections, so expired connections can be traced back to their users.
+type PresenceStorage struct {
+	db     *redis.Client
+	logger logger.Logger
+	tracer trace.Tracer
+}
+
+func NewPresenceStorage(db *redis.Client, logger logger.Logger) *PresenceStorage {
+	return &PresenceStorage{
+		db:     db,
+		logger: logger,
+		tracer: otel.Tracer(""),
+	}
+}
+
+var _ ports.PresenceStorage = (*PresenceStorage)(nil)
+
+// Touch registers the connection or extends its ttl.
+func (p *PresenceStorage) Touch(ctx context.Context, gameID, userID, connID string, ttl time.Duration) error {
+	ctx, span := p.tracer.Start(ctx, "PresenceStorage.Touch")
+	defer span.End()
+
+	pipe := p.db.TxPipeline()
+	pipe.Set(ctx, p.createConnKey(gameID, connID), userID, ttl)
+	pipe.SAdd(ctx, p.createSetKey(gameID), p.createMember(userID, connID))
+	pipe.Expire(ctx, p.createSetKey(gameID), presenceSetTTL)
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// Remove drops the connection.
+func (p *PresenceStorage) Remove(ctx context.Context, gameID, userID, connID string) error {
+	ctx, span := p.tracer.Start(ctx, "PresenceStorage.Remove")
+	defer span.End()
+
+	pipe := p.db.TxPipeline()
+	pipe.Del(ctx, p.createConnKey(gameID, connID))
+	pipe.SRem(ctx, p.createSetKey(gameID), p.createMember(userID, connID))
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// Online returns the sorted ids of users with at least one live connection.
+func (p *PresenceStorage) Online(ctx context.Context, gameID string) ([]string, error) {
+	ctx, span := p.tracer.Start(ctx, "PresenceStorage.Online")
+	defer span.End()
+
+	live, _, err := p.connections(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]string, 0, len(live))
+	for _, conn := range live {
+		users = append(users, conn.userID)
+	}
+	slices.Sort(users)
+
+	return slices.Compact(users), nil
+}
+
+// Prune removes expired connections from the game set and returns the ids of their users.
+func (p *PresenceStorage) Prune(ctx context.Context, gameID string) ([]string, error) {
+	ctx, span := p.tracer.Start(ctx, "PresenceStorage.Prune")
+	defer span.End()
+
+	_, stale, err := p.connections(ctx, gameID)
+	if err != nil || len(stale) == 0 {
+		return nil, err
+	}
+
+	members := make([]any, 0, len(stale))
+	users := make([]string, 0, len(stale))
+	for _, conn := range stale {
+		members = append(members, conn.member)
+		users = append(users, conn.userID)
+	}
+
+	if err = p.db.SRem(ctx, p.createSetKey(gameID), members...).Err(); err != nil {
+		return nil, err
+	}
+	slices.Sort(users)
+
+	return slices.Compact(users), nil
+}
+
+// connections splits the game connections into live and expired ones.
+func (p *PresenceStorage) connections(ctx context.Context, gameID string) ([]connection, []connection, error) {
+	members, err := p.db.SMembers(ctx, p.createSetKey(gameID)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, nil, err
+	}
+
+	if len(members) == 0 {
+		return nil, nil, nil
+	}
+
+	conns := make([]connection, 0, len(members))
+	keys := make([]string, 0, len(members))
+	for _, member := range members {
+		userID, connID, ok := strings.Cut(member, ":")
+		if !ok {
+			p.logger.Warnf("invalid presence member %q of game %s", member, gameID)
+			continue
+		}
+
+		conns = append(conns, connection{member: member, userID: userID, connID: connID})
+		keys = append(keys, p.createConnKey(gameID, connID))
+	}
+
+	if len(keys) == 0 {
+		return nil, nil, nil
+	}
+
+	values, err := p.db.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	live := make([]connection, 0, len(conns))
+	stale := make([]connection, 0)
+	for i, conn := range conns {
+		if values[i] == nil {
+			stale = append(stale, conn)
+		} else {
+			live = append(live, conn)
+		}
+	}
+
+	return live, stale, nil
+}
+
+func (p *PresenceStorage) createSetKey(gameID string) string {
+	return presencePrefix + "{" + gameID + "}:conns"
+}
+
+func (p *PresenceStorage) createConnKey(gameID, connID string) string {
+	return presencePrefix + "{" + gameID + "}:conn:" + connID
+}
+
+func (p *PresenceStorage) createMember(userID, connID string) string {
+	return userID + ":" + connID
+}
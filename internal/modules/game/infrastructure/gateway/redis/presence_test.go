@@ -0,0 +1,63 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	game_redis "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/gateway/redis"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+func TestPresenceStorage(t *testing.T) {
+	ctx := context.Background()
+
+	log := logger.NewLogger(logger.Config{Mode: "test"})
+	log.Init()
+
+	rd := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: rd.Addr()})
+	defer client.Close()
+
+	storage := game_redis.NewPresenceStorage(client, log)
+
+	require.NoError(t, storage.Touch(ctx, gameID, "alice", "tab-1", 30*time.Second))
+	require.NoError(t, storage.Touch(ctx, gameID, "alice", "tab-2", 30*time.Second))
+	require.NoError(t, storage.Touch(ctx, gameID, "bob", "tab-3", 10*time.Second))
+
+	online, err := storage.Online(ctx, gameID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, online)
+
+	// Closing one of two tabs keeps the user online
+	require.NoError(t, storage.Remove(ctx, gameID, "alice", "tab-1"))
+	online, err = storage.Online(ctx, gameID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, online)
+
+	// Stale tab without heartbeats expires
+	rd.FastForward(20 * time.Second)
+	online, err = storage.Online(ctx, gameID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, online)
+
+	stale, err := storage.Prune(ctx, gameID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob"}, stale)
+
+	stale, err = storage.Prune(ctx, gameID)
+	require.NoError(t, err)
+	assert.Empty(t, stale)
+
+	// Heartbeat extends the connection ttl
+	require.NoError(t, storage.Touch(ctx, gameID, "alice", "tab-2", 30*time.Second))
+	rd.FastForward(20 * time.Second)
+	online, err = storage.Online(ctx, gameID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, online)
+}
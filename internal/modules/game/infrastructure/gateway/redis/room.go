@@ -0,0 +1,222 @@
+package redis
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/modules/game/application/ports"
+	"github.com/KyKyPy3/clean/pkg/latch"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const (
+	roomPrefix       = "game:room:"
+	logPrefix        = "game:room-log:"
+	subscriberBuffer = 64
+	logSize          = 200
+	logTTL           = 24 * time.Hour
+)
+
+//go:embed script/publish.lua
+var publishScript string
+
+// envelope is the logged and published form of a room message.
+type envelope struct {
+	ID      int64           `json:"id"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// RoomHub fans game room messages out through Redis pub/sub, so every instance
+// delivers them to its own connected clients. Every message gets a per-game
+// sequence id and is kept in a bounded log for replay.
+type RoomHub struct {
+	db      *redis.Client
+	publish *redis.Script
+	logger  logger.Logger
+	tracer  trace.Tracer
+
+	mu    sync.RWMutex
+	rooms map[string]map[chan ports.RoomMessage]struct{}
+}
+
+func NewRoomHub(db *redis.Client, logger logger.Logger) *RoomHub {
+	return &RoomHub{
+		db:      db,
+		publish: redis.NewScript(publishScript),
+		logger:  logger,
+		tracer:  otel.Tracer(""),
+		rooms:   make(map[string]map[chan ports.RoomMessage]struct{}),
+	}
+}
+
+var _ ports.Room = (*RoomHub)(nil)
+
+// Start subscribes to the room channels of all games and dispatches incoming
+// messages to local subscribers until ctx is done.
+func (h *RoomHub) Start(ctx context.Context, lock *latch.CountDownLatch) error {
+	pubsub := h.db.PSubscribe(ctx, roomPrefix+"*")
+
+	// Wait for subscription confirmation, so no message published after Start is lost.
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return err
+	}
+
+	lock.Add(1)
+
+	go func() {
+		defer lock.Done()
+		defer func() {
+			if err := pubsub.Close(); err != nil {
+				h.logger.Errorf("can't close room subscription, err: %v", err)
+			}
+		}()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				h.dispatch(strings.TrimPrefix(msg.Channel, roomPrefix), msg.Payload)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Publish appends the JSON message to the game event log and sends it to the
+// game room on all instances.
+func (h *RoomHub) Publish(ctx context.Context, gameID string, payload []byte) error {
+	ctx, span := h.tracer.Start(ctx, "RoomHub.Publish")
+	defer span.End()
+
+	return h.publish.Run(
+		ctx,
+		h.db,
+		[]string{h.createSeqKey(gameID), h.createLogKey(gameID), h.createChannel(gameID)},
+		payload,
+		logSize,
+		int64(logTTL.Seconds()),
+	).Err()
+}
+
+// Replay returns logged messages of the game room with id greater than afterID.
+func (h *RoomHub) Replay(ctx context.Context, gameID string, afterID int64) ([]ports.RoomMessage, bool, error) {
+	ctx, span := h.tracer.Start(ctx, "RoomHub.Replay")
+	defer span.End()
+
+	pipe := h.db.Pipeline()
+	seqCmd := pipe.Get(ctx, h.createSeqKey(gameID))
+	logCmd := pipe.LRange(ctx, h.createLogKey(gameID), 0, -1)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, false, err
+	}
+
+	seq, err := seqCmd.Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, false, err
+	}
+
+	entries := make([]ports.RoomMessage, 0, len(logCmd.Val()))
+	for _, raw := range logCmd.Val() {
+		var e envelope
+		if err = json.Unmarshal([]byte(raw), &e); err != nil {
+			return nil, false, err
+		}
+
+		entries = append(entries, ports.RoomMessage{ID: e.ID, Payload: e.Payload})
+	}
+
+	// The log has expired and the sequence started over, the client id is from an older log.
+	if afterID > seq {
+		return entries, false, nil
+	}
+
+	result := make([]ports.RoomMessage, 0, len(entries))
+	for _, entry := range entries {
+		if entry.ID > afterID {
+			result = append(result, entry)
+		}
+	}
+
+	complete := afterID == seq || (len(entries) > 0 && entries[0].ID <= afterID+1)
+
+	return result, complete, nil
+}
+
+// Subscribe registers a local listener of the game room. The returned function
+// unsubscribes the listener and closes its channel.
+func (h *RoomHub) Subscribe(gameID string) (<-chan ports.RoomMessage, func()) {
+	ch := make(chan ports.RoomMessage, subscriberBuffer)
+
+	h.mu.Lock()
+	if _, ok := h.rooms[gameID]; !ok {
+		h.rooms[gameID] = make(map[chan ports.RoomMessage]struct{})
+	}
+	h.rooms[gameID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			delete(h.rooms[gameID], ch)
+			if len(h.rooms[gameID]) == 0 {
+				delete(h.rooms, gameID)
+			}
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+func (h *RoomHub) dispatch(gameID string, raw string) {
+	var e envelope
+	if err := json.Unmarshal([]byte(raw), &e); err != nil {
+		h.logger.Errorf("can't decode room %s message, err: %v", gameID, err)
+		return
+	}
+
+	message := ports.RoomMessage{ID: e.ID, Payload: e.Payload}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch := range h.rooms[gameID] {
+		select {
+		case ch <- message:
+		default:
+			// Slow client, drop the message instead of blocking the whole room.
+			h.logger.Warnf("room %s subscriber is full, message dropped", gameID)
+		}
+	}
+}
+
+func (h *RoomHub) createChannel(gameID string) string {
+	return roomPrefix + gameID
+}
+
+func (h *RoomHub) createSeqKey(gameID string) string {
+	return logPrefix + "{" + gameID + "}:seq"
+}
+
+func (h *RoomHub) createLogKey(gameID string) string {
+	return logPrefix + "{" + gameID + "}:log"
+}
@@ -0,0 +1,108 @@
+package redis_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	game_redis "github.com/KyKyPy3/clean/internal/modules/game/infrastructure/gateway/redis"
+	"github.com/KyKyPy3/clean/pkg/latch"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const gameID = "6f2b0c1e-4a52-4bd8-9e1a-8f4f3f1b2c3d"
+
+func newHub(t *testing.T, ctx context.Context, rd *miniredis.Miniredis) *game_redis.RoomHub {
+	t.Helper()
+
+	log := logger.NewLogger(logger.Config{Mode: "test"})
+	log.Init()
+
+	client := redis.NewClient(&redis.Options{Addr: rd.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	hub := game_redis.NewRoomHub(client, log)
+	require.NoError(t, hub.Start(ctx, latch.NewCountDownLatch()))
+
+	return hub
+}
+
+func TestRoomHubDelivery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rd := miniredis.RunT(t)
+	hubA := newHub(t, ctx, rd)
+	hubB := newHub(t, ctx, rd)
+
+	messages, unsubscribe := hubA.Subscribe(gameID)
+	defer unsubscribe()
+
+	require.NoError(t, hubB.Publish(ctx, gameID, []byte(`{"type":"VoteCast"}`)))
+
+	select {
+	case message := <-messages:
+		assert.Equal(t, int64(1), message.ID)
+		assert.JSONEq(t, `{"type":"VoteCast"}`, string(message.Payload))
+	case <-time.After(2 * time.Second):
+		t.Fatal("room message was not delivered")
+	}
+}
+
+func TestRoomHubReplay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rd := miniredis.RunT(t)
+	hub := newHub(t, ctx, rd)
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, hub.Publish(ctx, gameID, []byte(`{"n":`+strconv.Itoa(i)+`}`)))
+	}
+
+	messages, complete, err := hub.Replay(ctx, gameID, 1)
+	require.NoError(t, err)
+	assert.True(t, complete)
+	require.Len(t, messages, 2)
+	assert.Equal(t, int64(2), messages[0].ID)
+	assert.Equal(t, int64(3), messages[1].ID)
+
+	messages, complete, err = hub.Replay(ctx, gameID, 3)
+	require.NoError(t, err)
+	assert.True(t, complete)
+	assert.Empty(t, messages)
+
+	// Log is gone, so the client from the older log must resync.
+	rd.FlushAll()
+	require.NoError(t, hub.Publish(ctx, gameID, []byte(`{"n":4}`)))
+
+	messages, complete, err = hub.Replay(ctx, gameID, 3)
+	require.NoError(t, err)
+	assert.False(t, complete)
+	require.Len(t, messages, 1)
+	assert.Equal(t, int64(1), messages[0].ID)
+}
+
+func TestRoomHubBoundedLog(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rd := miniredis.RunT(t)
+	hub := newHub(t, ctx, rd)
+
+	for i := 0; i < 250; i++ {
+		require.NoError(t, hub.Publish(ctx, gameID, []byte(`{}`)))
+	}
+
+	messages, complete, err := hub.Replay(ctx, gameID, 10)
+	require.NoError(t, err)
+	assert.False(t, complete)
+	assert.Len(t, messages, 200)
+	assert.Equal(t, int64(51), messages[0].ID)
+}
@@ -0,0 +1,12 @@
+-- KEYS[1] - sequence key, KEYS[2] - log key, KEYS[3] - room channel
+-- ARGV[1] - JSON payload, ARGV[2] - log size, ARGV[3] - log ttl in seconds
+local id = redis.call('INCR', KEYS[1])
+local message = '{"id":' .. id .. ',"payload":' .. ARGV[1] .. '}'
+
+redis.call('RPUSH', KEYS[2], message)
+redis.call('LTRIM', KEYS[2], -tonumber(ARGV[2]), -1)
+redis.call('EXPIRE', KEYS[1], ARGV[3])
+redis.call('EXPIRE', KEYS[2], ARGV[3])
+redis.call('PUBLISH', KEYS[3], message)
+
+return id
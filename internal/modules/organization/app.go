@@ -0,0 +1,63 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/labstack/echo/v4"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/event"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/query"
+	handlers "github.com/KyKyPy3/clean/internal/modules/organization/infrastructure/controller/http/v1"
+	user_event "github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+func InitHandlers(
+	_ context.Context,
+	organizationStorage ports.OrganizationPgStorage,
+	userStorage ports.UserViewStorage,
+	mountPoint *echo.Group,
+	pubsub *mediator.Mediator,
+	trManager *manager.Manager,
+	logger logger.Logger,
+) {
+	organizationCmdBus := core.NewCommandBus()
+	organizationCmdBus.Register(
+		command.CreateOrganizationKind,
+		command.NewCreateOrganization(organizationStorage, trManager, pubsub, logger),
+	)
+	organizationCmdBus.Register(
+		command.AddOrganizationMemberKind,
+		command.NewAddOrganizationMember(organizationStorage, userStorage, trManager, pubsub, logger),
+	)
+	organizationCmdBus.Register(
+		command.RemoveOrganizationMemberKind,
+		command.NewRemoveOrganizationMember(organizationStorage, trManager, pubsub, logger),
+	)
+	organizationCmdBus.Register(
+		command.ChangeOrganizationMemberRoleKind,
+		command.NewChangeOrganizationMemberRole(organizationStorage, trManager, pubsub, logger),
+	)
+
+	organizationQueryBus := core.NewQueryBus()
+	organizationQueryBus.Register(
+		query.FetchOrganizationsKind,
+		query.NewFetchOrganizations(organizationStorage, logger),
+	)
+	organizationQueryBus.Register(
+		query.FetchOrganizationKind,
+		query.NewFetchOrganization(organizationStorage, logger),
+	)
+
+	pubsub.Subscribe(
+		user_event.UserCreated,
+		event.NewUserCreated(organizationStorage, pubsub, logger).Handle,
+	)
+
+	handlers.NewOrganizationHandlers(mountPoint, organizationCmdBus, organizationQueryBus, logger)
+}
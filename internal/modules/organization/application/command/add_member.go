@@ -0,0 +1,126 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	user_entity "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+const AddOrganizationMemberKind = "AddOrganizationMember"
+
+type AddOrganizationMemberCommand struct {
+	OrganizationID string
+	ActorID        string
+	Email          string
+	Role           string
+}
+
+func NewAddOrganizationMemberCommand(organizationID, actorID, email, role string) AddOrganizationMemberCommand {
+	return AddOrganizationMemberCommand{
+		OrganizationID: organizationID,
+		ActorID:        actorID,
+		Email:          email,
+		Role:           role,
+	}
+}
+
+func (c AddOrganizationMemberCommand) Type() core.CommandType {
+	return AddOrganizationMemberKind
+}
+
+var _ core.Command = (*AddOrganizationMemberCommand)(nil)
+
+type AddOrganizationMember struct {
+	storage     ports.OrganizationPgStorage
+	userStorage ports.UserViewStorage
+	manager     ports.TrManager
+	mediator    ports.Mediator
+	logger      logger.Logger
+}
+
+func NewAddOrganizationMember(
+	storage ports.OrganizationPgStorage,
+	userStorage ports.UserViewStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) AddOrganizationMember {
+	return AddOrganizationMember{
+		storage:     storage,
+		userStorage: userStorage,
+		manager:     manager,
+		mediator:    mediator,
+		logger:      logger,
+	}
+}
+
+func (c AddOrganizationMember) Handle(ctx context.Context, command core.Command) (any, error) {
+	addCommand, ok := command.(AddOrganizationMemberCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	organizationID, err := common.ParseUID(addCommand.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	actorID, err := common.ParseUID(addCommand.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := common.NewEmail(addCommand.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := entity.ParseRole(addCommand.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var organization entity.Organization
+		organization, err = c.storage.GetByID(ctx, organizationID)
+		if err != nil {
+			return err
+		}
+
+		// The invited user is not a member of the organization yet, so the
+		// lookup goes across tenants.
+		var user user_entity.User
+		user, err = c.userStorage.GetByEmail(tenant.Unscoped(ctx), email)
+		if err != nil {
+			return err
+		}
+
+		var member entity.Member
+		member, err = organization.AddMember(actorID, user.ID(), role)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.AddMember(ctx, organizationID, member)
+		if err != nil {
+			return err
+		}
+
+		return c.mediator.Publish(ctx, organization.Events()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*AddOrganizationMember)(nil)
@@ -0,0 +1,114 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const ChangeOrganizationMemberRoleKind = "ChangeOrganizationMemberRole"
+
+type ChangeOrganizationMemberRoleCommand struct {
+	OrganizationID string
+	ActorID        string
+	UserID         string
+	Role           string
+}
+
+func NewChangeOrganizationMemberRoleCommand(
+	organizationID, actorID, userID, role string,
+) ChangeOrganizationMemberRoleCommand {
+	return ChangeOrganizationMemberRoleCommand{
+		OrganizationID: organizationID,
+		ActorID:        actorID,
+		UserID:         userID,
+		Role:           role,
+	}
+}
+
+func (c ChangeOrganizationMemberRoleCommand) Type() core.CommandType {
+	return ChangeOrganizationMemberRoleKind
+}
+
+var _ core.Command = (*ChangeOrganizationMemberRoleCommand)(nil)
+
+type ChangeOrganizationMemberRole struct {
+	storage  ports.OrganizationPgStorage
+	manager  ports.TrManager
+	mediator ports.Mediator
+	logger   logger.Logger
+}
+
+func NewChangeOrganizationMemberRole(
+	storage ports.OrganizationPgStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) ChangeOrganizationMemberRole {
+	return ChangeOrganizationMemberRole{
+		storage:  storage,
+		manager:  manager,
+		mediator: mediator,
+		logger:   logger,
+	}
+}
+
+func (c ChangeOrganizationMemberRole) Handle(ctx context.Context, command core.Command) (any, error) {
+	changeCommand, ok := command.(ChangeOrganizationMemberRoleCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	organizationID, err := common.ParseUID(changeCommand.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	actorID, err := common.ParseUID(changeCommand.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(changeCommand.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := entity.ParseRole(changeCommand.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var organization entity.Organization
+		organization, err = c.storage.GetByID(ctx, organizationID)
+		if err != nil {
+			return err
+		}
+
+		err = organization.ChangeMemberRole(actorID, userID, role)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.UpdateMemberRole(ctx, organizationID, userID, role)
+		if err != nil {
+			return err
+		}
+
+		return c.mediator.Publish(ctx, organization.Events()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*ChangeOrganizationMemberRole)(nil)
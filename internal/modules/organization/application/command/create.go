@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const CreateOrganizationKind = "CreateOrganization"
+
+type CreateOrganizationCommand struct {
+	Name   string
+	UserID string
+}
+
+func NewCreateOrganizationCommand(name, userID string) CreateOrganizationCommand {
+	return CreateOrganizationCommand{
+		Name:   name,
+		UserID: userID,
+	}
+}
+
+func (c CreateOrganizationCommand) Type() core.CommandType {
+	return CreateOrganizationKind
+}
+
+var _ core.Command = (*CreateOrganizationCommand)(nil)
+
+type CreateOrganization struct {
+	storage  ports.OrganizationPgStorage
+	manager  ports.TrManager
+	mediator ports.Mediator
+	logger   logger.Logger
+}
+
+func NewCreateOrganization(
+	storage ports.OrganizationPgStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) CreateOrganization {
+	return CreateOrganization{
+		storage:  storage,
+		manager:  manager,
+		mediator: mediator,
+		logger:   logger,
+	}
+}
+
+func (c CreateOrganization) Handle(ctx context.Context, command core.Command) (any, error) {
+	createCommand, ok := command.(CreateOrganizationCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	userID, err := common.ParseUID(createCommand.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := entity.NewOrganization(createCommand.Name, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		err = c.storage.Create(ctx, organization)
+		if err != nil {
+			return err
+		}
+
+		return c.mediator.Publish(ctx, organization.Events()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return organization.ID().String(), nil
+}
+
+var _ core.CommandHandler = (*CreateOrganization)(nil)
@@ -0,0 +1,105 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const RemoveOrganizationMemberKind = "RemoveOrganizationMember"
+
+type RemoveOrganizationMemberCommand struct {
+	OrganizationID string
+	ActorID        string
+	UserID         string
+}
+
+func NewRemoveOrganizationMemberCommand(organizationID, actorID, userID string) RemoveOrganizationMemberCommand {
+	return RemoveOrganizationMemberCommand{
+		OrganizationID: organizationID,
+		ActorID:        actorID,
+		UserID:         userID,
+	}
+}
+
+func (c RemoveOrganizationMemberCommand) Type() core.CommandType {
+	return RemoveOrganizationMemberKind
+}
+
+var _ core.Command = (*RemoveOrganizationMemberCommand)(nil)
+
+type RemoveOrganizationMember struct {
+	storage  ports.OrganizationPgStorage
+	manager  ports.TrManager
+	mediator ports.Mediator
+	logger   logger.Logger
+}
+
+func NewRemoveOrganizationMember(
+	storage ports.OrganizationPgStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) RemoveOrganizationMember {
+	return RemoveOrganizationMember{
+		storage:  storage,
+		manager:  manager,
+		mediator: mediator,
+		logger:   logger,
+	}
+}
+
+func (c RemoveOrganizationMember) Handle(ctx context.Context, command core.Command) (any, error) {
+	removeCommand, ok := command.(RemoveOrganizationMemberCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	organizationID, err := common.ParseUID(removeCommand.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	actorID, err := common.ParseUID(removeCommand.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(removeCommand.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var organization entity.Organization
+		organization, err = c.storage.GetByID(ctx, organizationID)
+		if err != nil {
+			return err
+		}
+
+		err = organization.RemoveMember(actorID, userID)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.RemoveMember(ctx, organizationID, userID)
+		if err != nil {
+			return err
+		}
+
+		return c.mediator.Publish(ctx, organization.Events()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*RemoveOrganizationMember)(nil)
@@ -0,0 +1,62 @@
+package event
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	user_event "github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+// UserCreated gives every new user a personal organization to start with.
+type UserCreated struct {
+	storage  ports.OrganizationPgStorage
+	mediator ports.Mediator
+	logger   logger.Logger
+}
+
+func NewUserCreated(
+	storage ports.OrganizationPgStorage,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) *UserCreated {
+	return &UserCreated{
+		storage:  storage,
+		mediator: mediator,
+		logger:   logger,
+	}
+}
+
+func (u *UserCreated) Handle(ctx context.Context, e mediator.Event) error {
+	switch t := e.(type) {
+	case user_event.UserCreatedEvent:
+		return u.handleUserCreated(ctx, t)
+	default:
+		return fmt.Errorf("unknown type of event %T", e)
+	}
+}
+
+func (u *UserCreated) handleUserCreated(ctx context.Context, e user_event.UserCreatedEvent) error {
+	userID, err := common.ParseUID(e.ID)
+	if err != nil {
+		return err
+	}
+
+	organization, err := entity.NewOrganization(fmt.Sprintf("%s's team", e.FullName.FirstName()), userID)
+	if err != nil {
+		return err
+	}
+
+	u.logger.Debugf("Create personal organization %s for user %s", organization.ID(), userID)
+
+	err = u.storage.Create(ctx, organization)
+	if err != nil {
+		return err
+	}
+
+	return u.mediator.Publish(ctx, organization.Events()...)
+}
@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	user_entity "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+type Mediator interface {
+	Publish(ctx context.Context, events ...mediator.Event) error
+}
+
+type TrManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+// OrganizationPgStorage keeps organizations and their memberships. It is the
+// tenant directory itself, so its queries are scoped by the given ids only.
+type OrganizationPgStorage interface {
+	// FetchByUser returns the organizations of the user in the order they were
+	// joined. Each organization holds the membership of the user only.
+	FetchByUser(ctx context.Context, userID common.UID, limit, offset int64) ([]entity.Organization, error)
+	// GetByID returns the organization with all its members.
+	GetByID(ctx context.Context, id common.UID) (entity.Organization, error)
+	Create(ctx context.Context, organization entity.Organization) error
+	AddMember(ctx context.Context, organizationID common.UID, member entity.Member) error
+	UpdateMemberRole(ctx context.Context, organizationID, userID common.UID, role entity.Role) error
+	RemoveMember(ctx context.Context, organizationID, userID common.UID) error
+}
+
+type UserViewStorage interface {
+	GetByEmail(ctx context.Context, email common.Email) (user_entity.User, error)
+}
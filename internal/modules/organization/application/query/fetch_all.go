@@ -0,0 +1,73 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/organization/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const FetchOrganizationsKind = "FetchOrganizations"
+
+type FetchOrganizationsQuery struct {
+	UserID string
+	Limit  int64
+	Offset int64
+}
+
+func (f FetchOrganizationsQuery) Type() core.QueryType {
+	return FetchOrganizationsKind
+}
+
+var _ core.Query = (*FetchOrganizationsQuery)(nil)
+
+type FetchOrganizations struct {
+	storage ports.OrganizationPgStorage
+	logger  logger.Logger
+}
+
+func NewFetchOrganizations(storage ports.OrganizationPgStorage, logger logger.Logger) FetchOrganizations {
+	return FetchOrganizations{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Handle returns the organizations of the user with the user role in each of them.
+func (f FetchOrganizations) Handle(ctx context.Context, query core.Query) (any, error) {
+	fetchQuery, ok := query.(FetchOrganizationsQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	userID, err := common.ParseUID(fetchQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	organizations, err := f.storage.FetchByUser(ctx, userID, fetchQuery.Limit, fetchQuery.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("fetch organizations error: %w", err)
+	}
+
+	organizationsDto := make([]dto.OrganizationDTO, 0, len(organizations))
+	for _, organization := range organizations {
+		organizationDto := dto.OrganizationDTO{
+			ID:        organization.ID().String(),
+			Name:      organization.Name(),
+			CreatedAt: organization.CreatedAt().String(),
+			UpdatedAt: organization.UpdatedAt().String(),
+		}
+		if member, found := organization.Member(userID); found {
+			organizationDto.Role = member.Role().String()
+		}
+
+		organizationsDto = append(organizationsDto, organizationDto)
+	}
+
+	return organizationsDto, nil
+}
@@ -0,0 +1,85 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/organization/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const FetchOrganizationKind = "FetchOrganization"
+
+type FetchOrganizationQuery struct {
+	ID     string
+	UserID string
+}
+
+func (f FetchOrganizationQuery) Type() core.QueryType {
+	return FetchOrganizationKind
+}
+
+var _ core.Query = (*FetchOrganizationQuery)(nil)
+
+type FetchOrganization struct {
+	storage ports.OrganizationPgStorage
+	logger  logger.Logger
+}
+
+func NewFetchOrganization(storage ports.OrganizationPgStorage, logger logger.Logger) FetchOrganization {
+	return FetchOrganization{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Handle returns the organization with its members. Only members may see it.
+func (f FetchOrganization) Handle(ctx context.Context, query core.Query) (any, error) {
+	fetchQuery, ok := query.(FetchOrganizationQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	id, err := common.ParseUID(fetchQuery.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(fetchQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := f.storage.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	member, ok := organization.Member(userID)
+	if !ok {
+		return nil, entity.ErrNotOrganizationMember
+	}
+
+	membersDto := make([]dto.MemberDTO, 0, len(organization.Members()))
+	for _, m := range organization.Members() {
+		membersDto = append(membersDto, dto.MemberDTO{
+			UserID:   m.UserID().String(),
+			Name:     m.Name(),
+			Role:     m.Role().String(),
+			JoinedAt: m.JoinedAt().String(),
+		})
+	}
+
+	return dto.OrganizationDTO{
+		ID:        organization.ID().String(),
+		Name:      organization.Name(),
+		Role:      member.Role().String(),
+		Members:   membersDto,
+		CreatedAt: organization.CreatedAt().String(),
+		UpdatedAt: organization.UpdatedAt().String(),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+// Member is a user belonging to the organization.
+type Member struct {
+	userID   common.UID
+	name     string
+	role     Role
+	joinedAt time.Time
+}
+
+func HydrateMember(userID common.UID, name string, role Role, joinedAt time.Time) Member {
+	return Member{
+		userID:   userID,
+		name:     name,
+		role:     role,
+		joinedAt: joinedAt,
+	}
+}
+
+func (m Member) UserID() common.UID {
+	return m.userID
+}
+
+// Name returns the user name. It is known for the members loaded from the storage only.
+func (m Member) Name() string {
+	return m.name
+}
+
+// Role returns the member role in the organization.
+func (m Member) Role() Role {
+	return m.role
+}
+
+func (m Member) JoinedAt() time.Time {
+	return m.joinedAt
+}
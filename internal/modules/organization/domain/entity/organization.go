@@ -0,0 +1,280 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/event"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+const maxNameLength = 255
+
+var (
+	ErrEmptyOrganizationName  = errors.New("organization name cannot be empty")
+	ErrOrganizationNameLength = errors.New("organization name is too long")
+	ErrNotOrganizationMember  = errors.New("user is not an organization member")
+	ErrAlreadyMember          = errors.New("user is already an organization member")
+	ErrLastOwner              = errors.New("organization must keep at least one owner")
+)
+
+// Organization is a tenant owning games. Its members may belong to several organizations.
+type Organization struct {
+	*core.BaseAggregateRoot
+
+	id        common.UID
+	name      string
+	members   []Member
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewOrganization - creates a new Organization with the creator as its owner.
+func NewOrganization(name string, ownerID common.UID) (Organization, error) {
+	name, err := validateName(name)
+	if err != nil {
+		return Organization{}, err
+	}
+
+	now := time.Now().UTC()
+	org := Organization{
+		BaseAggregateRoot: &core.BaseAggregateRoot{},
+		id:                common.NewUID(),
+		name:              name,
+		members:           []Member{HydrateMember(ownerID, "", RoleOwner, now)},
+		createdAt:         now,
+		updatedAt:         now,
+	}
+
+	org.BaseAggregateRoot.AddEvent(event.OrganizationCreatedEvent{
+		ID:      org.id.String(),
+		Name:    name,
+		OwnerID: ownerID.String(),
+	})
+
+	return org, nil
+}
+
+func Hydrate(
+	id common.UID,
+	name string,
+	members []Member,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Organization {
+	return Organization{
+		BaseAggregateRoot: &core.BaseAggregateRoot{},
+		id:                id,
+		name:              name,
+		members:           members,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+	}
+}
+
+func (o *Organization) ID() common.UID {
+	return o.id
+}
+
+func (o *Organization) Name() string {
+	return o.name
+}
+
+func (o *Organization) Members() []Member {
+	return o.members
+}
+
+func (o *Organization) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+func (o *Organization) UpdatedAt() time.Time {
+	return o.updatedAt
+}
+
+// Member returns the membership of the user.
+func (o *Organization) Member(userID common.UID) (Member, bool) {
+	for _, member := range o.members {
+		if member.userID == userID {
+			return member, true
+		}
+	}
+
+	return Member{}, false
+}
+
+// IsMember reports whether the user belongs to the organization.
+func (o *Organization) IsMember(userID common.UID) bool {
+	_, ok := o.Member(userID)
+	return ok
+}
+
+// AddMember adds the user to the organization on behalf of an owner or admin.
+// Only owners may add other owners.
+func (o *Organization) AddMember(actorID, userID common.UID, role Role) (Member, error) {
+	actor, err := o.authorize(actorID)
+	if err != nil {
+		return Member{}, err
+	}
+
+	if role == RoleOwner && actor.role != RoleOwner {
+		return Member{}, ErrPermissionDenied
+	}
+
+	if o.IsMember(userID) {
+		return Member{}, ErrAlreadyMember
+	}
+
+	member := HydrateMember(userID, "", role, time.Now().UTC())
+	o.members = append(o.members, member)
+
+	o.BaseAggregateRoot.AddEvent(event.MemberAddedEvent{
+		OrganizationID: o.id.String(),
+		UserID:         userID.String(),
+		Role:           role.String(),
+		AddedBy:        actorID.String(),
+	})
+
+	return member, nil
+}
+
+// RemoveMember removes the user from the organization. Members may leave on
+// their own, others are removed by an owner or admin. Only owners may remove owners.
+func (o *Organization) RemoveMember(actorID, userID common.UID) error {
+	member, ok := o.Member(userID)
+	if !ok {
+		return ErrNotOrganizationMember
+	}
+
+	if actorID != userID {
+		actor, err := o.authorize(actorID)
+		if err != nil {
+			return err
+		}
+
+		if member.role == RoleOwner && actor.role != RoleOwner {
+			return ErrPermissionDenied
+		}
+	}
+
+	if member.role == RoleOwner && o.owners() == 1 {
+		return ErrLastOwner
+	}
+
+	for i := range o.members {
+		if o.members[i].userID == userID {
+			o.members = append(o.members[:i], o.members[i+1:]...)
+			break
+		}
+	}
+
+	o.BaseAggregateRoot.AddEvent(event.MemberRemovedEvent{
+		OrganizationID: o.id.String(),
+		UserID:         userID.String(),
+		RemovedBy:      actorID.String(),
+	})
+
+	return nil
+}
+
+// ChangeMemberRole assigns the role to the member on behalf of an owner.
+func (o *Organization) ChangeMemberRole(actorID, userID common.UID, role Role) error {
+	actor, err := o.authorize(actorID)
+	if err != nil {
+		return err
+	}
+
+	if actor.role != RoleOwner {
+		return ErrPermissionDenied
+	}
+
+	if role != RoleOwner && role != RoleAdmin && role != RoleMember {
+		return ErrInvalidRole
+	}
+
+	member := o.member(userID)
+	if member == nil {
+		return ErrNotOrganizationMember
+	}
+
+	if member.role == RoleOwner && role != RoleOwner && o.owners() == 1 {
+		return ErrLastOwner
+	}
+
+	member.role = role
+
+	o.BaseAggregateRoot.AddEvent(event.MemberRoleChangedEvent{
+		OrganizationID: o.id.String(),
+		UserID:         userID.String(),
+		Role:           role.String(),
+		ChangedBy:      actorID.String(),
+	})
+
+	return nil
+}
+
+// IsEmpty checks if organization is empty.
+func (o *Organization) IsEmpty() bool {
+	return o.id.IsEmpty()
+}
+
+// String returns the string representation of the organization.
+func (o *Organization) String() string {
+	return fmt.Sprintf("Organization{ID: %s, Name: %s, Members: %d}", o.ID(), o.Name(), len(o.members))
+}
+
+func (o *Organization) Events() []mediator.Event {
+	return o.BaseAggregateRoot.Events()
+}
+
+// authorize checks that the user is a member allowed to manage members.
+func (o *Organization) authorize(userID common.UID) (Member, error) {
+	member, ok := o.Member(userID)
+	if !ok {
+		return Member{}, ErrNotOrganizationMember
+	}
+
+	if !member.role.CanManageMembers() {
+		return Member{}, ErrPermissionDenied
+	}
+
+	return member, nil
+}
+
+func (o *Organization) member(userID common.UID) *Member {
+	for i := range o.members {
+		if o.members[i].userID == userID {
+			return &o.members[i]
+		}
+	}
+
+	return nil
+}
+
+func (o *Organization) owners() int {
+	var count int
+	for _, member := range o.members {
+		if member.role == RoleOwner {
+			count++
+		}
+	}
+
+	return count
+}
+
+func validateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", ErrEmptyOrganizationName
+	}
+
+	if len(name) > maxNameLength {
+		return "", ErrOrganizationNameLength
+	}
+
+	return name, nil
+}
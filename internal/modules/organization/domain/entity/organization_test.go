@@ -0,0 +1,67 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+)
+
+func TestNewOrganization(t *testing.T) {
+	ownerID := common.NewUID()
+
+	org, err := entity.NewOrganization(" Platform team ", ownerID)
+	require.NoError(t, err)
+	assert.Equal(t, "Platform team", org.Name())
+	assert.Len(t, org.Events(), 1)
+
+	member, ok := org.Member(ownerID)
+	require.True(t, ok)
+	assert.Equal(t, entity.RoleOwner, member.Role())
+
+	_, err = entity.NewOrganization(" ", ownerID)
+	assert.ErrorIs(t, err, entity.ErrEmptyOrganizationName)
+}
+
+func TestOrganizationMembers(t *testing.T) {
+	ownerID := common.NewUID()
+	adminID := common.NewUID()
+	memberID := common.NewUID()
+
+	org, err := entity.NewOrganization("Platform team", ownerID)
+	require.NoError(t, err)
+
+	_, err = org.AddMember(ownerID, adminID, entity.RoleAdmin)
+	require.NoError(t, err)
+	_, err = org.AddMember(adminID, memberID, entity.RoleMember)
+	require.NoError(t, err)
+
+	_, err = org.AddMember(adminID, memberID, entity.RoleMember)
+	assert.ErrorIs(t, err, entity.ErrAlreadyMember)
+
+	// Admins can't grant the owner role nor remove owners
+	_, err = org.AddMember(adminID, common.NewUID(), entity.RoleOwner)
+	assert.ErrorIs(t, err, entity.ErrPermissionDenied)
+	assert.ErrorIs(t, org.RemoveMember(adminID, ownerID), entity.ErrPermissionDenied)
+
+	// Members can't manage the organization
+	_, err = org.AddMember(memberID, common.NewUID(), entity.RoleMember)
+	assert.ErrorIs(t, err, entity.ErrPermissionDenied)
+	assert.ErrorIs(t, org.ChangeMemberRole(adminID, memberID, entity.RoleAdmin), entity.ErrPermissionDenied)
+
+	// The last owner stays
+	assert.ErrorIs(t, org.RemoveMember(ownerID, ownerID), entity.ErrLastOwner)
+	assert.ErrorIs(t, org.ChangeMemberRole(ownerID, ownerID, entity.RoleMember), entity.ErrLastOwner)
+
+	require.NoError(t, org.ChangeMemberRole(ownerID, adminID, entity.RoleOwner))
+	require.NoError(t, org.RemoveMember(ownerID, ownerID))
+	require.NoError(t, org.RemoveMember(memberID, memberID))
+
+	assert.False(t, org.IsMember(ownerID))
+	assert.False(t, org.IsMember(memberID))
+	assert.Len(t, org.Members(), 1)
+	assert.Len(t, org.Events(), 6)
+}
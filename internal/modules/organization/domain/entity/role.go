@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrPermissionDenied = errors.New("organization role does not permit the action")
+	ErrInvalidRole      = errors.New("invalid organization role")
+)
+
+// Role is the member role stored in organization_members.role.
+type Role int
+
+const (
+	RoleOwner Role = iota + 1
+	RoleAdmin
+	RoleMember
+)
+
+// ParseRole converts the role name to Role.
+func ParseRole(name string) (Role, error) {
+	for _, role := range []Role{RoleOwner, RoleAdmin, RoleMember} {
+		if role.String() == name {
+			return role, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrInvalidRole, name)
+}
+
+func (r Role) String() string {
+	switch r {
+	case RoleOwner:
+		return "owner"
+	case RoleAdmin:
+		return "admin"
+	case RoleMember:
+		return "member"
+	default:
+		return "unknown"
+	}
+}
+
+// CanManageMembers reports whether the role may add and remove organization members.
+func (r Role) CanManageMembers() bool {
+	return r == RoleOwner || r == RoleAdmin
+}
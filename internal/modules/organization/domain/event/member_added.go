@@ -0,0 +1,14 @@
+package event
+
+const MemberAdded = "OrganizationMemberAdded"
+
+type MemberAddedEvent struct {
+	OrganizationID string
+	UserID         string
+	Role           string
+	AddedBy        string
+}
+
+func (e MemberAddedEvent) Kind() string {
+	return MemberAdded
+}
@@ -0,0 +1,13 @@
+package event
+
+const MemberRemoved = "OrganizationMemberRemoved"
+
+type MemberRemovedEvent struct {
+	OrganizationID string
+	UserID         string
+	RemovedBy      string
+}
+
+func (e MemberRemovedEvent) Kind() string {
+	return MemberRemoved
+}
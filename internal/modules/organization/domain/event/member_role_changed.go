@@ -0,0 +1,14 @@
+package event
+
+const MemberRoleChanged = "OrganizationMemberRoleChanged"
+
+type MemberRoleChangedEvent struct {
+	OrganizationID string
+	UserID         string
+	Role           string
+	ChangedBy      string
+}
+
+func (e MemberRoleChangedEvent) Kind() string {
+	return MemberRoleChanged
+}
@@ -0,0 +1,13 @@
+package event
+
+const OrganizationCreated = "OrganizationCreated"
+
+type OrganizationCreatedEvent struct {
+	ID      string
+	Name    string
+	OwnerID string
+}
+
+func (e OrganizationCreatedEvent) Kind() string {
+	return OrganizationCreated
+}
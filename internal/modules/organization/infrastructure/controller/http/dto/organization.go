@@ -0,0 +1,35 @@
+package dto
+
+type OrganizationDTO struct {
+	ID        string      `json:"id"`
+	Name      string      `json:"name"`
+	Role      string      `json:"role"`
+	Members   []MemberDTO `json:"members,omitempty"`
+	CreatedAt string      `json:"createdAt"`
+	UpdatedAt string      `json:"updateAt"`
+}
+
+type MemberDTO struct {
+	UserID   string `json:"userId"`
+	Name     string `json:"name"`
+	Role     string `json:"role"`
+	JoinedAt string `json:"joinedAt"`
+}
+
+type CreateOrganizationDTO struct {
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+type AddMemberDTO struct {
+	Email string `json:"email" validate:"required,email"`
+	Role  string `json:"role" validate:"required,oneof=owner admin member"`
+}
+
+type ChangeMemberRoleDTO struct {
+	Role string `json:"role" validate:"required,oneof=owner admin member"`
+}
+
+type FetchOrganizationsDTO struct {
+	Limit  int64 `query:"limit" validate:"gte=0,lte=1000"`
+	Offset int64 `query:"offset" validate:"gte=0,lte=1000"`
+}
@@ -0,0 +1,549 @@
+//nolint:godot // file has comments for swagger doc
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	http_dto "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/query"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/organization/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const (
+	requestTimeout  = 10 * time.Second
+	defaultPageSize = 50
+)
+
+type CommandBus interface {
+	Dispatch(context.Context, core.Command) (any, error)
+}
+
+type QueryBus interface {
+	Ask(context.Context, core.Query) (any, error)
+}
+
+type OrganizationHandlers struct {
+	Commands CommandBus
+	Queries  QueryBus
+	tracer   trace.Tracer
+	Logger   logger.Logger
+}
+
+func NewOrganizationHandlers(v1 *echo.Group, commands CommandBus, queries QueryBus, logger logger.Logger) {
+	handlers := &OrganizationHandlers{
+		Commands: commands,
+		Queries:  queries,
+		Logger:   logger,
+		tracer:   otel.Tracer(""),
+	}
+
+	v1.GET("/organization", handlers.Fetch)
+	v1.POST("/organization", handlers.Create)
+	v1.GET("/organization/:id", handlers.GetByID)
+	v1.POST("/organization/:id/members", handlers.AddMember)
+	v1.PUT("/organization/:id/members/:userId", handlers.ChangeMemberRole)
+	v1.DELETE("/organization/:id/members/:userId", handlers.RemoveMember)
+}
+
+// Fetch godoc
+// @Summary Fetch organizations
+// @Description Fetch organizations of the current user
+// @Tags Organization
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.OrganizationDTO
+// @Router /organization [get]
+func (o *OrganizationHandlers) Fetch(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := o.tracer.Start(ctx, "OrganizationHandlers.Fetch")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	opts := dto.FetchOrganizationsDTO{
+		Limit:  defaultPageSize,
+		Offset: 0,
+	}
+
+	// Parse given params
+	errs := echo.QueryParamsBinder(c).
+		FailFast(false).
+		Int64("limit", &opts.Limit).
+		Int64("offset", &opts.Offset).
+		BindErrors()
+	if errs != nil {
+		for _, err := range errs {
+			var bindingError *echo.BindingError
+			if errors.As(err, &bindingError) {
+				errorList = append(errorList, &http_dto.ValidationError{
+					Field:  bindingError.Field,
+					Value:  bindingError.Values,
+					Reason: "parse",
+				})
+			}
+		}
+
+		o.Logger.Errorf("failed to decode request params: %#v", errorList)
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	err := c.Validate(opts)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	q := query.FetchOrganizationsQuery{
+		UserID: userID,
+		Offset: opts.Offset,
+		Limit:  opts.Limit,
+	}
+	organizations, err := o.Queries.Ask(ctx, q)
+	if err != nil {
+		return o.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data: map[string]interface{}{
+				"organizations": organizations,
+			},
+		},
+	)
+}
+
+// GetByID godoc
+// @Summary Get organization by id
+// @Description Get organization with its members
+// @Tags Organization
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Success 200 {object} dto.OrganizationDTO
+// @Router /organization/{id} [get]
+func (o *OrganizationHandlers) GetByID(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := o.tracer.Start(ctx, "OrganizationHandlers.GetByID")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	organization, err := o.Queries.Ask(ctx, query.FetchOrganizationQuery{ID: c.Param("id"), UserID: userID})
+	if err != nil {
+		return o.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data:    organization,
+		},
+	)
+}
+
+// Create godoc
+// @Summary Create organization
+// @Description Create organization owned by the current user
+// @Tags Organization
+// @Accept json
+// @Produce json
+// @Param body body dto.CreateOrganizationDTO true "Organization"
+// @Success 201
+// @Router /organization [post]
+func (o *OrganizationHandlers) Create(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := o.tracer.Start(ctx, "OrganizationHandlers.Create")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.CreateOrganizationDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	id, err := o.Commands.Dispatch(ctx, command.NewCreateOrganizationCommand(params.Name, userID))
+	if err != nil {
+		return o.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusCreated,
+		http_dto.ResponseDTO{
+			Status:  http.StatusCreated,
+			Message: "success",
+			Data: map[string]interface{}{
+				"id": id,
+			},
+		},
+	)
+}
+
+// AddMember godoc
+// @Summary Add organization member
+// @Description Add registered user to the organization by email
+// @Tags Organization
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param body body dto.AddMemberDTO true "Member"
+// @Success 201
+// @Router /organization/{id}/members [post]
+func (o *OrganizationHandlers) AddMember(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := o.tracer.Start(ctx, "OrganizationHandlers.AddMember")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.AddMemberDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err = o.Commands.Dispatch(
+		ctx,
+		command.NewAddOrganizationMemberCommand(c.Param("id"), userID, params.Email, params.Role),
+	)
+	if err != nil {
+		return o.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusCreated,
+		http_dto.ResponseDTO{
+			Status:  http.StatusCreated,
+			Message: "success",
+		},
+	)
+}
+
+// ChangeMemberRole godoc
+// @Summary Change organization member role
+// @Description Change role of the organization member
+// @Tags Organization
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param userId path string true "User ID"
+// @Param body body dto.ChangeMemberRoleDTO true "Role"
+// @Success 200
+// @Router /organization/{id}/members/{userId} [put]
+func (o *OrganizationHandlers) ChangeMemberRole(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := o.tracer.Start(ctx, "OrganizationHandlers.ChangeMemberRole")
+	defer span.End()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.ChangeMemberRoleDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err = o.Commands.Dispatch(
+		ctx,
+		command.NewChangeOrganizationMemberRoleCommand(c.Param("id"), userID, c.Param("userId"), params.Role),
+	)
+	if err != nil {
+		return o.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// RemoveMember godoc
+// @Summary Remove organization member
+// @Description Remove member from the organization or leave it
+// @Tags Organization
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param userId path string true "User ID"
+// @Success 204
+// @Router /organization/{id}/members/{userId} [delete]
+func (o *OrganizationHandlers) RemoveMember(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	ctx, span := o.tracer.Start(ctx, "OrganizationHandlers.RemoveMember")
+	defer span.End()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err := o.Commands.Dispatch(
+		ctx,
+		command.NewRemoveOrganizationMemberCommand(c.Param("id"), userID, c.Param("userId")),
+	)
+	if err != nil {
+		return o.errorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// errorResponse maps domain errors to http status codes.
+func (o *OrganizationHandlers) errorResponse(c echo.Context, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain_core.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, entity.ErrNotOrganizationMember), errors.Is(err, entity.ErrPermissionDenied):
+		status = http.StatusForbidden
+	case errors.Is(err, entity.ErrAlreadyMember), errors.Is(err, entity.ErrLastOwner):
+		status = http.StatusConflict
+	case errors.Is(err, entity.ErrEmptyOrganizationName),
+		errors.Is(err, entity.ErrOrganizationNameLength),
+		errors.Is(err, entity.ErrInvalidRole),
+		errors.Is(err, common.ErrEmptyUID),
+		errors.Is(err, common.ErrUIDBadFormat),
+		errors.Is(err, common.ErrEmptyEmail),
+		errors.Is(err, common.ErrBadFormat):
+		status = http.StatusBadRequest
+	default:
+		o.Logger.Errorf("organization request failed: %v", err)
+	}
+
+	return c.JSON(
+		status,
+		http_dto.ResponseDTO{
+			Status:  status,
+			Message: "error",
+			Error:   err.Error(),
+		},
+	)
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+)
+
+// DBOrganization Database organization representation.
+type DBOrganization struct {
+	ID        string    `db:"id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// DBMember Database organization member representation.
+type DBMember struct {
+	UserID   string    `db:"user_id"`
+	UserName string    `db:"user_name"`
+	Role     int       `db:"role"`
+	JoinedAt time.Time `db:"joined_at"`
+}
+
+// DBMembership Database organization joined with the membership of a user.
+type DBMembership struct {
+	DBOrganization
+	DBMember
+}
+
+// OrganizationFromDB Convert database organization model with its members to domain model.
+func OrganizationFromDB(dbOrganization DBOrganization, dbMembers []DBMember) (entity.Organization, error) {
+	id, err := common.ParseUID(dbOrganization.ID)
+	if err != nil {
+		return entity.Organization{}, err
+	}
+
+	members := make([]entity.Member, 0, len(dbMembers))
+	for _, dbMember := range dbMembers {
+		var member entity.Member
+		member, err = MemberFromDB(dbMember)
+		if err != nil {
+			return entity.Organization{}, err
+		}
+
+		members = append(members, member)
+	}
+
+	return entity.Hydrate(
+		id,
+		dbOrganization.Name,
+		members,
+		dbOrganization.CreatedAt,
+		dbOrganization.UpdatedAt,
+	), nil
+}
+
+// OrganizationToDB Convert domain organization model to database model.
+func OrganizationToDB(organization entity.Organization) DBOrganization {
+	return DBOrganization{
+		ID:        organization.ID().String(),
+		Name:      organization.Name(),
+		CreatedAt: organization.CreatedAt(),
+		UpdatedAt: organization.UpdatedAt(),
+	}
+}
+
+// MemberFromDB Convert database organization member to domain model.
+func MemberFromDB(dbMember DBMember) (entity.Member, error) {
+	userID, err := common.ParseUID(dbMember.UserID)
+	if err != nil {
+		return entity.Member{}, err
+	}
+
+	return entity.HydrateMember(userID, dbMember.UserName, entity.Role(dbMember.Role), dbMember.JoinedAt), nil
+}
+
+// MemberToDB Convert domain organization member to database model.
+func MemberToDB(member entity.Member) DBMember {
+	return DBMember{
+		UserID:   member.UserID().String(),
+		UserName: member.Name(),
+		Role:     int(member.Role()),
+		JoinedAt: member.JoinedAt(),
+	}
+}
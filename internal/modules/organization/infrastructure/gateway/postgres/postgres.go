@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/organization/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+type organizationPgStorage struct {
+	db     *sqlx.DB
+	logger logger.Logger
+	tracer trace.Tracer
+	getter *trmsqlx.CtxGetter
+}
+
+func NewOrganizationPgStorage(
+	db *sqlx.DB,
+	getter *trmsqlx.CtxGetter,
+	logger logger.Logger,
+) ports.OrganizationPgStorage {
+	return &organizationPgStorage{
+		db:     db,
+		logger: logger,
+		getter: getter,
+		tracer: otel.Tracer(""),
+	}
+}
+
+// FetchByUser Fetch organizations the user belongs to with the user membership.
+func (o *organizationPgStorage) FetchByUser(
+	ctx context.Context,
+	userID common.UID,
+	limit, offset int64,
+) ([]entity.Organization, error) {
+	ctx, span := o.tracer.Start(ctx, "organizationPgStorage.FetchByUser")
+	defer span.End()
+
+	memberships := make([]DBMembership, 0)
+	err := o.getter.DefaultTrOrDB(ctx, o.db).SelectContext(
+		ctx,
+		&memberships,
+		FetchByUserSQL,
+		userID.String(),
+		limit,
+		offset,
+	)
+	if err != nil {
+		o.logger.Errorf("Can't fetch organizations of user %s, err: %v", userID, err)
+		return nil, errors.Wrap(err, "FetchByUser.SelectContext")
+	}
+
+	result := make([]entity.Organization, 0, len(memberships))
+	for _, membership := range memberships {
+		organization, orgErr := OrganizationFromDB(membership.DBOrganization, []DBMember{membership.DBMember})
+		if orgErr != nil {
+			return nil, errors.Wrap(orgErr, "FetchByUser.OrganizationFromDB")
+		}
+
+		result = append(result, organization)
+	}
+
+	return result, nil
+}
+
+// GetByID Get organization with its members by id.
+func (o *organizationPgStorage) GetByID(ctx context.Context, id common.UID) (entity.Organization, error) {
+	ctx, span := o.tracer.Start(ctx, "organizationPgStorage.GetByID")
+	defer span.End()
+
+	db := o.getter.DefaultTrOrDB(ctx, o.db)
+
+	organizations := make([]DBOrganization, 0)
+	if err := db.SelectContext(ctx, &organizations, GetByIDSQL, id.String()); err != nil {
+		return entity.Organization{}, errors.Wrap(err, "GetByID.SelectOrganization")
+	}
+
+	if len(organizations) == 0 {
+		return entity.Organization{}, core.ErrNotFound
+	}
+
+	members := make([]DBMember, 0)
+	if err := db.SelectContext(ctx, &members, GetMembersSQL, id.String()); err != nil {
+		return entity.Organization{}, errors.Wrap(err, "GetByID.SelectMembers")
+	}
+
+	organization, err := OrganizationFromDB(organizations[0], members)
+	if err != nil {
+		return entity.Organization{}, errors.Wrap(err, "GetByID.OrganizationFromDB")
+	}
+
+	return organization, nil
+}
+
+// Create new organization with its members.
+func (o *organizationPgStorage) Create(ctx context.Context, organization entity.Organization) error {
+	ctx, span := o.tracer.Start(ctx, "organizationPgStorage.Create")
+	defer span.End()
+
+	dbOrganization := OrganizationToDB(organization)
+	if _, err := o.getter.DefaultTrOrDB(ctx, o.db).ExecContext(
+		ctx,
+		CreateSQL,
+		dbOrganization.ID,
+		dbOrganization.Name,
+		dbOrganization.CreatedAt,
+		dbOrganization.UpdatedAt,
+	); err != nil {
+		return errors.Wrap(err, "Create.ExecContext")
+	}
+
+	for _, member := range organization.Members() {
+		if err := o.AddMember(ctx, organization.ID(), member); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMember Add user to the organization.
+func (o *organizationPgStorage) AddMember(ctx context.Context, organizationID common.UID, member entity.Member) error {
+	ctx, span := o.tracer.Start(ctx, "organizationPgStorage.AddMember")
+	defer span.End()
+
+	dbMember := MemberToDB(member)
+	if _, err := o.getter.DefaultTrOrDB(ctx, o.db).ExecContext(
+		ctx,
+		AddMemberSQL,
+		organizationID.String(),
+		dbMember.UserID,
+		dbMember.Role,
+		dbMember.JoinedAt,
+	); err != nil {
+		return errors.Wrap(err, "AddMember.ExecContext")
+	}
+
+	return nil
+}
+
+// UpdateMemberRole Change role of the organization member.
+func (o *organizationPgStorage) UpdateMemberRole(
+	ctx context.Context,
+	organizationID, userID common.UID,
+	role entity.Role,
+) error {
+	ctx, span := o.tracer.Start(ctx, "organizationPgStorage.UpdateMemberRole")
+	defer span.End()
+
+	if _, err := o.getter.DefaultTrOrDB(ctx, o.db).ExecContext(
+		ctx,
+		UpdateMemberRoleSQL,
+		organizationID.String(),
+		userID.String(),
+		int(role),
+	); err != nil {
+		return errors.Wrap(err, "UpdateMemberRole.ExecContext")
+	}
+
+	return nil
+}
+
+// RemoveMember Remove user from the organization.
+func (o *organizationPgStorage) RemoveMember(ctx context.Context, organizationID, userID common.UID) error {
+	ctx, span := o.tracer.Start(ctx, "organizationPgStorage.RemoveMember")
+	defer span.End()
+
+	if _, err := o.getter.DefaultTrOrDB(ctx, o.db).ExecContext(
+		ctx,
+		RemoveMemberSQL,
+		organizationID.String(),
+		userID.String(),
+	); err != nil {
+		return errors.Wrap(err, "RemoveMember.ExecContext")
+	}
+
+	return nil
+}
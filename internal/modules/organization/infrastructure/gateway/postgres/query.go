@@ -0,0 +1,26 @@
+package postgres
+
+import _ "embed"
+
+var (
+	//go:embed query/fetchByUser.sql
+	FetchByUserSQL string
+
+	//go:embed query/getByID.sql
+	GetByIDSQL string
+
+	//go:embed query/getMembers.sql
+	GetMembersSQL string
+
+	//go:embed query/create.sql
+	CreateSQL string
+
+	//go:embed query/addMember.sql
+	AddMemberSQL string
+
+	//go:embed query/updateMemberRole.sql
+	UpdateMemberRoleSQL string
+
+	//go:embed query/removeMember.sql
+	RemoveMemberSQL string
+)
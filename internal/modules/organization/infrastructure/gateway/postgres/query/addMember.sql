@@ -0,0 +1,2 @@
+INSERT INTO organization_members (organization_id, user_id, role, joined_at)
+VALUES ($1, $2, $3, $4)
\ No newline at end of file
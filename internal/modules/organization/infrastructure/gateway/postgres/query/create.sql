@@ -0,0 +1,2 @@
+INSERT INTO organizations (id, name, created_at, updated_at)
+VALUES ($1, $2, $3, $4)
\ No newline at end of file
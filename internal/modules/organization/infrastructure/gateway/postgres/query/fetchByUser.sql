@@ -0,0 +1,14 @@
+SELECT o.id,
+       o.name,
+       o.created_at,
+       o.updated_at,
+       m.user_id,
+       u.name AS user_name,
+       m.role,
+       m.joined_at
+FROM organization_members m
+         JOIN organizations o ON o.id = m.organization_id
+         JOIN users u ON u.id = m.user_id
+WHERE m.user_id = $1
+ORDER BY m.joined_at, o.id
+LIMIT $2 OFFSET $3
\ No newline at end of file
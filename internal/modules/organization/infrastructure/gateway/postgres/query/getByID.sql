@@ -0,0 +1,6 @@
+SELECT id,
+       name,
+       created_at,
+       updated_at
+FROM organizations
+WHERE id = $1
\ No newline at end of file
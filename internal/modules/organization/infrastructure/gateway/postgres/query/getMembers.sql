@@ -0,0 +1,8 @@
+SELECT m.user_id,
+       u.name AS user_name,
+       m.role,
+       m.joined_at
+FROM organization_members m
+         JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = $1
+ORDER BY m.joined_at, m.user_id
\ No newline at end of file
@@ -0,0 +1,4 @@
+DELETE
+FROM organization_members
+WHERE organization_id = $1
+  AND user_id = $2
\ No newline at end of file
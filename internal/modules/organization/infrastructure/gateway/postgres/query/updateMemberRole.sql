@@ -0,0 +1,4 @@
+UPDATE organization_members
+SET role = $3
+WHERE organization_id = $1
+  AND user_id = $2
\ No newline at end of file
@@ -16,9 +16,11 @@ import (
 	handlers "github.com/KyKyPy3/clean/internal/modules/registration/infrastructure/controller/http/v1"
 	events "github.com/KyKyPy3/clean/internal/modules/registration/infrastructure/controller/queue/v1"
 	"github.com/KyKyPy3/clean/internal/modules/registration/infrastructure/gateway/email"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
 	"github.com/KyKyPy3/clean/pkg/logger"
 	"github.com/KyKyPy3/clean/pkg/mediator"
 	"github.com/KyKyPy3/clean/pkg/outbox"
+	"github.com/KyKyPy3/clean/pkg/tenant"
 )
 
 const (
@@ -35,13 +37,16 @@ func InitHandlers(
 	trManager *manager.Manager,
 	emailGateway *email.Client,
 	outboxManager outbox.Manager,
+	webURL string,
+	passwordPolicy vo.PasswordPolicy,
+	hasher ports.PasswordHasher,
 	logger logger.Logger,
 ) {
-	regUniqPolicy := application.NewUniquenessPolicy(ctx, userViewStorage, logger)
+	regUniqPolicy := application.NewUniquenessPolicy(tenant.Unscoped(ctx), userViewStorage, logger)
 	regCmdBus := core.NewCommandBus()
 	regCmdBus.Register(
 		command.CreateRegistrationKind,
-		command.NewCreateRegistration(regPgStorage, regUniqPolicy, trManager, pubsub, logger),
+		command.NewCreateRegistration(regPgStorage, regUniqPolicy, passwordPolicy, hasher, trManager, pubsub, logger),
 	)
 	regCmdBus.Register(
 		command.ConfirmRegistrationKind,
@@ -64,5 +69,5 @@ func InitHandlers(
 	})
 
 	handlers.NewRegistrationHandlers(mountPoint, regCmdBus, logger)
-	events.NewRegistrationEvents(consumer, regCmdBus, logger)
+	events.NewRegistrationEvents(consumer, regCmdBus, webURL, logger)
 }
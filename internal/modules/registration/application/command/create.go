@@ -8,6 +8,7 @@ import (
 	"github.com/KyKyPy3/clean/internal/domain/common"
 	"github.com/KyKyPy3/clean/internal/modules/registration/application/ports"
 	"github.com/KyKyPy3/clean/internal/modules/registration/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
 	"github.com/KyKyPy3/clean/pkg/logger"
 )
 
@@ -32,26 +33,32 @@ func (c CreateRegistrationCommand) Type() core.CommandType {
 var _ core.Command = (*CreateRegistrationCommand)(nil)
 
 type CreateRegistration struct {
-	storage  ports.RegistrationPgStorage
-	policy   ports.UniquenessPolicer
-	manager  ports.TrManager
-	mediator ports.Mediator
-	logger   logger.Logger
+	storage        ports.RegistrationPgStorage
+	policy         ports.UniquenessPolicer
+	passwordPolicy vo.PasswordPolicy
+	hasher         ports.PasswordHasher
+	manager        ports.TrManager
+	mediator       ports.Mediator
+	logger         logger.Logger
 }
 
 func NewCreateRegistration(
 	storage ports.RegistrationPgStorage,
 	policy ports.UniquenessPolicer,
+	passwordPolicy vo.PasswordPolicy,
+	hasher ports.PasswordHasher,
 	manager ports.TrManager,
 	mediator ports.Mediator,
 	logger logger.Logger,
 ) CreateRegistration {
 	return CreateRegistration{
-		storage:  storage,
-		policy:   policy,
-		manager:  manager,
-		mediator: mediator,
-		logger:   logger,
+		storage:        storage,
+		policy:         policy,
+		passwordPolicy: passwordPolicy,
+		hasher:         hasher,
+		manager:        manager,
+		mediator:       mediator,
+		logger:         logger,
 	}
 }
 
@@ -66,7 +73,12 @@ func (c CreateRegistration) Handle(ctx context.Context, command core.Command) (a
 		return nil, err
 	}
 
-	reg, err := entity.NewRegistration(email, createCommand.Password, c.policy)
+	password, err := vo.NewPassword(createCommand.Password, email, c.passwordPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	reg, err := entity.NewRegistration(email, password, c.policy, c.hasher)
 	if err != nil {
 		return nil, err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/KyKyPy3/clean/internal/domain/common"
 	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
 	"github.com/KyKyPy3/clean/internal/modules/registration/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
 	mocks "github.com/KyKyPy3/clean/mocks/internal_/application/core"
 	ports "github.com/KyKyPy3/clean/mocks/internal_/modules/registration/application/ports"
 	"github.com/KyKyPy3/clean/pkg/logger"
@@ -30,12 +31,15 @@ func TestHandleUnsupportedCreateRegistrationCommandError(t *testing.T) {
 
 	registrationStorageMock := ports.NewRegistrationPgStorage(t)
 	policyMock := ports.NewUniquenessPolicer(t)
+	hasherMock := ports.NewPasswordHasher(t)
 	managerMock := ports.NewTrManager(t)
 	mediatorMock := ports.NewMediator(t)
 
 	createRegistrationCommandHandler := command.NewCreateRegistration(
 		registrationStorageMock,
 		policyMock,
+		vo.PasswordPolicy{},
+		hasherMock,
 		managerMock,
 		mediatorMock,
 		log,
@@ -54,18 +58,21 @@ func TestHandleUnsupportedCreateRegistrationEmailError(t *testing.T) {
 	})
 
 	email := "test"
-	password := "12345"
+	password := "Correct-h0rse"
 
 	createRegistrationCommand := command.NewCreateRegistrationCommand(email, password)
 
 	registrationStorageMock := ports.NewRegistrationPgStorage(t)
 	policyMock := ports.NewUniquenessPolicer(t)
+	hasherMock := ports.NewPasswordHasher(t)
 	managerMock := ports.NewTrManager(t)
 	mediatorMock := ports.NewMediator(t)
 
 	createRegistrationCommandHandler := command.NewCreateRegistration(
 		registrationStorageMock,
 		policyMock,
+		vo.PasswordPolicy{},
+		hasherMock,
 		managerMock,
 		mediatorMock,
 		log,
@@ -84,12 +91,13 @@ func TestHandleUnsupportedCreateRegistrationNotUniqError(t *testing.T) {
 	})
 
 	email := "test@mail.com"
-	password := "12345"
+	password := "Correct-h0rse"
 
 	createRegistrationCommand := command.NewCreateRegistrationCommand(email, password)
 
 	registrationStorageMock := ports.NewRegistrationPgStorage(t)
 	policyMock := ports.NewUniquenessPolicer(t)
+	hasherMock := ports.NewPasswordHasher(t)
 	managerMock := ports.NewTrManager(t)
 	mediatorMock := ports.NewMediator(t)
 
@@ -98,6 +106,8 @@ func TestHandleUnsupportedCreateRegistrationNotUniqError(t *testing.T) {
 	createRegistrationCommandHandler := command.NewCreateRegistration(
 		registrationStorageMock,
 		policyMock,
+		vo.PasswordPolicy{},
+		hasherMock,
 		managerMock,
 		mediatorMock,
 		log,
@@ -116,16 +126,18 @@ func TestHandleCreateRegistrationCommandSuccess(t *testing.T) {
 	})
 
 	email := "test@gmail.com"
-	password := "12345"
+	password := "Correct-h0rse"
 
 	createRegistrationCommand := command.NewCreateRegistrationCommand(email, password)
 
 	registrationStorageMock := ports.NewRegistrationPgStorage(t)
 	policyMock := ports.NewUniquenessPolicer(t)
+	hasherMock := ports.NewPasswordHasher(t)
 	managerMock := ports.NewTrManager(t)
 	mediatorMock := ports.NewMediator(t)
 
 	policyMock.On("IsUnique", mock.Anything, mock.Anything).Return(true, nil)
+	hasherMock.On("Hash", password).Return("$argon2id$hash", nil)
 	managerMock.
 		EXPECT().Do(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
@@ -137,6 +149,8 @@ func TestHandleCreateRegistrationCommandSuccess(t *testing.T) {
 	createRegistrationCommandHandler := command.NewCreateRegistration(
 		registrationStorageMock,
 		policyMock,
+		vo.PasswordPolicy{},
+		hasherMock,
 		managerMock,
 		mediatorMock,
 		log,
@@ -146,3 +160,38 @@ func TestHandleCreateRegistrationCommandSuccess(t *testing.T) {
 	registrationStorageMock.AssertExpectations(t)
 	assert.NoError(t, err)
 }
+
+func TestHandleCreateRegistrationWeakPasswordError(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode:     "development",
+		Level:    "debug",
+		Encoding: "json",
+	})
+
+	email := "test@gmail.com"
+	password := "12345"
+
+	createRegistrationCommand := command.NewCreateRegistrationCommand(email, password)
+
+	registrationStorageMock := ports.NewRegistrationPgStorage(t)
+	policyMock := ports.NewUniquenessPolicer(t)
+	hasherMock := ports.NewPasswordHasher(t)
+	managerMock := ports.NewTrManager(t)
+	mediatorMock := ports.NewMediator(t)
+
+	createRegistrationCommandHandler := command.NewCreateRegistration(
+		registrationStorageMock,
+		policyMock,
+		vo.PasswordPolicy{RequireUpper: true},
+		hasherMock,
+		managerMock,
+		mediatorMock,
+		log,
+	)
+	_, err := createRegistrationCommandHandler.Handle(context.Background(), createRegistrationCommand)
+
+	registrationStorageMock.AssertExpectations(t)
+	var passwordErr *vo.PasswordError
+	assert.ErrorAs(t, err, &passwordErr)
+	assert.Equal(t, []string{vo.PasswordTooShort, vo.PasswordNoUpper}, passwordErr.Violations)
+}
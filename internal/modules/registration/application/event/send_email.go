@@ -13,8 +13,10 @@ import (
 const SendEmailKind = "SendEmail"
 
 type SendEmailCommand struct {
-	ID    string
-	Email string
+	ID      string
+	Email   string
+	Subject string
+	Body    string
 }
 
 func (c SendEmailCommand) Type() core.CommandType {
@@ -46,6 +48,5 @@ func (s *SendEmail) Handle(ctx context.Context, command core.Command) (any, erro
 		return nil, err
 	}
 
-	// TOFIX: get subject and body from config template
-	return nil, s.sender.Send(ctx, email, "Registration email", "Test body")
+	return nil, s.sender.Send(ctx, email, sendCommand.Subject, sendCommand.Body)
 }
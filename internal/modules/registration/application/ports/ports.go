@@ -36,3 +36,7 @@ type RegistrationPgStorage interface {
 	Update(ctx context.Context, registration entity.Registration) error
 	GetByID(ctx context.Context, id common.UID) (entity.Registration, error)
 }
+
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+}
@@ -3,17 +3,14 @@ package entity
 import (
 	"fmt"
 
-	"golang.org/x/crypto/bcrypt"
-
 	"github.com/KyKyPy3/clean/internal/domain/common"
 	"github.com/KyKyPy3/clean/internal/domain/core"
 	"github.com/KyKyPy3/clean/internal/modules/registration/domain"
 	"github.com/KyKyPy3/clean/internal/modules/registration/domain/event"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
 	"github.com/KyKyPy3/clean/pkg/mediator"
 )
 
-const passwordCost = 10
-
 // Registration struct.
 type Registration struct {
 	*core.BaseAggregateRoot
@@ -25,11 +22,20 @@ type Registration struct {
 }
 
 // NewRegistration - create and validate registration.
-func NewRegistration(email common.Email, password string, uniqPolicy domain.UniqueEmailPolicy) (Registration, error) {
+func NewRegistration(
+	email common.Email,
+	password vo.Password,
+	uniqPolicy domain.UniqueEmailPolicy,
+	hasher domain.PasswordHasher,
+) (Registration, error) {
 	if email.IsEmpty() {
 		return Registration{}, fmt.Errorf("registration email is empty, err: %w", core.ErrInvalidEntity)
 	}
 
+	if password.IsEmpty() {
+		return Registration{}, fmt.Errorf("registration password is empty, err: %w", core.ErrInvalidEntity)
+	}
+
 	ok, err := uniqPolicy.IsUnique(email)
 	if err != nil {
 		return Registration{}, fmt.Errorf("failed to check uniqueness of email on registration, err: %w", err)
@@ -43,11 +49,11 @@ func NewRegistration(email common.Email, password string, uniqPolicy domain.Uniq
 		BaseAggregateRoot: &core.BaseAggregateRoot{},
 		id:                common.NewUID(),
 		email:             email,
-		password:          password,
+		password:          password.String(),
 		verified:          false,
 	}
 
-	if err = r.hashPassword(); err != nil {
+	if err = r.hashPassword(hasher); err != nil {
 		return Registration{}, fmt.Errorf("can't hash password, err: %w", err)
 	}
 
@@ -100,13 +106,13 @@ func (r *Registration) Verify() error {
 }
 
 // hashPassword hash user password.
-func (r *Registration) hashPassword() error {
-	hash, err := bcrypt.GenerateFromPassword([]byte(r.password), passwordCost)
+func (r *Registration) hashPassword(hasher domain.PasswordHasher) error {
+	hash, err := hasher.Hash(r.password)
 	if err != nil {
 		return err
 	}
 
-	r.password = string(hash)
+	r.password = hash
 
 	return nil
 }
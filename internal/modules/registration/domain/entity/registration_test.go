@@ -10,6 +10,7 @@ import (
 	"github.com/KyKyPy3/clean/internal/domain/common"
 	"github.com/KyKyPy3/clean/internal/domain/core"
 	"github.com/KyKyPy3/clean/internal/modules/registration/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
 )
 
 var (
@@ -31,27 +32,48 @@ func (p *policyMock) IsUnique(email common.Email) (bool, error) {
 	return true, nil
 }
 
+type hasherMock struct {
+}
+
+func (h *hasherMock) Hash(password string) (string, error) {
+	return "hash:" + password, nil
+}
+
+func newPassword(t *testing.T) vo.Password {
+	t.Helper()
+
+	password, err := vo.NewPassword("Correct-h0rse", common.Email{}, vo.PasswordPolicy{})
+	require.NoError(t, err)
+
+	return password
+}
+
 func TestNewRegistration(t *testing.T) {
 	email := common.MustNewEmail("alise@email.com")
 
-	registration, err := entity.NewRegistration(email, "", &policyMock{})
+	registration, err := entity.NewRegistration(email, newPassword(t), &policyMock{}, &hasherMock{})
 	require.NoError(t, err)
 	assert.Equal(t, registration.Email(), email)
 	assert.False(t, registration.Verified())
+	assert.Equal(t, "hash:Correct-h0rse", registration.Password())
+	assert.NotEqual(t, "Correct-h0rse", registration.Password())
 }
 
 func TestRegistrationValidation(t *testing.T) {
 	email := common.Email{}
 
-	_, err := entity.NewRegistration(email, "", &policyMock{})
+	_, err := entity.NewRegistration(email, newPassword(t), &policyMock{}, &hasherMock{})
 	require.Error(t, err)
 	assert.ErrorIs(t, err, core.ErrInvalidEntity)
+
+	_, err = entity.NewRegistration(common.MustNewEmail("alise@email.com"), vo.Password{}, &policyMock{}, &hasherMock{})
+	assert.ErrorIs(t, err, core.ErrInvalidEntity)
 }
 
 func TestRegistrationUniqueSuccess(t *testing.T) {
 	email, _ := common.NewEmail("not_unique@gmail.com")
 
-	_, err := entity.NewRegistration(email, "", &policyMock{})
+	_, err := entity.NewRegistration(email, newPassword(t), &policyMock{}, &hasherMock{})
 	require.Error(t, err)
 	assert.ErrorIs(t, err, core.ErrAlreadyExist)
 }
@@ -59,7 +81,7 @@ func TestRegistrationUniqueSuccess(t *testing.T) {
 func TestRegistrationUniqueError(t *testing.T) {
 	email, _ := common.NewEmail("error@gmail.com")
 
-	_, err := entity.NewRegistration(email, "", &policyMock{})
+	_, err := entity.NewRegistration(email, newPassword(t), &policyMock{}, &hasherMock{})
 	require.Error(t, err)
 	assert.ErrorIs(t, err, errUnique)
 }
@@ -0,0 +1,6 @@
+package domain
+
+// PasswordHasher hashes the password of the registration.
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+}
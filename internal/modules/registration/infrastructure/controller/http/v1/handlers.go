@@ -17,6 +17,7 @@ import (
 	http_dto "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
 	"github.com/KyKyPy3/clean/internal/modules/registration/application/command"
 	"github.com/KyKyPy3/clean/internal/modules/registration/infrastructure/controller/http/dto"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
 	"github.com/KyKyPy3/clean/pkg/logger"
 )
 
@@ -108,6 +109,24 @@ func (r *RegistrationHandlers) Create(c echo.Context) error {
 
 	cmd := command.NewCreateRegistrationCommand(params.Email, params.Password)
 	_, err = r.commands.Dispatch(ctx, cmd)
+	var passwordErr *vo.PasswordError
+	if errors.As(err, &passwordErr) {
+		for _, violation := range passwordErr.Violations {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  "Password",
+				Reason: violation,
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
 	if err != nil {
 		r.logger.Errorf("Failed to create registration %w", err)
 
@@ -3,6 +3,9 @@ package v1
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 	"go.opentelemetry.io/otel"
@@ -11,9 +14,12 @@ import (
 	"github.com/KyKyPy3/clean/internal/application/core"
 	"github.com/KyKyPy3/clean/internal/infrastructure/queue"
 	reg_event "github.com/KyKyPy3/clean/internal/modules/registration/application/event"
+	user_event "github.com/KyKyPy3/clean/internal/modules/user/domain/event"
 	"github.com/KyKyPy3/clean/pkg/logger"
 )
 
+const kindHeader = "Kind"
+
 type CommandBus interface {
 	Dispatch(context.Context, core.Command) (any, error)
 }
@@ -23,22 +29,44 @@ type RegistrationEvent struct {
 	Email string `json:"email"`
 }
 
+type EmailChangeEvent struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	OldEmail string `json:"oldEmail"`
+}
+
+type PasswordResetEvent struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+}
+
+type AccountLockedEvent struct {
+	ID          string    `json:"id"`
+	Email       string    `json:"email"`
+	LockedUntil time.Time `json:"lockedUntil"`
+}
+
+// RegistrationEvents sends the emails of the events, their links open the pages of
+// the web client at webURL.
 type RegistrationEvents struct {
 	logger   logger.Logger
 	commands CommandBus
 	consumer *queue.Consumer
+	webURL   string
 	tracer   trace.Tracer
 }
 
-func NewRegistrationEvents(consumer *queue.Consumer, commands CommandBus, logger logger.Logger) {
+func NewRegistrationEvents(consumer *queue.Consumer, commands CommandBus, webURL string, logger logger.Logger) {
 	handlers := &RegistrationEvents{
 		logger:   logger,
 		consumer: consumer,
 		commands: commands,
+		webURL:   webURL,
 		tracer:   otel.Tracer(""),
 	}
 
 	consumer.Subscribe("registration", handlers.Handle)
+	consumer.Subscribe("user", handlers.HandleUser)
 }
 
 func (r *RegistrationEvents) Handle(ctx context.Context, event *kafka.Message) error {
@@ -53,9 +81,12 @@ func (r *RegistrationEvents) Handle(ctx context.Context, event *kafka.Message) e
 
 	r.logger.Debugf("Receive event from queue %+v", regEvent)
 
+	// TOFIX: get subject and body from config template
 	cmd := reg_event.SendEmailCommand{
-		ID:    regEvent.ID,
-		Email: regEvent.Email,
+		ID:      regEvent.ID,
+		Email:   regEvent.Email,
+		Subject: "Registration email",
+		Body:    "Test body",
 	}
 	_, err = r.commands.Dispatch(ctx, cmd)
 	if err != nil {
@@ -64,3 +95,114 @@ func (r *RegistrationEvents) Handle(ctx context.Context, event *kafka.Message) e
 
 	return nil
 }
+
+// HandleUser sends the emails of the user events, other events of the topic are skipped.
+func (r *RegistrationEvents) HandleUser(ctx context.Context, event *kafka.Message) error {
+	ctx, span := r.tracer.Start(ctx, "RegistrationEvents.HandleUser")
+	defer span.End()
+
+	var cmds []reg_event.SendEmailCommand
+	var err error
+	switch kind(event) {
+	case user_event.EmailChangeRequested:
+		cmds, err = emailChangeEmails(event, r.webURL)
+	case user_event.PasswordResetRequested:
+		cmds, err = passwordResetEmails(event)
+	case user_event.AccountLocked:
+		cmds, err = accountLockedEmails(event)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, cmd := range cmds {
+		_, err = r.commands.Dispatch(ctx, cmd)
+		if err != nil {
+			r.logger.Errorf("Can't execute send email command, err: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// emailChangeEmails sends the confirmation to the new address, the old one is only notified.
+// The link opens the confirmation page of the web client, the page applies the change
+// by a POST, so the mail scanners following the link don't confirm it.
+func emailChangeEmails(event *kafka.Message, webURL string) ([]reg_event.SendEmailCommand, error) {
+	changeEvent := EmailChangeEvent{}
+	err := json.Unmarshal(event.Value, &changeEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := url.JoinPath(webURL, "email", changeEvent.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []reg_event.SendEmailCommand{
+		{
+			ID:      changeEvent.ID,
+			Email:   changeEvent.Email,
+			Subject: "Confirm email change",
+			Body:    fmt.Sprintf("Confirm the new email of your account at %s", link),
+		},
+		{
+			ID:      changeEvent.ID,
+			Email:   changeEvent.OldEmail,
+			Subject: "Email change requested",
+			Body:    fmt.Sprintf("The email of your account is requested to change to %s", changeEvent.Email),
+		},
+	}, nil
+}
+
+func passwordResetEmails(event *kafka.Message) ([]reg_event.SendEmailCommand, error) {
+	resetEvent := PasswordResetEvent{}
+	err := json.Unmarshal(event.Value, &resetEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	return []reg_event.SendEmailCommand{
+		{
+			ID:      resetEvent.ID,
+			Email:   resetEvent.Email,
+			Subject: "Password reset",
+			Body:    fmt.Sprintf("Set a new password of your account with the code %s", resetEvent.ID),
+		},
+	}, nil
+}
+
+// accountLockedEmails warns the user, the failed logins may be an attack on the account.
+func accountLockedEmails(event *kafka.Message) ([]reg_event.SendEmailCommand, error) {
+	lockedEvent := AccountLockedEvent{}
+	err := json.Unmarshal(event.Value, &lockedEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	return []reg_event.SendEmailCommand{
+		{
+			ID:      lockedEvent.ID,
+			Email:   lockedEvent.Email,
+			Subject: "Account locked",
+			Body: fmt.Sprintf(
+				"Your account is locked after repeated failed logins until %s. "+
+					"If it was not you, reset your password.",
+				lockedEvent.LockedUntil.UTC().Format(time.RFC1123),
+			),
+		},
+	}, nil
+}
+
+func kind(event *kafka.Message) string {
+	for _, header := range event.Headers {
+		if header.Key == kindHeader {
+			return string(header.Value)
+		}
+	}
+
+	return ""
+}
@@ -7,16 +7,26 @@ import (
 	"github.com/KyKyPy3/clean/internal/infrastructure/config"
 	"github.com/KyKyPy3/clean/internal/modules/session/application/command"
 	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/query"
 	handlers "github.com/KyKyPy3/clean/internal/modules/session/infrastructure/controller/http/v1"
 	"github.com/KyKyPy3/clean/pkg/jwt"
 	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/mediator"
 )
 
 func InitHandlers(
 	userPgStorage ports.UserPgStorage,
+	gamePgStorage ports.GamePgStorage,
+	orgPgStorage ports.OrganizationPgStorage,
+	twoFactorPgStorage ports.TwoFactorPgStorage,
 	sessionRedisStorage ports.SessionRedisStorage,
+	challengeRedisStorage ports.ChallengeRedisStorage,
+	loginAttemptRedisStorage ports.LoginAttemptRedisStorage,
 	publicMountPoint *echo.Group,
 	privateMountPoint *echo.Group,
+	pubsub *mediator.Mediator,
+	hasher ports.PasswordHasher,
+	otp ports.OneTimePassword,
 	cfg *config.Config,
 	jwt *jwt.JWT,
 	logger logger.Logger,
@@ -24,14 +34,77 @@ func InitHandlers(
 	regCmdBus := core.NewCommandBus()
 	regCmdBus.Register(
 		command.LoginUserKind,
-		command.NewLoginUser(userPgStorage, sessionRedisStorage, logger),
+		command.NewLoginUser(
+			userPgStorage,
+			orgPgStorage,
+			twoFactorPgStorage,
+			sessionRedisStorage,
+			challengeRedisStorage,
+			loginAttemptRedisStorage,
+			pubsub,
+			hasher,
+			logger,
+		),
+	)
+	regCmdBus.Register(
+		command.LoginTwoFactorKind,
+		command.NewLoginTwoFactor(
+			twoFactorPgStorage,
+			sessionRedisStorage,
+			challengeRedisStorage,
+			loginAttemptRedisStorage,
+			otp,
+			pubsub,
+			logger,
+		),
+	)
+	regCmdBus.Register(
+		command.RefreshSessionKind,
+		command.NewRefreshSession(userPgStorage, sessionRedisStorage, pubsub, logger),
+	)
+	regCmdBus.Register(
+		command.SwitchOrganizationKind,
+		command.NewSwitchOrganization(orgPgStorage, sessionRedisStorage, logger),
 	)
 	regCmdBus.Register(
 		command.LogoutUserKind,
 		command.NewLogoutUser(sessionRedisStorage, logger),
 	)
 
+	regCmdBus.Register(
+		command.RevokeSessionKind,
+		command.NewRevokeSession(sessionRedisStorage, logger),
+	)
+	regCmdBus.Register(
+		command.RevokeOtherSessionsKind,
+		command.NewRevokeOtherSessions(sessionRedisStorage, logger),
+	)
+	regCmdBus.Register(
+		command.RevokeUserSessionsKind,
+		command.NewRevokeUserSessions(orgPgStorage, sessionRedisStorage, logger),
+	)
+
+	regCmdBus.Register(
+		command.LoginGuestKind,
+		command.NewLoginGuest(gamePgStorage, sessionRedisStorage, logger),
+	)
+
 	userQueryBus := core.NewQueryBus()
+	userQueryBus.Register(
+		query.FetchSessionsKind,
+		query.NewFetchSessions(sessionRedisStorage, logger),
+	)
 
 	handlers.NewAuthHandlers(publicMountPoint, privateMountPoint, regCmdBus, userQueryBus, cfg, jwt, logger)
 }
+
+// NewAccountLockout counts the wrong two-factor codes of the other modules toward
+// the lockout of the account configured for the logins.
+func NewAccountLockout(
+	loginAttemptRedisStorage ports.LoginAttemptRedisStorage,
+	pubsub *mediator.Mediator,
+	cfg *config.Config,
+	logger logger.Logger,
+) command.AccountLockout {
+	return command.NewAccountLockout(loginAttemptRedisStorage, handlers.AccountLockoutPolicy(cfg), pubsub, logger)
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	game_domain "github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+const LoginGuestKind = "LoginGuest"
+
+// LoginGuestCommand opens a spectator session for the game of the join link.
+type LoginGuestCommand struct {
+	Code      string
+	Name      string
+	AccessTTL time.Duration
+}
+
+type LoginGuestResult struct {
+	Guest          entity.Guest
+	OrganizationID common.UID
+	AccessToken    entity.Token
+}
+
+func (c LoginGuestCommand) Type() core.CommandType {
+	return LoginGuestKind
+}
+
+var _ core.Command = (*LoginGuestCommand)(nil)
+
+type LoginGuest struct {
+	gameView       ports.GamePgStorage
+	sessionStorage ports.SessionRedisStorage
+	logger         logger.Logger
+}
+
+func NewLoginGuest(
+	gameView ports.GamePgStorage,
+	sessionStorage ports.SessionRedisStorage,
+	logger logger.Logger,
+) LoginGuest {
+	return LoginGuest{
+		gameView:       gameView,
+		sessionStorage: sessionStorage,
+		logger:         logger,
+	}
+}
+
+func (l LoginGuest) Handle(ctx context.Context, command core.Command) (any, error) {
+	guestCommand, ok := command.(LoginGuestCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	// Guests join by code before the organization of the game is known
+	ctx = tenant.Unscoped(ctx)
+
+	link, err := l.gameView.GetJoinLink(ctx, guestCommand.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if link.IsExpired(now) {
+		return nil, game_domain.ErrJoinLinkExpired
+	}
+
+	guest, err := entity.NewGuest(link.GameID(), guestCommand.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := l.gameView.GetByID(ctx, link.GameID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Guest sessions never outlive the join link they were opened with
+	expiresAt := now.Add(guestCommand.AccessTTL)
+	if link.ExpiresAt().Before(expiresAt) {
+		expiresAt = link.ExpiresAt()
+	}
+
+	accessToken := entity.NewToken(guest.ID(), game.OrganizationID(), expiresAt.Unix())
+	err = l.sessionStorage.Set(ctx, accessToken.ID(), accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return LoginGuestResult{
+		Guest:          guest,
+		OrganizationID: game.OrganizationID(),
+		AccessToken:    accessToken,
+	}, nil
+}
@@ -0,0 +1,168 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	user_event "github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+// loginLockout counts the failed passwords and two-factor codes of the accounts
+// and IP addresses and locks them by their lockout policies.
+type loginLockout struct {
+	storage  ports.LoginAttemptRedisStorage
+	mediator ports.Mediator
+	logger   logger.Logger
+}
+
+// failedLogin describes the failure, the user is empty for unknown accounts.
+type failedLogin struct {
+	email          common.Email
+	userID         common.UID
+	ip             string
+	accountLockout entity.LockoutPolicy
+	ipLockout      entity.LockoutPolicy
+}
+
+type lockout struct {
+	duration time.Duration
+	started  bool
+}
+
+// check rejects the login while the account or the IP address is locked.
+func (l loginLockout) check(ctx context.Context, email common.Email, ip string) error {
+	var retryAfter time.Duration
+	for _, key := range []string{accountKey(email), ipKey(ip)} {
+		if key == "" {
+			continue
+		}
+
+		lockedFor, err := l.storage.LockedFor(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		retryAfter = max(retryAfter, lockedFor)
+	}
+
+	if retryAfter > 0 {
+		return &entity.LockedError{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// fail counts the failure of the account and the IP address. It returns
+// entity.LockedError when the failure starts or extends a lockout.
+func (l loginLockout) fail(ctx context.Context, login failedLogin) error {
+	accountLock, err := l.count(ctx, accountKey(login.email), login.accountLockout)
+	if err != nil {
+		return err
+	}
+
+	ipLock, err := l.count(ctx, ipKey(login.ip), login.ipLockout)
+	if err != nil {
+		return err
+	}
+
+	// The user is told once, when the failures reach the threshold
+	if accountLock.started && !login.userID.IsEmpty() {
+		err = l.mediator.Publish(ctx, user_event.AccountLockedEvent{
+			ID:          login.userID.String(),
+			Email:       login.email,
+			LockedUntil: time.Now().Add(accountLock.duration).UTC(),
+		})
+		if err != nil {
+			l.logger.Errorf("Can't publish account locked event of user %s: %s", login.userID, err)
+		}
+	}
+
+	retryAfter := max(accountLock.duration, ipLock.duration)
+	if retryAfter > 0 {
+		return &entity.LockedError{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// reset forgets the failures of the account after the login succeeded.
+func (l loginLockout) reset(ctx context.Context, email common.Email) error {
+	return l.storage.Reset(ctx, accountKey(email))
+}
+
+// count records the failure of the key and locks it by the backoff of the policy.
+func (l loginLockout) count(ctx context.Context, key string, policy entity.LockoutPolicy) (lockout, error) {
+	if key == "" || !policy.Enabled() {
+		return lockout{}, nil
+	}
+
+	failures, err := l.storage.Fail(ctx, key, policy.Window)
+	if err != nil {
+		return lockout{}, err
+	}
+
+	duration := policy.Backoff(failures)
+	if duration == 0 {
+		return lockout{}, nil
+	}
+
+	err = l.storage.Lock(ctx, key, duration)
+	if err != nil {
+		return lockout{}, err
+	}
+
+	return lockout{duration: duration, started: failures == policy.Threshold}, nil
+}
+
+// AccountLockout lets the other modules count their wrong two-factor codes toward
+// the lockout of the account shared with the logins.
+type AccountLockout struct {
+	lockout loginLockout
+	policy  entity.LockoutPolicy
+}
+
+func NewAccountLockout(
+	attemptStorage ports.LoginAttemptRedisStorage,
+	policy entity.LockoutPolicy,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) AccountLockout {
+	return AccountLockout{
+		lockout: loginLockout{storage: attemptStorage, mediator: mediator, logger: logger},
+		policy:  policy,
+	}
+}
+
+// LockedFor returns the remaining lockout of the account, it is zero when the account is not locked.
+func (a AccountLockout) LockedFor(ctx context.Context, email common.Email) (time.Duration, error) {
+	return a.lockout.storage.LockedFor(ctx, accountKey(email))
+}
+
+// Fail counts a wrong code of the user and returns the lockout it starts or extends.
+func (a AccountLockout) Fail(ctx context.Context, userID common.UID, email common.Email) (time.Duration, error) {
+	err := a.lockout.fail(ctx, failedLogin{email: email, userID: userID, accountLockout: a.policy})
+
+	var locked *entity.LockedError
+	if errors.As(err, &locked) {
+		return locked.RetryAfter, nil
+	}
+
+	return 0, err
+}
+
+func accountKey(email common.Email) string {
+	return "account:" + email.String()
+}
+
+func ipKey(ip string) string {
+	if ip == "" {
+		return ""
+	}
+
+	return "ip:" + ip
+}
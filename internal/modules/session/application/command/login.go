@@ -2,30 +2,51 @@ package command
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/KyKyPy3/clean/internal/application/core"
 	"github.com/KyKyPy3/clean/internal/domain/common"
 	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	org_domain "github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
 	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
 	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	user_domain "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
 	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
 )
 
-const LoginUserKind = "LoginUser"
+const (
+	LoginUserKind = "LoginUser"
+	// dummyPassword is hashed once to verify the passwords of unknown emails.
+	dummyPassword = "dummy-password"
+)
 
+// LoginUserCommand opens a session in the given organization. Without an
+// organization the session is opened in the first organization the user joined.
+// Failed logins lock the account and the IP address by their lockout policies.
 type LoginUserCommand struct {
-	Email      string
-	Password   string
-	AccessTTL  time.Duration
-	RefreshTTL time.Duration
+	Email          string
+	Password       string
+	OrganizationID string
+	UserAgent      string
+	IP             string
+	AccessTTL      time.Duration
+	RefreshTTL     time.Duration
+	ChallengeTTL   time.Duration
+	AccountLockout entity.LockoutPolicy
+	IPLockout      entity.LockoutPolicy
 }
 
+// LoginUserResult holds the tokens of the session, or the challenge when the user
+// has to confirm the login with the two-factor code.
 type LoginUserResult struct {
-	UserID       common.UID
-	AccessToken  entity.Token
-	RefreshToken entity.Token
+	UserID         common.UID
+	OrganizationID common.UID
+	AccessToken    entity.Token
+	RefreshToken   entity.Token
+	Challenge      entity.Challenge
 }
 
 func (c LoginUserCommand) Type() core.CommandType {
@@ -35,20 +56,44 @@ func (c LoginUserCommand) Type() core.CommandType {
 var _ core.Command = (*LoginUserCommand)(nil)
 
 type LoginUser struct {
-	userView       ports.UserPgStorage
-	sessionStorage ports.SessionRedisStorage
-	logger         logger.Logger
+	userView         ports.UserPgStorage
+	orgView          ports.OrganizationPgStorage
+	twoFactorView    ports.TwoFactorPgStorage
+	sessionStorage   ports.SessionRedisStorage
+	challengeStorage ports.ChallengeRedisStorage
+	lockout          loginLockout
+	hasher           ports.PasswordHasher
+	dummyHash        string
+	logger           logger.Logger
 }
 
 func NewLoginUser(
 	userView ports.UserPgStorage,
+	orgView ports.OrganizationPgStorage,
+	twoFactorView ports.TwoFactorPgStorage,
 	sessionStorage ports.SessionRedisStorage,
+	challengeStorage ports.ChallengeRedisStorage,
+	attemptStorage ports.LoginAttemptRedisStorage,
+	mediator ports.Mediator,
+	hasher ports.PasswordHasher,
 	logger logger.Logger,
 ) LoginUser {
+	// The hash follows the current hasher settings, so it takes as long as the stored ones
+	dummyHash, err := hasher.Hash(dummyPassword)
+	if err != nil {
+		logger.Errorf("Can't hash the dummy password: %s", err)
+	}
+
 	return LoginUser{
-		userView:       userView,
-		sessionStorage: sessionStorage,
-		logger:         logger,
+		userView:         userView,
+		orgView:          orgView,
+		twoFactorView:    twoFactorView,
+		sessionStorage:   sessionStorage,
+		challengeStorage: challengeStorage,
+		lockout:          loginLockout{storage: attemptStorage, mediator: mediator, logger: logger},
+		hasher:           hasher,
+		dummyHash:        dummyHash,
+		logger:           logger,
 	}
 }
 
@@ -63,39 +108,214 @@ func (l LoginUser) Handle(ctx context.Context, command core.Command) (any, error
 		return nil, err
 	}
 
-	user, err := l.userView.GetByEmail(ctx, email)
+	// The user is looked up before the session organization is known
+	ctx = tenant.Unscoped(ctx)
+
+	err = l.lockout.check(ctx, email, loginCommand.IP)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.IsEmpty() {
+	user, err := l.userView.GetByEmail(ctx, email)
+	if err != nil && !errors.Is(err, domain_core.ErrNotFound) {
+		return nil, err
+	}
+
+	// Unknown accounts count as failures too, so they can't be told apart
+	if !l.validatePassword(user, loginCommand.Password) {
+		err = l.lockout.fail(ctx, failedLogin{
+			email:          email,
+			userID:         user.ID(),
+			ip:             loginCommand.IP,
+			accountLockout: loginCommand.AccountLockout,
+			ipLockout:      loginCommand.IPLockout,
+		})
+		if err != nil {
+			return nil, err
+		}
+
 		return nil, domain_core.ErrNotFound
 	}
 
-	err = user.ValidatePassword(loginCommand.Password)
+	l.rehashPassword(ctx, user, loginCommand.Password)
+
+	organizationID, err := l.organization(ctx, user.ID(), loginCommand.OrganizationID)
 	if err != nil {
-		return nil, domain_core.ErrNotFound
+		return nil, err
 	}
 
-	now := time.Now().UTC()
-	accessExpiresIn := now.Add(loginCommand.AccessTTL).Unix()
-	refreshExpiresIn := now.Add(loginCommand.RefreshTTL).Unix()
-	accessToken := entity.NewToken(user.ID(), accessExpiresIn)
-	refreshToken := entity.NewToken(user.ID(), refreshExpiresIn)
+	// Users with two-factor authentication answer a challenge before the tokens are issued
+	twoFactor, err := l.twoFactorView.GetByUser(ctx, user.ID())
+	if err != nil && !errors.Is(err, domain_core.ErrNotFound) {
+		return nil, err
+	}
+
+	// The failures of the account are kept until the code is checked too
+	if twoFactor.Enabled() {
+		challenge := entity.NewChallenge(
+			user.ID(),
+			organizationID,
+			email,
+			time.Now().Add(loginCommand.ChallengeTTL).Unix(),
+		)
+		err = l.challengeStorage.Set(ctx, challenge)
+		if err != nil {
+			return nil, err
+		}
 
-	err = l.sessionStorage.Set(ctx, accessToken.ID(), accessToken)
+		return LoginUserResult{
+			UserID:         user.ID(),
+			OrganizationID: organizationID,
+			Challenge:      challenge,
+		}, nil
+	}
+
+	err = l.lockout.reset(ctx, email)
 	if err != nil {
 		return nil, err
 	}
 
-	err = l.sessionStorage.Set(ctx, refreshToken.ID(), refreshToken)
+	accessToken, refreshToken, err := openSession(ctx, l.sessionStorage, sessionParams{
+		userID:         user.ID(),
+		organizationID: organizationID,
+		userAgent:      loginCommand.UserAgent,
+		ip:             loginCommand.IP,
+		accessTTL:      loginCommand.AccessTTL,
+		refreshTTL:     loginCommand.RefreshTTL,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return LoginUserResult{
-		UserID:       user.ID(),
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		UserID:         user.ID(),
+		OrganizationID: organizationID,
+		AccessToken:    accessToken,
+		RefreshToken:   refreshToken,
 	}, nil
 }
+
+// validatePassword checks the password of the user. The password of an unknown
+// email is verified against the dummy hash, so the response takes as long.
+func (l LoginUser) validatePassword(user user_domain.User, password string) bool {
+	if user.IsEmpty() {
+		_ = l.hasher.Verify(l.dummyHash, password)
+		return false
+	}
+
+	return user.ValidatePassword(password, l.hasher) == nil
+}
+
+// rehashPassword migrates the stored hash to the current hasher settings while the
+// plain password is known. The login goes on when it fails, the old hash still works.
+func (l LoginUser) rehashPassword(ctx context.Context, user user_domain.User, password string) {
+	rehashed, err := user.RehashPassword(password, l.hasher)
+	if err != nil {
+		l.logger.Warnf("Can't rehash password of user %s: %s", user.ID(), err)
+		return
+	}
+
+	if !rehashed {
+		return
+	}
+
+	err = l.userView.UpdatePassword(ctx, user)
+	if err != nil {
+		l.logger.Warnf("Can't store rehashed password of user %s: %s", user.ID(), err)
+	}
+}
+
+// organization picks the organization to open the session in.
+func (l LoginUser) organization(ctx context.Context, userID common.UID, requested string) (common.UID, error) {
+	if requested == "" {
+		organizations, err := l.orgView.FetchByUser(ctx, userID, 1, 0)
+		if err != nil {
+			return common.UID{}, err
+		}
+
+		if len(organizations) == 0 {
+			return common.UID{}, org_domain.ErrNotOrganizationMember
+		}
+
+		return organizations[0].ID(), nil
+	}
+
+	return memberOrganization(ctx, l.orgView, userID, requested)
+}
+
+// memberOrganization checks that the user belongs to the requested organization.
+func memberOrganization(
+	ctx context.Context,
+	orgView ports.OrganizationPgStorage,
+	userID common.UID,
+	requested string,
+) (common.UID, error) {
+	organizationID, err := common.ParseUID(requested)
+	if err != nil {
+		return common.UID{}, err
+	}
+
+	organization, err := orgView.GetByID(ctx, organizationID)
+	if err != nil {
+		return common.UID{}, err
+	}
+
+	if !organization.IsMember(userID) {
+		return common.UID{}, org_domain.ErrNotOrganizationMember
+	}
+
+	return organizationID, nil
+}
+
+type sessionParams struct {
+	userID         common.UID
+	organizationID common.UID
+	userAgent      string
+	ip             string
+	accessTTL      time.Duration
+	refreshTTL     time.Duration
+}
+
+// openSession stores the access and refresh tokens of a new token family together
+// with the session recording the device of the login.
+func openSession(
+	ctx context.Context,
+	sessionStorage ports.SessionRedisStorage,
+	params sessionParams,
+) (entity.Token, entity.Token, error) {
+	now := time.Now().UTC()
+	refreshExpiresIn := now.Add(params.refreshTTL).Unix()
+	familyID := common.NewUID()
+	accessToken := entity.NewFamilyToken(
+		familyID,
+		params.userID,
+		params.organizationID,
+		now.Add(params.accessTTL).Unix(),
+	)
+	refreshToken := entity.NewFamilyToken(familyID, params.userID, params.organizationID, refreshExpiresIn)
+
+	err := sessionStorage.Set(ctx, accessToken.ID(), accessToken)
+	if err != nil {
+		return entity.Token{}, entity.Token{}, err
+	}
+
+	err = sessionStorage.Set(ctx, refreshToken.ID(), refreshToken)
+	if err != nil {
+		return entity.Token{}, entity.Token{}, err
+	}
+
+	session := entity.NewSession(
+		familyID,
+		params.userID,
+		params.organizationID,
+		params.userAgent,
+		params.ip,
+		refreshExpiresIn,
+	)
+	err = sessionStorage.SetSession(ctx, session)
+	if err != nil {
+		return entity.Token{}, entity.Token{}, err
+	}
+
+	return accessToken, refreshToken, nil
+}
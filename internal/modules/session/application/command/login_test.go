@@ -0,0 +1,211 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	org_domain "github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	user_domain "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+	"github.com/KyKyPy3/clean/pkg/hasher"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+type attemptStorageStub struct {
+	failures map[string]int64
+	locked   map[string]time.Duration
+}
+
+func newAttemptStorageStub() *attemptStorageStub {
+	return &attemptStorageStub{
+		failures: map[string]int64{},
+		locked:   map[string]time.Duration{},
+	}
+}
+
+func (a *attemptStorageStub) Fail(_ context.Context, key string, _ time.Duration) (int64, error) {
+	a.failures[key]++
+	return a.failures[key], nil
+}
+
+func (a *attemptStorageStub) Lock(_ context.Context, key string, duration time.Duration) error {
+	a.locked[key] = duration
+	return nil
+}
+
+func (a *attemptStorageStub) LockedFor(_ context.Context, key string) (time.Duration, error) {
+	return a.locked[key], nil
+}
+
+func (a *attemptStorageStub) Reset(_ context.Context, key string) error {
+	delete(a.failures, key)
+	return nil
+}
+
+// expire ends the running lockouts.
+func (a *attemptStorageStub) expire() {
+	clear(a.locked)
+}
+
+// hasherSpy records the hashes the passwords are verified against.
+type hasherSpy struct {
+	*hasher.Hasher
+	verified []string
+}
+
+func (h *hasherSpy) Verify(hash, password string) error {
+	h.verified = append(h.verified, hash)
+	return h.Hasher.Verify(hash, password)
+}
+
+func TestLoginLockout(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	passwordHasher := hasher.New(hasher.Params{Memory: 1024, Iterations: 1, Parallelism: 1})
+	hash, err := passwordHasher.Hash("12345")
+	require.NoError(t, err)
+	user := user_domain.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		hash,
+		time.Now(),
+		time.Now(),
+	)
+	organization := org_domain.Hydrate(
+		common.NewUID(),
+		"Acme",
+		[]org_domain.Member{org_domain.HydrateMember(user.ID(), "Alise", org_domain.RoleMember, time.Now())},
+		time.Now(),
+		time.Now(),
+	)
+
+	attempts := newAttemptStorageStub()
+	events := &mediatorStub{}
+	handler := command.NewLoginUser(
+		&userStorageStub{user: user},
+		&orgStorageStub{organization: organization},
+		&twoFactorStorageStub{},
+		newSessionStorageStub(),
+		newChallengeStorageStub(),
+		attempts,
+		events,
+		passwordHasher,
+		log,
+	)
+	login := func(email, password string) error {
+		_, loginErr := handler.Handle(context.Background(), command.LoginUserCommand{
+			Email:      email,
+			Password:   password,
+			IP:         "10.0.0.1",
+			AccessTTL:  time.Minute,
+			RefreshTTL: time.Hour,
+			AccountLockout: entity.LockoutPolicy{
+				Threshold: 3,
+				Window:    time.Hour,
+				Delay:     time.Second,
+				MaxDelay:  3 * time.Second,
+			},
+			IPLockout: entity.LockoutPolicy{
+				Threshold: 8,
+				Window:    time.Hour,
+				Delay:     time.Minute,
+				MaxDelay:  time.Hour,
+			},
+		})
+
+		return loginErr
+	}
+	retryAfter := func(err error) time.Duration {
+		var locked *entity.LockedError
+		require.ErrorAs(t, err, &locked)
+		require.ErrorIs(t, err, entity.ErrLoginLocked)
+
+		return locked.RetryAfter
+	}
+
+	require.ErrorIs(t, login("alise@email.com", "password"), domain_core.ErrNotFound)
+	require.ErrorIs(t, login("alise@email.com", "password"), domain_core.ErrNotFound)
+	assert.Equal(t, time.Second, retryAfter(login("alise@email.com", "password")))
+
+	// The locked account rejects the right password too
+	assert.Equal(t, time.Second, retryAfter(login("alise@email.com", "12345")))
+
+	// The lockout doubles with each further failure up to the limit
+	attempts.expire()
+	assert.Equal(t, 2*time.Second, retryAfter(login("alise@email.com", "password")))
+	attempts.expire()
+	assert.Equal(t, 3*time.Second, retryAfter(login("alise@email.com", "password")))
+
+	// The user is told once
+	require.Len(t, events.events, 1)
+	locked, ok := events.events[0].(event.AccountLockedEvent)
+	require.True(t, ok)
+	assert.Equal(t, user.ID().String(), locked.ID)
+	assert.Equal(t, user.Email(), locked.Email)
+
+	// The successful login forgets the failures of the account
+	attempts.expire()
+	require.NoError(t, login("alise@email.com", "12345"))
+	assert.NotContains(t, attempts.failures, "account:alise@email.com")
+
+	// Failures of unknown accounts count for the IP address
+	require.ErrorIs(t, login("bob@email.com", "password"), domain_core.ErrNotFound)
+	require.ErrorIs(t, login("eve@email.com", "password"), domain_core.ErrNotFound)
+	assert.Equal(t, time.Minute, retryAfter(login("mallory@email.com", "password")))
+	assert.Equal(t, time.Minute, retryAfter(login("alise@email.com", "12345")))
+	assert.Len(t, events.events, 1)
+}
+
+func TestLoginUnknownEmail(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	passwordHasher := &hasherSpy{Hasher: hasher.New(hasher.Params{Memory: 1024, Iterations: 1, Parallelism: 1})}
+	hash, err := passwordHasher.Hash("12345")
+	require.NoError(t, err)
+	user := user_domain.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		hash,
+		time.Now(),
+		time.Now(),
+	)
+
+	handler := command.NewLoginUser(
+		&userStorageStub{user: user},
+		&orgStorageStub{},
+		&twoFactorStorageStub{},
+		newSessionStorageStub(),
+		newChallengeStorageStub(),
+		newAttemptStorageStub(),
+		&mediatorStub{},
+		passwordHasher,
+		log,
+	)
+	_, err = handler.Handle(context.Background(), command.LoginUserCommand{
+		Email:    "bob@email.com",
+		Password: "12345",
+	})
+	require.ErrorIs(t, err, domain_core.ErrNotFound)
+
+	// The password is verified against a hash of the current settings like the known ones
+	require.Len(t, passwordHasher.verified, 1)
+	assert.NotEqual(t, hash, passwordHasher.verified[0])
+	assert.False(t, passwordHasher.NeedsRehash(passwordHasher.verified[0]))
+}
@@ -0,0 +1,198 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	user_domain "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+const LoginTwoFactorKind = "LoginTwoFactor"
+
+// LoginTwoFactorCommand answers the challenge of the login with a code of the
+// authenticator app or a recovery code and opens the session. Wrong codes count
+// toward the lockout of the account and the IP address like wrong passwords.
+type LoginTwoFactorCommand struct {
+	ChallengeID    string
+	Code           string
+	UserAgent      string
+	IP             string
+	MaxAttempts    int
+	AccessTTL      time.Duration
+	RefreshTTL     time.Duration
+	AccountLockout entity.LockoutPolicy
+	IPLockout      entity.LockoutPolicy
+}
+
+func (c LoginTwoFactorCommand) Type() core.CommandType {
+	return LoginTwoFactorKind
+}
+
+var _ core.Command = (*LoginTwoFactorCommand)(nil)
+
+type LoginTwoFactor struct {
+	twoFactorView    ports.TwoFactorPgStorage
+	sessionStorage   ports.SessionRedisStorage
+	challengeStorage ports.ChallengeRedisStorage
+	lockout          loginLockout
+	otp              ports.OneTimePassword
+	mediator         ports.Mediator
+	logger           logger.Logger
+}
+
+func NewLoginTwoFactor(
+	twoFactorView ports.TwoFactorPgStorage,
+	sessionStorage ports.SessionRedisStorage,
+	challengeStorage ports.ChallengeRedisStorage,
+	attemptStorage ports.LoginAttemptRedisStorage,
+	otp ports.OneTimePassword,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) LoginTwoFactor {
+	return LoginTwoFactor{
+		twoFactorView:    twoFactorView,
+		sessionStorage:   sessionStorage,
+		challengeStorage: challengeStorage,
+		lockout:          loginLockout{storage: attemptStorage, mediator: mediator, logger: logger},
+		otp:              otp,
+		mediator:         mediator,
+		logger:           logger,
+	}
+}
+
+func (l LoginTwoFactor) Handle(ctx context.Context, command core.Command) (any, error) {
+	loginCommand, ok := command.(LoginTwoFactorCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	challengeID, err := common.ParseUID(loginCommand.ChallengeID)
+	if err != nil {
+		return nil, domain_core.ErrNotFound
+	}
+
+	challenge, err := l.challengeStorage.Get(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The login continues in the organization the challenge was issued for
+	ctx = tenant.WithOrganization(ctx, challenge.OrganizationID().String())
+
+	err = l.lockout.check(ctx, challenge.Email(), loginCommand.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	// The answer is counted before the code is checked, so concurrent answers can't exceed the attempts
+	attempts, err := l.challengeStorage.Attempt(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if attempts > int64(loginCommand.MaxAttempts) {
+		return nil, l.removeChallenge(ctx, challenge)
+	}
+
+	twoFactor, err := l.twoFactorView.GetByUser(ctx, challenge.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	err = twoFactor.Authenticate(loginCommand.Code, time.Now(), l.otp)
+	if errors.Is(err, user_domain.ErrInvalidTwoFactorCode) {
+		return nil, l.fail(ctx, loginCommand, challenge, attempts)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A challenge opens one session, concurrent answers are refused
+	err = l.challengeStorage.Delete(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.lockout.reset(ctx, challenge.Email())
+	if err != nil {
+		return nil, err
+	}
+
+	// The used code and recovery code are not accepted again
+	err = l.twoFactorView.Update(ctx, twoFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.mediator.Publish(ctx, twoFactor.Events()...)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, refreshToken, err := openSession(ctx, l.sessionStorage, sessionParams{
+		userID:         challenge.UserID(),
+		organizationID: challenge.OrganizationID(),
+		userAgent:      loginCommand.UserAgent,
+		ip:             loginCommand.IP,
+		accessTTL:      loginCommand.AccessTTL,
+		refreshTTL:     loginCommand.RefreshTTL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return LoginUserResult{
+		UserID:         challenge.UserID(),
+		OrganizationID: challenge.OrganizationID(),
+		AccessToken:    accessToken,
+		RefreshToken:   refreshToken,
+	}, nil
+}
+
+// fail counts the wrong code toward the lockout, the challenge is removed after
+// the last attempt and the user has to log in with the password again.
+func (l LoginTwoFactor) fail(
+	ctx context.Context,
+	loginCommand LoginTwoFactorCommand,
+	challenge entity.Challenge,
+	attempts int64,
+) error {
+	err := l.lockout.fail(ctx, failedLogin{
+		email:          challenge.Email(),
+		userID:         challenge.UserID(),
+		ip:             loginCommand.IP,
+		accountLockout: loginCommand.AccountLockout,
+		ipLockout:      loginCommand.IPLockout,
+	})
+	if err != nil {
+		return err
+	}
+
+	if attempts < int64(loginCommand.MaxAttempts) {
+		return user_domain.ErrInvalidTwoFactorCode
+	}
+
+	return l.removeChallenge(ctx, challenge)
+}
+
+// removeChallenge drops the challenge answered too many times.
+func (l LoginTwoFactor) removeChallenge(ctx context.Context, challenge entity.Challenge) error {
+	l.logger.Warnf("Two-factor challenge of user %s failed too many times", challenge.UserID())
+
+	err := l.challengeStorage.Delete(ctx, challenge.ID())
+	if err != nil && !errors.Is(err, domain_core.ErrNotFound) {
+		return err
+	}
+
+	return entity.ErrChallengeFailed
+}
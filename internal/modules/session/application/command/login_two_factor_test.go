@@ -0,0 +1,249 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	org_domain "github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	user_domain "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+	"github.com/KyKyPy3/clean/pkg/hasher"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/totp"
+)
+
+type challengeStorageStub struct {
+	challenges map[common.UID]entity.Challenge
+	attempts   map[common.UID]int64
+}
+
+func newChallengeStorageStub() *challengeStorageStub {
+	return &challengeStorageStub{
+		challenges: map[common.UID]entity.Challenge{},
+		attempts:   map[common.UID]int64{},
+	}
+}
+
+func (s *challengeStorageStub) Set(_ context.Context, challenge entity.Challenge) error {
+	s.challenges[challenge.ID()] = challenge
+	return nil
+}
+
+func (s *challengeStorageStub) Get(_ context.Context, id common.UID) (entity.Challenge, error) {
+	challenge, ok := s.challenges[id]
+	if !ok {
+		return entity.Challenge{}, domain_core.ErrNotFound
+	}
+
+	return challenge, nil
+}
+
+func (s *challengeStorageStub) Attempt(_ context.Context, id common.UID) (int64, error) {
+	if _, ok := s.challenges[id]; !ok {
+		return 0, domain_core.ErrNotFound
+	}
+
+	s.attempts[id]++
+	return s.attempts[id], nil
+}
+
+func (s *challengeStorageStub) Delete(_ context.Context, id common.UID) error {
+	if _, ok := s.challenges[id]; !ok {
+		return domain_core.ErrNotFound
+	}
+
+	delete(s.challenges, id)
+	delete(s.attempts, id)
+	return nil
+}
+
+type twoFactorStorageStub struct {
+	twoFactor user_domain.TwoFactor
+}
+
+func (s *twoFactorStorageStub) GetByUser(_ context.Context, _ common.UID) (user_domain.TwoFactor, error) {
+	if s.twoFactor.IsEmpty() {
+		return user_domain.TwoFactor{}, domain_core.ErrNotFound
+	}
+
+	return s.twoFactor, nil
+}
+
+func (s *twoFactorStorageStub) Update(_ context.Context, twoFactor user_domain.TwoFactor) error {
+	s.twoFactor = twoFactor
+	return nil
+}
+
+func TestLoginTwoFactor(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	passwordHasher := hasher.New(hasher.Params{Memory: 1024, Iterations: 1, Parallelism: 1})
+	hash, err := passwordHasher.Hash("12345")
+	require.NoError(t, err)
+	user := user_domain.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		hash,
+		time.Now(),
+		time.Now(),
+	)
+	organization := org_domain.Hydrate(
+		common.NewUID(),
+		"Acme",
+		[]org_domain.Member{org_domain.HydrateMember(user.ID(), "Alise", org_domain.RoleMember, time.Now())},
+		time.Now(),
+		time.Now(),
+	)
+
+	// Enroll the authenticator app a period ago, so the current code is not used yet
+	otp := totp.New("clean")
+	secret, err := otp.GenerateSecret()
+	require.NoError(t, err)
+	twoFactor, err := user_domain.NewTwoFactor(user.ID(), secret)
+	require.NoError(t, err)
+	code, err := otp.Code(secret, time.Now().Add(-totp.Period))
+	require.NoError(t, err)
+	recoveryCodes, err := twoFactor.Enable(code, time.Now().Add(-totp.Period), otp)
+	require.NoError(t, err)
+
+	sessions := newSessionStorageStub()
+	challenges := newChallengeStorageStub()
+	attempts := newAttemptStorageStub()
+	twoFactors := &twoFactorStorageStub{twoFactor: user_domain.HydrateTwoFactor(
+		twoFactor.UserID(),
+		twoFactor.Secret(),
+		twoFactor.Enabled(),
+		twoFactor.RecoveryCodes(),
+		twoFactor.LastStep(),
+		twoFactor.CreatedAt(),
+		twoFactor.UpdatedAt(),
+	)}
+	events := &mediatorStub{}
+	accountKey := "account:" + user.Email().String()
+
+	login := command.NewLoginUser(
+		&userStorageStub{user: user},
+		&orgStorageStub{organization: organization},
+		twoFactors,
+		sessions,
+		challenges,
+		attempts,
+		&mediatorStub{},
+		passwordHasher,
+		log,
+	)
+	challenge := func() entity.Challenge {
+		res, loginErr := login.Handle(context.Background(), command.LoginUserCommand{
+			Email:        user.Email().String(),
+			Password:     "12345",
+			AccessTTL:    time.Minute,
+			RefreshTTL:   time.Hour,
+			ChallengeTTL: time.Minute,
+		})
+		require.NoError(t, loginErr)
+
+		result, ok := res.(command.LoginUserResult)
+		require.True(t, ok)
+		require.False(t, result.Challenge.IsEmpty())
+		assert.Equal(t, organization.ID(), result.Challenge.OrganizationID())
+		assert.True(t, result.AccessToken.ID().IsEmpty())
+
+		return result.Challenge
+	}
+
+	handler := command.NewLoginTwoFactor(twoFactors, sessions, challenges, attempts, otp, events, log)
+	answer := func(challenge entity.Challenge, code string) (any, error) {
+		return handler.Handle(context.Background(), command.LoginTwoFactorCommand{
+			ChallengeID: challenge.ID().String(),
+			Code:        code,
+			MaxAttempts: 2,
+			AccessTTL:   time.Minute,
+			RefreshTTL:  time.Hour,
+			AccountLockout: entity.LockoutPolicy{
+				Threshold: 3,
+				Window:    time.Minute,
+				Delay:     time.Minute,
+				MaxDelay:  time.Hour,
+			},
+		})
+	}
+
+	// The password alone opens no session
+	first := challenge()
+	assert.Empty(t, sessions.tokens)
+
+	// The challenge is revoked after the last wrong code
+	_, err = answer(first, "unknown-code")
+	require.ErrorIs(t, err, user_domain.ErrInvalidTwoFactorCode)
+	_, err = answer(first, "unknown-code")
+	require.ErrorIs(t, err, entity.ErrChallengeFailed)
+	_, err = answer(first, recoveryCodes[0])
+	require.ErrorIs(t, err, domain_core.ErrNotFound)
+
+	// The wrong codes count toward the lockout of the account, the password doesn't reset them
+	second := challenge()
+	assert.Equal(t, int64(2), attempts.failures[accountKey])
+
+	code, err = otp.Code(secret, time.Now())
+	require.NoError(t, err)
+	res, err := answer(second, code)
+	require.NoError(t, err)
+
+	result, ok := res.(command.LoginUserResult)
+	require.True(t, ok)
+	assert.Equal(t, user.ID(), result.UserID)
+	assert.Contains(t, sessions.tokens, result.AccessToken.ID())
+	assert.Contains(t, sessions.tokens, result.RefreshToken.ID())
+	assert.Contains(t, sessions.sessions, result.RefreshToken.FamilyID())
+	assert.NotContains(t, attempts.failures, accountKey)
+
+	// The challenge and the code are used once
+	_, err = answer(second, code)
+	require.ErrorIs(t, err, domain_core.ErrNotFound)
+	_, err = answer(challenge(), code)
+	require.ErrorIs(t, err, user_domain.ErrInvalidTwoFactorCode)
+
+	// Recovery codes replace the authenticator app
+	_, err = answer(challenge(), recoveryCodes[1])
+	require.NoError(t, err)
+	assert.Len(t, twoFactors.twoFactor.RecoveryCodes(), user_domain.RecoveryCodesCount-1)
+
+	require.Len(t, events.events, 1)
+	used, ok := events.events[0].(event.RecoveryCodeUsedEvent)
+	require.True(t, ok)
+	assert.Equal(t, user.ID().String(), used.ID)
+
+	// Guessing the codes with new challenges locks the account
+	third := challenge()
+	_, err = answer(third, "unknown-code")
+	require.ErrorIs(t, err, user_domain.ErrInvalidTwoFactorCode)
+	_, err = answer(third, "unknown-code")
+	require.ErrorIs(t, err, entity.ErrChallengeFailed)
+	_, err = answer(challenge(), "unknown-code")
+	var locked *entity.LockedError
+	require.ErrorAs(t, err, &locked)
+	assert.Equal(t, time.Minute, locked.RetryAfter)
+
+	_, err = login.Handle(context.Background(), command.LoginUserCommand{
+		Email:    user.Email().String(),
+		Password: "12345",
+	})
+	require.ErrorIs(t, err, entity.ErrLoginLocked)
+
+	require.Len(t, events.events, 2)
+	_, ok = events.events[1].(event.AccountLockedEvent)
+	assert.True(t, ok)
+}
@@ -54,6 +54,15 @@ func (l LogoutUser) Handle(ctx context.Context, command core.Command) (any, erro
 		return nil, err
 	}
 
+	// The refreshed tokens of the family are revoked together with the session
+	accessToken, err := l.sessionStorage.Get(ctx, accessTokenID)
+	if err == nil && !accessToken.FamilyID().IsEmpty() {
+		err = l.sessionStorage.DeleteFamily(ctx, accessToken.FamilyID())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = l.sessionStorage.Delete(ctx, accessTokenID)
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,21 +11,30 @@ import (
 	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
 	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
 	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/event"
 	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
 )
 
 const RefreshSessionKind = "RefreshSession"
 
+// RefreshSessionCommand replaces the refresh token with a new one of the same family
+// and issues a new access token.
 type RefreshSessionCommand struct {
-	ID         string
-	UserID     string
-	AccessTTL  time.Duration
-	RefreshTTL time.Duration
+	ID             string
+	UserID         string
+	OrganizationID string
+	UserAgent      string
+	IP             string
+	AccessTTL      time.Duration
+	RefreshTTL     time.Duration
 }
 
 type RefreshSessionResult struct {
-	UserID      common.UID
-	AccessToken entity.Token
+	UserID         common.UID
+	OrganizationID common.UID
+	AccessToken    entity.Token
+	RefreshToken   entity.Token
 }
 
 func (c RefreshSessionCommand) Type() core.CommandType {
@@ -36,17 +46,20 @@ var _ core.Command = (*RefreshSessionCommand)(nil)
 type RefreshSession struct {
 	userView       ports.UserPgStorage
 	sessionStorage ports.SessionRedisStorage
+	mediator       ports.Mediator
 	logger         logger.Logger
 }
 
 func NewRefreshSession(
 	userView ports.UserPgStorage,
 	sessionStorage ports.SessionRedisStorage,
+	mediator ports.Mediator,
 	logger logger.Logger,
 ) RefreshSession {
 	return RefreshSession{
 		userView:       userView,
 		sessionStorage: sessionStorage,
+		mediator:       mediator,
 		logger:         logger,
 	}
 }
@@ -57,12 +70,32 @@ func (l RefreshSession) Handle(ctx context.Context, command core.Command) (any,
 		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
 	}
 
+	tokenID, err := common.ParseUID(refreshCommand.ID)
+	if err != nil {
+		return nil, domain_core.ErrNotFound
+	}
+
 	userID, err := common.ParseUID(refreshCommand.UserID)
 	if err != nil {
 		return nil, domain_core.ErrNotFound
 	}
 
-	user, err := l.userView.GetByID(ctx, userID)
+	organizationID, err := common.ParseUID(refreshCommand.OrganizationID)
+	if err != nil {
+		return nil, domain_core.ErrNotFound
+	}
+
+	refreshToken, err := l.sessionStorage.Get(ctx, tokenID)
+	if err != nil {
+		return nil, l.checkReuse(ctx, tokenID, userID)
+	}
+
+	if refreshToken.UserID() != userID || refreshToken.OrganizationID() != organizationID {
+		return nil, domain_core.ErrNotFound
+	}
+
+	// Users removed from the organization are not found in its scope
+	user, err := l.userView.GetByID(tenant.WithOrganization(ctx, organizationID.String()), userID)
 	if err != nil {
 		return nil, err
 	}
@@ -72,16 +105,97 @@ func (l RefreshSession) Handle(ctx context.Context, command core.Command) (any,
 	}
 
 	now := time.Now().UTC()
-	accessExpiresIn := now.Add(refreshCommand.AccessTTL).Unix()
-	accessToken := entity.NewToken(user.ID(), accessExpiresIn)
+	nextToken := refreshToken.Rotate(now.Add(refreshCommand.RefreshTTL).Unix())
+	accessToken := entity.NewFamilyToken(
+		nextToken.FamilyID(),
+		user.ID(),
+		organizationID,
+		now.Add(refreshCommand.AccessTTL).Unix(),
+	)
+
+	// A concurrent refresh with the same token is a reuse as well
+	err = l.sessionStorage.Rotate(ctx, refreshToken, nextToken)
+	if errors.Is(err, entity.ErrRefreshTokenReused) {
+		return nil, l.revokeFamily(ctx, tokenID, nextToken.FamilyID(), userID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
 
 	err = l.sessionStorage.Set(ctx, accessToken.ID(), accessToken)
 	if err != nil {
 		return nil, err
 	}
 
+	err = l.touchSession(ctx, nextToken, refreshCommand)
+	if err != nil {
+		return nil, err
+	}
+
 	return RefreshSessionResult{
-		UserID:      user.ID(),
-		AccessToken: accessToken,
+		UserID:         user.ID(),
+		OrganizationID: organizationID,
+		AccessToken:    accessToken,
+		RefreshToken:   nextToken,
 	}, nil
 }
+
+// touchSession records the device of the refresh in the session of the family.
+// Families started before the sessions were recorded get one now.
+func (l RefreshSession) touchSession(ctx context.Context, token entity.Token, cmd RefreshSessionCommand) error {
+	session, err := l.sessionStorage.GetSession(ctx, token.FamilyID())
+	switch {
+	case errors.Is(err, domain_core.ErrNotFound):
+		session = entity.NewSession(
+			token.FamilyID(),
+			token.UserID(),
+			token.OrganizationID(),
+			cmd.UserAgent,
+			cmd.IP,
+			token.ExpiresIn(),
+		)
+	case err != nil:
+		return err
+	default:
+		session.Seen(cmd.UserAgent, cmd.IP, token.ExpiresIn())
+	}
+
+	return l.sessionStorage.SetSession(ctx, session)
+}
+
+// checkReuse tells apart refresh tokens which expired or were revoked from the
+// rotated ones, which revoke their family.
+func (l RefreshSession) checkReuse(ctx context.Context, tokenID, userID common.UID) error {
+	familyID, err := l.sessionStorage.RotatedFamily(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+
+	if familyID.IsEmpty() {
+		return domain_core.ErrNotFound
+	}
+
+	return l.revokeFamily(ctx, tokenID, familyID, userID)
+}
+
+// revokeFamily revokes all tokens of the family and records RefreshTokenReused.
+func (l RefreshSession) revokeFamily(ctx context.Context, tokenID, familyID, userID common.UID) error {
+	l.logger.Warnf("Refresh token %s of user %s reused, revoke token family %s", tokenID, userID, familyID)
+
+	err := l.sessionStorage.DeleteFamily(ctx, familyID)
+	if err != nil {
+		return err
+	}
+
+	err = l.mediator.Publish(ctx, event.RefreshTokenReusedEvent{
+		TokenID:  tokenID.String(),
+		FamilyID: familyID.String(),
+		UserID:   userID.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return entity.ErrRefreshTokenReused
+}
@@ -0,0 +1,290 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/event"
+	user_domain "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+type sessionStorageStub struct {
+	tokens   map[common.UID]entity.Token
+	rotated  map[common.UID]common.UID
+	sessions map[common.UID]entity.Session
+}
+
+func newSessionStorageStub() *sessionStorageStub {
+	return &sessionStorageStub{
+		tokens:   map[common.UID]entity.Token{},
+		rotated:  map[common.UID]common.UID{},
+		sessions: map[common.UID]entity.Session{},
+	}
+}
+
+func (s *sessionStorageStub) Get(_ context.Context, tokenID common.UID) (entity.Token, error) {
+	token, ok := s.tokens[tokenID]
+	if !ok {
+		return entity.Token{}, errors.New("session not found")
+	}
+
+	return token, nil
+}
+
+func (s *sessionStorageStub) Set(_ context.Context, tokenID common.UID, token entity.Token) error {
+	s.tokens[tokenID] = token
+	return nil
+}
+
+func (s *sessionStorageStub) Delete(_ context.Context, tokenID common.UID) error {
+	delete(s.tokens, tokenID)
+	return nil
+}
+
+func (s *sessionStorageStub) DeleteByUser(_ context.Context, userID common.UID) error {
+	for tokenID, token := range s.tokens {
+		if token.UserID() == userID {
+			delete(s.tokens, tokenID)
+		}
+	}
+
+	for sessionID, session := range s.sessions {
+		if session.UserID() == userID {
+			delete(s.sessions, sessionID)
+		}
+	}
+
+	return nil
+}
+
+func (s *sessionStorageStub) DeleteByUserExcept(_ context.Context, userID, familyID common.UID) error {
+	for tokenID, token := range s.tokens {
+		if token.UserID() == userID && token.FamilyID() != familyID {
+			delete(s.tokens, tokenID)
+		}
+	}
+
+	for sessionID, session := range s.sessions {
+		if session.UserID() == userID && sessionID != familyID {
+			delete(s.sessions, sessionID)
+		}
+	}
+
+	return nil
+}
+
+func (s *sessionStorageStub) Rotate(_ context.Context, previous, next entity.Token) error {
+	if _, ok := s.rotated[previous.ID()]; ok {
+		return entity.ErrRefreshTokenReused
+	}
+
+	s.rotated[previous.ID()] = next.FamilyID()
+	delete(s.tokens, previous.ID())
+	s.tokens[next.ID()] = next
+
+	return nil
+}
+
+func (s *sessionStorageStub) RotatedFamily(_ context.Context, tokenID common.UID) (common.UID, error) {
+	return s.rotated[tokenID], nil
+}
+
+func (s *sessionStorageStub) DeleteFamily(_ context.Context, familyID common.UID) error {
+	for tokenID, token := range s.tokens {
+		if token.FamilyID() == familyID {
+			delete(s.tokens, tokenID)
+		}
+	}
+	delete(s.sessions, familyID)
+
+	return nil
+}
+
+func (s *sessionStorageStub) SetSession(_ context.Context, session entity.Session) error {
+	s.sessions[session.ID()] = session
+	return nil
+}
+
+func (s *sessionStorageStub) GetSession(_ context.Context, id common.UID) (entity.Session, error) {
+	session, ok := s.sessions[id]
+	if !ok {
+		return entity.Session{}, domain_core.ErrNotFound
+	}
+
+	return session, nil
+}
+
+func (s *sessionStorageStub) FetchSessions(_ context.Context, userID common.UID) ([]entity.Session, error) {
+	var sessions []entity.Session
+	for _, session := range s.sessions {
+		if session.UserID() == userID {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions, nil
+}
+
+type userStorageStub struct {
+	user user_domain.User
+}
+
+func (u *userStorageStub) Fetch(_ context.Context, _, _ int64) ([]user_domain.User, error) {
+	return []user_domain.User{u.user}, nil
+}
+
+func (u *userStorageStub) GetByEmail(_ context.Context, email common.Email) (user_domain.User, error) {
+	if email != u.user.Email() {
+		return user_domain.User{}, nil
+	}
+
+	return u.user, nil
+}
+
+func (u *userStorageStub) GetByID(_ context.Context, _ common.UID) (user_domain.User, error) {
+	return u.user, nil
+}
+
+func (u *userStorageStub) UpdatePassword(_ context.Context, _ user_domain.User) error {
+	return nil
+}
+
+type mediatorStub struct {
+	events []mediator.Event
+}
+
+func (m *mediatorStub) Publish(_ context.Context, events ...mediator.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
+func TestRefreshSessionRotation(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	user := user_domain.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		"hash",
+		time.Now(),
+		time.Now(),
+	)
+	orgID := common.NewUID()
+	familyID := common.NewUID()
+	expiresIn := time.Now().Add(time.Hour).Unix()
+
+	storage := newSessionStorageStub()
+	require.NoError(t, storage.SetSession(
+		context.Background(),
+		entity.NewSession(familyID, user.ID(), orgID, "Firefox", "10.0.0.1", expiresIn),
+	))
+	accessToken := entity.NewFamilyToken(familyID, user.ID(), orgID, expiresIn)
+	refreshToken := entity.NewFamilyToken(familyID, user.ID(), orgID, expiresIn)
+	require.NoError(t, storage.Set(context.Background(), accessToken.ID(), accessToken))
+	require.NoError(t, storage.Set(context.Background(), refreshToken.ID(), refreshToken))
+
+	events := &mediatorStub{}
+	handler := command.NewRefreshSession(&userStorageStub{user: user}, storage, events, log)
+	refresh := func(token entity.Token) (any, error) {
+		return handler.Handle(context.Background(), command.RefreshSessionCommand{
+			ID:             token.ID().String(),
+			UserID:         user.ID().String(),
+			OrganizationID: orgID.String(),
+			UserAgent:      "Chrome",
+			IP:             "10.0.0.2",
+			AccessTTL:      time.Minute,
+			RefreshTTL:     time.Hour,
+		})
+	}
+
+	// Every refresh issues a new refresh token of the same family
+	res, err := refresh(refreshToken)
+	require.NoError(t, err)
+	result, ok := res.(command.RefreshSessionResult)
+	require.True(t, ok)
+	assert.NotEqual(t, refreshToken.ID(), result.RefreshToken.ID())
+	assert.Equal(t, familyID, result.RefreshToken.FamilyID())
+	assert.Equal(t, familyID, result.AccessToken.FamilyID())
+	assert.NotContains(t, storage.tokens, refreshToken.ID())
+	assert.Contains(t, storage.tokens, result.RefreshToken.ID())
+
+	// The refresh is recorded in the session of the family
+	session := storage.sessions[familyID]
+	assert.Equal(t, "Chrome", session.UserAgent())
+	assert.Equal(t, "10.0.0.2", session.IP())
+	assert.Equal(t, result.RefreshToken.ExpiresIn(), session.ExpiresIn())
+
+	// Unknown tokens are rejected without revoking anything
+	_, err = refresh(entity.NewFamilyToken(familyID, user.ID(), orgID, expiresIn))
+	require.ErrorIs(t, err, domain_core.ErrNotFound)
+	assert.Len(t, storage.tokens, 3)
+
+	// The rotated token revokes the whole family
+	_, err = refresh(refreshToken)
+	require.ErrorIs(t, err, entity.ErrRefreshTokenReused)
+	assert.Empty(t, storage.tokens)
+	assert.Empty(t, storage.sessions)
+
+	require.Len(t, events.events, 1)
+	reused, ok := events.events[0].(event.RefreshTokenReusedEvent)
+	require.True(t, ok)
+	assert.Equal(t, refreshToken.ID().String(), reused.TokenID)
+	assert.Equal(t, familyID.String(), reused.FamilyID)
+	assert.Equal(t, user.ID().String(), reused.UserID)
+
+	// The new token of the revoked family does not work any more
+	_, err = refresh(result.RefreshToken)
+	require.ErrorIs(t, err, domain_core.ErrNotFound)
+}
+
+func TestRefreshSessionLegacyToken(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	user := user_domain.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		"hash",
+		time.Now(),
+		time.Now(),
+	)
+	orgID := common.NewUID()
+
+	// Tokens issued before the rotation have no family and start one
+	storage := newSessionStorageStub()
+	refreshToken := entity.NewToken(user.ID(), orgID, time.Now().Add(time.Hour).Unix())
+	require.NoError(t, storage.Set(context.Background(), refreshToken.ID(), refreshToken))
+
+	handler := command.NewRefreshSession(&userStorageStub{user: user}, storage, &mediatorStub{}, log)
+	res, err := handler.Handle(context.Background(), command.RefreshSessionCommand{
+		ID:             refreshToken.ID().String(),
+		UserID:         user.ID().String(),
+		OrganizationID: orgID.String(),
+		AccessTTL:      time.Minute,
+		RefreshTTL:     time.Hour,
+	})
+	require.NoError(t, err)
+
+	result, ok := res.(command.RefreshSessionResult)
+	require.True(t, ok)
+	assert.Equal(t, refreshToken.ID(), result.RefreshToken.FamilyID())
+	assert.Contains(t, storage.sessions, refreshToken.ID())
+}
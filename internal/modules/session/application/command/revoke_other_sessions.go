@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const RevokeOtherSessionsKind = "RevokeOtherSessions"
+
+// RevokeOtherSessionsCommand logs the user out on all devices except the one
+// of the current session, the session is the token family of the device.
+type RevokeOtherSessionsCommand struct {
+	UserID           string
+	CurrentSessionID string
+}
+
+func (c RevokeOtherSessionsCommand) Type() core.CommandType {
+	return RevokeOtherSessionsKind
+}
+
+var _ core.Command = (*RevokeOtherSessionsCommand)(nil)
+
+type RevokeOtherSessions struct {
+	sessionStorage ports.SessionRedisStorage
+	logger         logger.Logger
+}
+
+func NewRevokeOtherSessions(
+	sessionStorage ports.SessionRedisStorage,
+	logger logger.Logger,
+) RevokeOtherSessions {
+	return RevokeOtherSessions{
+		sessionStorage: sessionStorage,
+		logger:         logger,
+	}
+}
+
+func (r RevokeOtherSessions) Handle(ctx context.Context, command core.Command) (any, error) {
+	revokeCommand, ok := command.(RevokeOtherSessionsCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	userID, err := common.ParseUID(revokeCommand.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentSessionID, err := common.ParseUID(revokeCommand.CurrentSessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens issued before the sessions were tracked have no family and are revoked too
+	err = r.sessionStorage.DeleteByUserExcept(ctx, userID, currentSessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.logger.Debugf("Revoked other sessions of user %s", userID)
+
+	var res interface{}
+	return res, nil
+}
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const RevokeSessionKind = "RevokeSession"
+
+// RevokeSessionCommand logs the user out on the device of the session.
+type RevokeSessionCommand struct {
+	UserID    string
+	SessionID string
+}
+
+func (c RevokeSessionCommand) Type() core.CommandType {
+	return RevokeSessionKind
+}
+
+var _ core.Command = (*RevokeSessionCommand)(nil)
+
+type RevokeSession struct {
+	sessionStorage ports.SessionRedisStorage
+	logger         logger.Logger
+}
+
+func NewRevokeSession(
+	sessionStorage ports.SessionRedisStorage,
+	logger logger.Logger,
+) RevokeSession {
+	return RevokeSession{
+		sessionStorage: sessionStorage,
+		logger:         logger,
+	}
+}
+
+func (r RevokeSession) Handle(ctx context.Context, command core.Command) (any, error) {
+	revokeCommand, ok := command.(RevokeSessionCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	userID, err := common.ParseUID(revokeCommand.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := common.ParseUID(revokeCommand.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := r.sessionStorage.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sessions of other users are not found
+	if session.UserID() != userID {
+		return nil, domain_core.ErrNotFound
+	}
+
+	err = r.sessionStorage.DeleteFamily(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
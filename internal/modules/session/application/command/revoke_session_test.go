@@ -0,0 +1,160 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	org_domain "github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+type orgStorageStub struct {
+	organization org_domain.Organization
+}
+
+func (o *orgStorageStub) FetchByUser(_ context.Context, _ common.UID, _, _ int64) ([]org_domain.Organization, error) {
+	return []org_domain.Organization{o.organization}, nil
+}
+
+func (o *orgStorageStub) GetByID(_ context.Context, _ common.UID) (org_domain.Organization, error) {
+	return o.organization, nil
+}
+
+// login stores the tokens and the session of a new login of the user.
+func login(t *testing.T, storage *sessionStorageStub, userID, orgID common.UID) entity.Session {
+	t.Helper()
+
+	expiresIn := time.Now().Add(time.Hour).Unix()
+	familyID := common.NewUID()
+	for range 2 {
+		token := entity.NewFamilyToken(familyID, userID, orgID, expiresIn)
+		require.NoError(t, storage.Set(context.Background(), token.ID(), token))
+	}
+
+	session := entity.NewSession(familyID, userID, orgID, "Firefox", "10.0.0.1", expiresIn)
+	require.NoError(t, storage.SetSession(context.Background(), session))
+
+	return session
+}
+
+func TestRevokeSession(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	userID := common.NewUID()
+	orgID := common.NewUID()
+	storage := newSessionStorageStub()
+	current := login(t, storage, userID, orgID)
+	other := login(t, storage, userID, orgID)
+	foreign := login(t, storage, common.NewUID(), orgID)
+
+	handler := command.NewRevokeSession(storage, log)
+	revoke := func(session entity.Session) error {
+		_, err := handler.Handle(context.Background(), command.RevokeSessionCommand{
+			UserID:    userID.String(),
+			SessionID: session.ID().String(),
+		})
+
+		return err
+	}
+
+	// Sessions of other users are not revoked
+	require.ErrorIs(t, revoke(foreign), domain_core.ErrNotFound)
+	assert.Contains(t, storage.sessions, foreign.ID())
+
+	require.NoError(t, revoke(other))
+	assert.NotContains(t, storage.sessions, other.ID())
+	assert.Contains(t, storage.sessions, current.ID())
+	assert.Len(t, storage.tokens, 4)
+
+	require.ErrorIs(t, revoke(other), domain_core.ErrNotFound)
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	userID := common.NewUID()
+	orgID := common.NewUID()
+	storage := newSessionStorageStub()
+	current := login(t, storage, userID, orgID)
+	login(t, storage, userID, orgID)
+	login(t, storage, userID, orgID)
+	foreign := login(t, storage, common.NewUID(), orgID)
+
+	// Tokens issued before the sessions were tracked belong to no family
+	legacy := entity.NewToken(userID, orgID, time.Now().Add(time.Hour).Unix())
+	require.NoError(t, storage.Set(context.Background(), legacy.ID(), legacy))
+
+	handler := command.NewRevokeOtherSessions(storage, log)
+	_, err := handler.Handle(context.Background(), command.RevokeOtherSessionsCommand{
+		UserID:           userID.String(),
+		CurrentSessionID: current.ID().String(),
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, storage.sessions, 2)
+	assert.Contains(t, storage.sessions, current.ID())
+	assert.Contains(t, storage.sessions, foreign.ID())
+	assert.Len(t, storage.tokens, 4)
+	assert.NotContains(t, storage.tokens, legacy.ID())
+}
+
+func TestRevokeUserSessions(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	adminID := common.NewUID()
+	memberID := common.NewUID()
+	orgID := common.NewUID()
+	organization := org_domain.Hydrate(
+		orgID,
+		"Acme",
+		[]org_domain.Member{
+			org_domain.HydrateMember(adminID, "Admin", org_domain.RoleAdmin, time.Now()),
+			org_domain.HydrateMember(memberID, "Member", org_domain.RoleMember, time.Now()),
+		},
+		time.Now(),
+		time.Now(),
+	)
+
+	storage := newSessionStorageStub()
+	login(t, storage, memberID, orgID)
+	login(t, storage, memberID, orgID)
+	admin := login(t, storage, adminID, orgID)
+
+	handler := command.NewRevokeUserSessions(&orgStorageStub{organization: organization}, storage, log)
+	revoke := func(actorID, userID common.UID) error {
+		_, err := handler.Handle(context.Background(), command.RevokeUserSessionsCommand{
+			ActorID:        actorID.String(),
+			UserID:         userID.String(),
+			OrganizationID: orgID.String(),
+		})
+
+		return err
+	}
+
+	// Members can't revoke the sessions of others
+	require.ErrorIs(t, revoke(memberID, adminID), org_domain.ErrPermissionDenied)
+	require.ErrorIs(t, revoke(adminID, common.NewUID()), org_domain.ErrNotOrganizationMember)
+	assert.Len(t, storage.sessions, 3)
+
+	require.NoError(t, revoke(adminID, memberID))
+	assert.Len(t, storage.sessions, 1)
+	assert.Contains(t, storage.sessions, admin.ID())
+	assert.Len(t, storage.tokens, 2)
+}
@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	org_domain "github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const RevokeUserSessionsKind = "RevokeUserSessions"
+
+// RevokeUserSessionsCommand logs a member of the organization out on all devices.
+// Only owners and admins of the organization may revoke the sessions of members.
+type RevokeUserSessionsCommand struct {
+	ActorID        string
+	UserID         string
+	OrganizationID string
+}
+
+func (c RevokeUserSessionsCommand) Type() core.CommandType {
+	return RevokeUserSessionsKind
+}
+
+var _ core.Command = (*RevokeUserSessionsCommand)(nil)
+
+type RevokeUserSessions struct {
+	orgView        ports.OrganizationPgStorage
+	sessionStorage ports.SessionRedisStorage
+	logger         logger.Logger
+}
+
+func NewRevokeUserSessions(
+	orgView ports.OrganizationPgStorage,
+	sessionStorage ports.SessionRedisStorage,
+	logger logger.Logger,
+) RevokeUserSessions {
+	return RevokeUserSessions{
+		orgView:        orgView,
+		sessionStorage: sessionStorage,
+		logger:         logger,
+	}
+}
+
+func (r RevokeUserSessions) Handle(ctx context.Context, command core.Command) (any, error) {
+	revokeCommand, ok := command.(RevokeUserSessionsCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	actorID, err := common.ParseUID(revokeCommand.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := common.ParseUID(revokeCommand.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID, err := common.ParseUID(revokeCommand.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := r.orgView.GetByID(ctx, organizationID)
+	if errors.Is(err, domain_core.ErrNotFound) {
+		return nil, org_domain.ErrPermissionDenied
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	actor, ok := organization.Member(actorID)
+	if !ok || !actor.Role().CanManageMembers() {
+		return nil, org_domain.ErrPermissionDenied
+	}
+
+	if !organization.IsMember(userID) {
+		return nil, org_domain.ErrNotOrganizationMember
+	}
+
+	err = r.sessionStorage.DeleteByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.logger.Infof("Sessions of user %s revoked by %s", userID, actorID)
+
+	var res interface{}
+	return res, nil
+}
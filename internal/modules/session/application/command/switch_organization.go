@@ -0,0 +1,134 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+const SwitchOrganizationKind = "SwitchOrganization"
+
+// SwitchOrganizationCommand replaces the current session with a new one
+// opened in another organization of the user.
+type SwitchOrganizationCommand struct {
+	UserID         string
+	OrganizationID string
+	AccessTokenID  string
+	RefreshTokenID string
+	UserAgent      string
+	IP             string
+	AccessTTL      time.Duration
+	RefreshTTL     time.Duration
+}
+
+type SwitchOrganizationResult struct {
+	UserID         common.UID
+	OrganizationID common.UID
+	AccessToken    entity.Token
+	RefreshToken   entity.Token
+}
+
+func (c SwitchOrganizationCommand) Type() core.CommandType {
+	return SwitchOrganizationKind
+}
+
+var _ core.Command = (*SwitchOrganizationCommand)(nil)
+
+type SwitchOrganization struct {
+	orgView        ports.OrganizationPgStorage
+	sessionStorage ports.SessionRedisStorage
+	logger         logger.Logger
+}
+
+func NewSwitchOrganization(
+	orgView ports.OrganizationPgStorage,
+	sessionStorage ports.SessionRedisStorage,
+	logger logger.Logger,
+) SwitchOrganization {
+	return SwitchOrganization{
+		orgView:        orgView,
+		sessionStorage: sessionStorage,
+		logger:         logger,
+	}
+}
+
+func (s SwitchOrganization) Handle(ctx context.Context, command core.Command) (any, error) {
+	switchCommand, ok := command.(SwitchOrganizationCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	userID, err := common.ParseUID(switchCommand.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessTokenID, err := common.ParseUID(switchCommand.AccessTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The target organization is outside the scope of the current session
+	organizationID, err := memberOrganization(
+		tenant.Unscoped(ctx),
+		s.orgView,
+		userID,
+		switchCommand.OrganizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, refreshToken, err := openSession(ctx, s.sessionStorage, sessionParams{
+		userID:         userID,
+		organizationID: organizationID,
+		userAgent:      switchCommand.UserAgent,
+		ip:             switchCommand.IP,
+		accessTTL:      switchCommand.AccessTTL,
+		refreshTTL:     switchCommand.RefreshTTL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The whole family of the current session is revoked
+	current, err := s.sessionStorage.Get(ctx, accessTokenID)
+	if err == nil && !current.FamilyID().IsEmpty() {
+		err = s.sessionStorage.DeleteFamily(ctx, current.FamilyID())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.sessionStorage.Delete(ctx, accessTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if switchCommand.RefreshTokenID != "" {
+		var refreshTokenID common.UID
+		refreshTokenID, err = common.ParseUID(switchCommand.RefreshTokenID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.sessionStorage.Delete(ctx, refreshTokenID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return SwitchOrganizationResult{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		AccessToken:    accessToken,
+		RefreshToken:   refreshToken,
+	}, nil
+}
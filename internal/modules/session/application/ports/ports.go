@@ -2,20 +2,96 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/KyKyPy3/clean/internal/domain/common"
+	game_domain "github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	org_domain "github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
 	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
 	user_domain "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/mediator"
 )
 
+type Mediator interface {
+	Publish(ctx context.Context, events ...mediator.Event) error
+}
+
 type UserPgStorage interface {
 	Fetch(ctx context.Context, limit, offset int64) ([]user_domain.User, error)
 	GetByEmail(ctx context.Context, email common.Email) (user_domain.User, error)
 	GetByID(ctx context.Context, id common.UID) (user_domain.User, error)
+	UpdatePassword(ctx context.Context, data user_domain.User) error
+}
+
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Verify(hash, password string) error
+	NeedsRehash(hash string) bool
+}
+
+// TwoFactorPgStorage provides the authenticator apps of the users.
+type TwoFactorPgStorage interface {
+	GetByUser(ctx context.Context, userID common.UID) (user_domain.TwoFactor, error)
+	Update(ctx context.Context, twoFactor user_domain.TwoFactor) error
+}
+
+type OneTimePassword interface {
+	Verify(secret, code string, now time.Time) (int64, error)
+}
+
+type GamePgStorage interface {
+	GetByID(ctx context.Context, id common.UID) (game_domain.Game, error)
+	GetJoinLink(ctx context.Context, code string) (game_domain.JoinLink, error)
+}
+
+type OrganizationPgStorage interface {
+	FetchByUser(ctx context.Context, userID common.UID, limit, offset int64) ([]org_domain.Organization, error)
+	GetByID(ctx context.Context, id common.UID) (org_domain.Organization, error)
 }
 
 type SessionRedisStorage interface {
 	Get(ctx context.Context, tokenID common.UID) (entity.Token, error)
 	Set(ctx context.Context, tokenID common.UID, token entity.Token) error
 	Delete(ctx context.Context, tokenID common.UID) error
+	// DeleteByUser revokes all sessions of the user.
+	DeleteByUser(ctx context.Context, userID common.UID) error
+	// DeleteByUserExcept revokes all sessions of the user except the token family,
+	// the tokens issued without a family included.
+	DeleteByUserExcept(ctx context.Context, userID, familyID common.UID) error
+	// Rotate replaces the refresh token with the next one of its family. It fails with
+	// entity.ErrRefreshTokenReused when the token was already rotated.
+	Rotate(ctx context.Context, previous, next entity.Token) error
+	// RotatedFamily returns the family of a rotated refresh token, the id is empty
+	// for tokens which were never rotated.
+	RotatedFamily(ctx context.Context, tokenID common.UID) (common.UID, error)
+	// DeleteFamily revokes all tokens of the family together with its session.
+	DeleteFamily(ctx context.Context, familyID common.UID) error
+	// SetSession stores the device of the token family in the session index of the user.
+	SetSession(ctx context.Context, session entity.Session) error
+	GetSession(ctx context.Context, id common.UID) (entity.Session, error)
+	// FetchSessions returns the active sessions of the user.
+	FetchSessions(ctx context.Context, userID common.UID) ([]entity.Session, error)
+}
+
+// ChallengeRedisStorage keeps the logins waiting for the two-factor code.
+type ChallengeRedisStorage interface {
+	Set(ctx context.Context, challenge entity.Challenge) error
+	Get(ctx context.Context, id common.UID) (entity.Challenge, error)
+	// Attempt counts an answer of the challenge and returns the answers so far, it fails
+	// with core.ErrNotFound when the challenge is removed.
+	Attempt(ctx context.Context, id common.UID) (int64, error)
+	// Delete fails with core.ErrNotFound when the challenge is already removed.
+	Delete(ctx context.Context, id common.UID) error
+}
+
+// LoginAttemptRedisStorage counts the failed logins of the accounts and IP addresses.
+type LoginAttemptRedisStorage interface {
+	// Fail counts a failure of the key and returns the failures within the window.
+	Fail(ctx context.Context, key string, window time.Duration) (int64, error)
+	// Lock rejects the logins of the key for the duration.
+	Lock(ctx context.Context, key string, duration time.Duration) error
+	// LockedFor returns the remaining lockout of the key, it is zero when the key is not locked.
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+	// Reset forgets the failures of the key.
+	Reset(ctx context.Context, key string) error
 }
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const FetchSessionsKind = "FetchSessions"
+
+// FetchSessionsQuery lists the active sessions of the user.
+type FetchSessionsQuery struct {
+	UserID string
+}
+
+func (f FetchSessionsQuery) Type() core.QueryType {
+	return FetchSessionsKind
+}
+
+var _ core.Query = (*FetchSessionsQuery)(nil)
+
+type FetchSessions struct {
+	sessionStorage ports.SessionRedisStorage
+	logger         logger.Logger
+}
+
+func NewFetchSessions(
+	sessionStorage ports.SessionRedisStorage,
+	logger logger.Logger,
+) FetchSessions {
+	return FetchSessions{
+		sessionStorage: sessionStorage,
+		logger:         logger,
+	}
+}
+
+func (f FetchSessions) Handle(ctx context.Context, query core.Query) (any, error) {
+	fetchQuery, ok := query.(FetchSessionsQuery)
+	if !ok {
+		return nil, fmt.Errorf("query type %s: %w", query.Type(), core.ErrUnexpectedQuery)
+	}
+
+	userID, err := common.ParseUID(fetchQuery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := f.sessionStorage.FetchSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+// ErrChallengeFailed is returned when the challenge is answered too many times.
+var ErrChallengeFailed = errors.New("two-factor challenge failed")
+
+// Challenge is a login waiting for the two-factor code. The password of the
+// user is already verified, the tokens are issued once the code is checked.
+// The email is the account the wrong codes are counted for by the lockout.
+type Challenge struct {
+	id             common.UID
+	userID         common.UID
+	organizationID common.UID
+	email          common.Email
+	expiresIn      int64
+}
+
+func NewChallenge(userID, organizationID common.UID, email common.Email, expiresIn int64) Challenge {
+	return Challenge{
+		id:             common.NewUID(),
+		userID:         userID,
+		organizationID: organizationID,
+		email:          email,
+		expiresIn:      expiresIn,
+	}
+}
+
+func HydrateChallenge(id, userID, organizationID common.UID, email common.Email, expiresIn int64) Challenge {
+	return Challenge{
+		id:             id,
+		userID:         userID,
+		organizationID: organizationID,
+		email:          email,
+		expiresIn:      expiresIn,
+	}
+}
+
+func (c *Challenge) ID() common.UID {
+	return c.id
+}
+
+func (c *Challenge) UserID() common.UID {
+	return c.userID
+}
+
+func (c *Challenge) OrganizationID() common.UID {
+	return c.organizationID
+}
+
+func (c *Challenge) Email() common.Email {
+	return c.email
+}
+
+func (c *Challenge) ExpiresIn() int64 {
+	return c.expiresIn
+}
+
+func (c *Challenge) IsEmpty() bool {
+	return *c == Challenge{}
+}
+
+func (c *Challenge) String() string {
+	return fmt.Sprintf("Challenge{ID: %s, UserID: %s, OrganizationID: %s}", c.ID(), c.UserID(), c.OrganizationID())
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+const maxGuestNameLength = 64
+
+var ErrInvalidGuestName = errors.New("guest name must be between 1 and 64 characters")
+
+// Guest is an anonymous spectator of a single game. Guests have no account,
+// they are identified only by the id issued together with their session.
+type Guest struct {
+	id     common.UID
+	gameID common.UID
+	name   string
+}
+
+func NewGuest(gameID common.UID, name string) (Guest, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxGuestNameLength {
+		return Guest{}, ErrInvalidGuestName
+	}
+
+	return Guest{
+		id:     common.NewUID(),
+		gameID: gameID,
+		name:   name,
+	}, nil
+}
+
+func (g *Guest) ID() common.UID {
+	return g.id
+}
+
+func (g *Guest) GameID() common.UID {
+	return g.gameID
+}
+
+func (g *Guest) Name() string {
+	return g.name
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrLoginLocked is returned while the logins of the account or the IP address are locked.
+var ErrLoginLocked = errors.New("too many failed logins")
+
+// LockoutPolicy throttles the failed logins of an account or an IP address. The
+// failures are forgotten Window after the latest one. From Threshold failures on
+// every failure locks the logins for Delay, doubled by each further failure up to MaxDelay.
+type LockoutPolicy struct {
+	Threshold int64
+	Window    time.Duration
+	Delay     time.Duration
+	MaxDelay  time.Duration
+}
+
+// Enabled reports whether the failures are counted at all.
+func (p LockoutPolicy) Enabled() bool {
+	return p.Threshold > 0 && p.Window > 0 && p.Delay > 0 && p.MaxDelay >= p.Delay
+}
+
+// Backoff returns the lockout after the given number of failures, it is zero below the threshold.
+func (p LockoutPolicy) Backoff(failures int64) time.Duration {
+	if !p.Enabled() || failures < p.Threshold {
+		return 0
+	}
+
+	delay := p.Delay
+	for i := p.Threshold; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, p.MaxDelay)
+}
+
+// LockedError rejects the login until RetryAfter passes.
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrLoginLocked, e.RetryAfter)
+}
+
+func (e *LockedError) Unwrap() error {
+	return ErrLoginLocked
+}
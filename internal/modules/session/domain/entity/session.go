@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+const maxUserAgentLength = 256
+
+// Session is a login of the user on one device. It is identified by the token
+// family of the login and lives as long as the latest refresh token of the family.
+type Session struct {
+	id             common.UID
+	userID         common.UID
+	organizationID common.UID
+	userAgent      string
+	ip             string
+	createdAt      time.Time
+	lastSeenAt     time.Time
+	expiresIn      int64
+}
+
+// NewSession records the device of the login which started the token family.
+func NewSession(familyID, userID, organizationID common.UID, userAgent, ip string, expiresIn int64) Session {
+	now := time.Now().UTC()
+
+	return Session{
+		id:             familyID,
+		userID:         userID,
+		organizationID: organizationID,
+		userAgent:      truncate(userAgent),
+		ip:             ip,
+		createdAt:      now,
+		lastSeenAt:     now,
+		expiresIn:      expiresIn,
+	}
+}
+
+func HydrateSession(
+	id, userID, organizationID common.UID,
+	userAgent, ip string,
+	createdAt, lastSeenAt time.Time,
+	expiresIn int64,
+) Session {
+	return Session{
+		id:             id,
+		userID:         userID,
+		organizationID: organizationID,
+		userAgent:      userAgent,
+		ip:             ip,
+		createdAt:      createdAt,
+		lastSeenAt:     lastSeenAt,
+		expiresIn:      expiresIn,
+	}
+}
+
+// Seen records the device of the latest refresh of the session.
+func (s *Session) Seen(userAgent, ip string, expiresIn int64) {
+	s.userAgent = truncate(userAgent)
+	s.ip = ip
+	s.lastSeenAt = time.Now().UTC()
+	s.expiresIn = expiresIn
+}
+
+func (s *Session) ID() common.UID {
+	return s.id
+}
+
+func (s *Session) UserID() common.UID {
+	return s.userID
+}
+
+func (s *Session) OrganizationID() common.UID {
+	return s.organizationID
+}
+
+func (s *Session) UserAgent() string {
+	return s.userAgent
+}
+
+func (s *Session) IP() string {
+	return s.ip
+}
+
+func (s *Session) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Session) LastSeenAt() time.Time {
+	return s.lastSeenAt
+}
+
+func (s *Session) ExpiresIn() int64 {
+	return s.expiresIn
+}
+
+func (s *Session) IsEmpty() bool {
+	return *s == Session{}
+}
+
+func (s *Session) String() string {
+	return fmt.Sprintf(
+		"Session{ID: %s, UserID: %s, OrganizationID: %s, UserAgent: %s, IP: %s}",
+		s.ID(),
+		s.UserID(),
+		s.OrganizationID(),
+		s.UserAgent(),
+		s.IP(),
+	)
+}
+
+// truncate bounds the user agent sent by the client.
+func truncate(userAgent string) string {
+	if utf8.RuneCountInString(userAgent) <= maxUserAgentLength {
+		return userAgent
+	}
+
+	return string([]rune(userAgent)[:maxUserAgentLength])
+}
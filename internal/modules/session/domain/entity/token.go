@@ -1,30 +1,49 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/KyKyPy3/clean/internal/domain/common"
 )
 
+// ErrRefreshTokenReused is returned when a refresh token is presented after it was rotated.
+var ErrRefreshTokenReused = errors.New("refresh token reused")
+
+// Token is a session of a user within one organization. The tokens issued by one
+// login and all its refreshes belong to the same family.
 type Token struct {
-	id        common.UID
-	userID    common.UID
-	expiresIn int64
+	id             common.UID
+	familyID       common.UID
+	userID         common.UID
+	organizationID common.UID
+	expiresIn      int64
 }
 
-func NewToken(userID common.UID, expiresIn int64) Token {
+func NewToken(userID, organizationID common.UID, expiresIn int64) Token {
 	return Token{
-		id:        common.NewUID(),
-		userID:    userID,
-		expiresIn: expiresIn,
+		id:             common.NewUID(),
+		userID:         userID,
+		organizationID: organizationID,
+		expiresIn:      expiresIn,
 	}
 }
 
-func Hydrate(tokenID, userID common.UID, expiresIn int64) Token {
+// NewFamilyToken creates a token of the family, a login starts a new family.
+func NewFamilyToken(familyID, userID, organizationID common.UID, expiresIn int64) Token {
+	token := NewToken(userID, organizationID, expiresIn)
+	token.familyID = familyID
+
+	return token
+}
+
+func Hydrate(tokenID, familyID, userID, organizationID common.UID, expiresIn int64) Token {
 	return Token{
-		id:        tokenID,
-		userID:    userID,
-		expiresIn: expiresIn,
+		id:             tokenID,
+		familyID:       familyID,
+		userID:         userID,
+		organizationID: organizationID,
+		expiresIn:      expiresIn,
 	}
 }
 
@@ -32,10 +51,31 @@ func (t *Token) ID() common.UID {
 	return t.id
 }
 
+// FamilyID returns the family of the token, sessions issued before the refresh
+// token rotation have no family.
+func (t *Token) FamilyID() common.UID {
+	return t.familyID
+}
+
+// Rotate creates the refresh token which replaces this one in the family. Tokens
+// without a family start one.
+func (t *Token) Rotate(expiresIn int64) Token {
+	familyID := t.familyID
+	if familyID.IsEmpty() {
+		familyID = t.id
+	}
+
+	return NewFamilyToken(familyID, t.userID, t.organizationID, expiresIn)
+}
+
 func (t *Token) UserID() common.UID {
 	return t.userID
 }
 
+func (t *Token) OrganizationID() common.UID {
+	return t.organizationID
+}
+
 func (t *Token) ExpiresIn() int64 {
 	return t.expiresIn
 }
@@ -46,9 +86,11 @@ func (t *Token) IsEmpty() bool {
 
 func (t *Token) String() string {
 	return fmt.Sprintf(
-		"Token{ID: %s, UserID: %s, ExpiresIn: %d}",
+		"Token{ID: %s, FamilyID: %s, UserID: %s, OrganizationID: %s, ExpiresIn: %d}",
 		t.ID(),
+		t.FamilyID(),
 		t.UserID(),
+		t.OrganizationID(),
 		t.ExpiresIn(),
 	)
 }
@@ -0,0 +1,15 @@
+package event
+
+const RefreshTokenReused = "RefreshTokenReused"
+
+// RefreshTokenReusedEvent is recorded when a rotated refresh token is presented
+// once more. The token was likely stolen, so the whole family is revoked.
+type RefreshTokenReusedEvent struct {
+	TokenID  string
+	FamilyID string
+	UserID   string
+}
+
+func (e RefreshTokenReusedEvent) Kind() string {
+	return RefreshTokenReused
+}
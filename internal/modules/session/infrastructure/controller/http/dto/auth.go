@@ -1,6 +1,50 @@
 package dto
 
+import (
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+)
+
 type LoginDTO struct {
-	Email    string `json:"email" validate:"required"`
-	Password string `json:"password" validate:"required"`
+	Email          string `json:"email" validate:"required"`
+	Password       string `json:"password" validate:"required"`
+	OrganizationID string `json:"organizationId"`
+}
+
+// LoginTwoFactorDTO answers the login challenge with a code of the authenticator
+// app or a recovery code.
+type LoginTwoFactorDTO struct {
+	Token string `json:"mfaToken" validate:"required"`
+	Code  string `json:"code" validate:"required"`
+}
+
+type SwitchOrganizationDTO struct {
+	OrganizationID string `json:"organizationId" validate:"required"`
+}
+
+type GuestLoginDTO struct {
+	Code string `json:"code" validate:"required"`
+	Name string `json:"name" validate:"required,max=64"`
+}
+
+type SessionDTO struct {
+	ID             string `json:"id"`
+	OrganizationID string `json:"organizationId"`
+	UserAgent      string `json:"userAgent"`
+	IP             string `json:"ip"`
+	CreatedAt      string `json:"createdAt"`
+	LastSeenAt     string `json:"lastSeenAt"`
+	Current        bool   `json:"current"`
+}
+
+// SessionToResponse - Convert domain session model to response model.
+func SessionToResponse(session entity.Session, currentSessionID string) SessionDTO {
+	return SessionDTO{
+		ID:             session.ID().String(),
+		OrganizationID: session.OrganizationID().String(),
+		UserAgent:      session.UserAgent(),
+		IP:             session.IP(),
+		CreatedAt:      session.CreatedAt().String(),
+		LastSeenAt:     session.LastSeenAt().String(),
+		Current:        session.ID().String() == currentSessionID,
+	}
 }
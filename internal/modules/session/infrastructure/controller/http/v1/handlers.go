@@ -4,17 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-playground/validator"
 	"github.com/labstack/echo/v4"
 
 	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
 	"github.com/KyKyPy3/clean/internal/infrastructure/config"
 	common_http "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
+	game_domain "github.com/KyKyPy3/clean/internal/modules/game/domain/entity"
+	org_domain "github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
 	"github.com/KyKyPy3/clean/internal/modules/session/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/query"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
 	"github.com/KyKyPy3/clean/internal/modules/session/infrastructure/controller/http/dto"
+	user_domain "github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
 	"github.com/KyKyPy3/clean/pkg/jwt"
 	"github.com/KyKyPy3/clean/pkg/logger"
 )
@@ -24,6 +33,16 @@ const (
 	cookieExpiration = -time.Hour * 24
 	accessTokenKey   = "access_token"
 	refreshTokenKey  = "refresh_token"
+
+	defaultGuestTokenMaxAge  = 2 * time.Hour
+	defaultChallengeMaxAge   = 5 * time.Minute
+	defaultChallengeAttempts = 5
+
+	defaultAccountThreshold = 5
+	defaultIPThreshold      = 20
+	defaultLockoutWindow    = 15 * time.Minute
+	defaultLockoutDelay     = 30 * time.Second
+	defaultLockoutMaxDelay  = 15 * time.Minute
 )
 
 type CommandBus interface {
@@ -54,8 +73,16 @@ func NewAuthHandlers(
 	handlers := &AuthHandlers{Commands: commands, Queries: queries, Cfg: cfg, Jwt: jwt, Logger: logger}
 
 	publicMountPoint.POST("/auth/login", handlers.Login)
+	publicMountPoint.POST("/auth/login/2fa", handlers.LoginTwoFactor)
+	publicMountPoint.POST("/auth/guest", handlers.LoginGuest)
+	// The refresh token is checked by the handler, the access token may be expired already
+	publicMountPoint.POST("/auth/refresh", handlers.RefreshToken)
 	privateMountPoint.POST("/auth/logout", handlers.Logout)
-	privateMountPoint.POST("/auth/refresh", handlers.RefreshToken)
+	privateMountPoint.POST("/auth/organization", handlers.SwitchOrganization)
+	privateMountPoint.GET("/auth/sessions", handlers.FetchSessions)
+	privateMountPoint.DELETE("/auth/sessions", handlers.RevokeOtherSessions)
+	privateMountPoint.DELETE("/auth/sessions/:id", handlers.RevokeSession)
+	privateMountPoint.DELETE("/auth/users/:id/sessions", handlers.RevokeUserSessions)
 }
 
 func (a *AuthHandlers) Logout(c echo.Context) error {
@@ -136,6 +163,14 @@ func (a *AuthHandlers) Logout(c echo.Context) error {
 	)
 }
 
+// RefreshToken godoc
+// @Summary Refresh session
+// @Description Replace the refresh token from the cookie with a new one and issue a new access token.
+// @Description A refresh token presented after it was replaced revokes all tokens of its login.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} common_http.ResponseDTO
+// @Router /auth/refresh [post]
 func (a *AuthHandlers) RefreshToken(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
 	defer cancel()
@@ -162,22 +197,32 @@ func (a *AuthHandlers) RefreshToken(c echo.Context) error {
 		)
 	}
 
-	// Refresh access token
+	// Rotate refresh token
 	cmd := command.RefreshSessionCommand{
-		ID:         token.TokenUUID,
-		UserID:     token.UserID,
-		AccessTTL:  a.Cfg.Jwt.AccessTokenMaxAge,
-		RefreshTTL: a.Cfg.Jwt.RefreshTokenMaxAge,
+		ID:             token.TokenUUID,
+		UserID:         token.UserID,
+		OrganizationID: token.OrganizationID,
+		UserAgent:      c.Request().UserAgent(),
+		IP:             c.RealIP(),
+		AccessTTL:      a.Cfg.Jwt.AccessTokenMaxAge,
+		RefreshTTL:     a.Cfg.Jwt.RefreshTokenMaxAge,
 	}
 	res, err := a.Commands.Dispatch(ctx, cmd)
 	if err != nil {
-		a.Logger.Errorf("Failed to refresh token %v", err)
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain_core.ErrNotFound), errors.Is(err, entity.ErrRefreshTokenReused):
+			status = http.StatusForbidden
+		default:
+			a.Logger.Errorf("Failed to refresh token %v", err)
+		}
 
 		return c.JSON(
-			http.StatusInternalServerError,
+			status,
 			common_http.ResponseDTO{
-				Status:  http.StatusForbidden,
+				Status:  status,
 				Message: "error",
+				Error:   err.Error(),
 			},
 		)
 	}
@@ -198,6 +243,7 @@ func (a *AuthHandlers) RefreshToken(c echo.Context) error {
 	accessToken, err := a.Jwt.CreateToken(
 		meta.AccessToken.ID().String(),
 		meta.UserID.String(),
+		meta.OrganizationID.String(),
 		a.Cfg.Jwt.AccessTokenMaxAge,
 	)
 	if err != nil {
@@ -211,13 +257,24 @@ func (a *AuthHandlers) RefreshToken(c echo.Context) error {
 		)
 	}
 
-	// Set tokens to cookie
-	cookie = new(http.Cookie)
-	cookie.Name = refreshTokenKey
-	cookie.Value = *accessToken.Token
-	cookie.Path = "/"
-	cookie.MaxAge = int(a.Cfg.Jwt.AccessTokenMaxAge.Seconds())
-	c.SetCookie(cookie)
+	refreshToken, err := a.Jwt.CreateToken(
+		meta.RefreshToken.ID().String(),
+		meta.UserID.String(),
+		meta.OrganizationID.String(),
+		a.Cfg.Jwt.RefreshTokenMaxAge,
+	)
+	if err != nil {
+		return c.JSON(
+			http.StatusInternalServerError,
+			common_http.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+				Error:   err.Error(),
+			},
+		)
+	}
+
+	a.setCookie(c, accessToken, refreshToken)
 
 	return c.JSON(
 		http.StatusOK,
@@ -225,7 +282,8 @@ func (a *AuthHandlers) RefreshToken(c echo.Context) error {
 			Status:  http.StatusOK,
 			Message: "success",
 			Data: map[string]interface{}{
-				"access_token": accessToken.Token,
+				"access_token":  accessToken.Token,
+				"refresh_token": refreshToken.Token,
 			},
 		},
 	)
@@ -233,15 +291,14 @@ func (a *AuthHandlers) RefreshToken(c echo.Context) error {
 
 // Login godoc
 // @Summary Login user
-// @Description Login user handler
+// @Description Login user handler. Users with two-factor authentication get a challenge token
+// @Description instead of the session tokens, it is answered at /auth/login/2fa.
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Success 200 {object} entity.Token
+// @Failure 429 {object} common_http.ResponseDTO "Too many failed logins, retry after the Retry-After seconds"
 // @Router /auth/login [post]
-// TOFIX: refactor function
-//
-//nolint:funlen // can't make it simpler now
 func (a *AuthHandlers) Login(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
 	defer cancel()
@@ -276,13 +333,24 @@ func (a *AuthHandlers) Login(c echo.Context) error {
 	a.Logger.Debugf("Login with params %v", params)
 
 	cmd := command.LoginUserCommand{
-		Email:      params.Email,
-		Password:   params.Password,
-		AccessTTL:  a.Cfg.Jwt.AccessTokenMaxAge,
-		RefreshTTL: a.Cfg.Jwt.RefreshTokenMaxAge,
+		Email:          params.Email,
+		Password:       params.Password,
+		OrganizationID: params.OrganizationID,
+		UserAgent:      c.Request().UserAgent(),
+		IP:             c.RealIP(),
+		AccessTTL:      a.Cfg.Jwt.AccessTokenMaxAge,
+		RefreshTTL:     a.Cfg.Jwt.RefreshTokenMaxAge,
+		ChallengeTTL:   a.challengeMaxAge(),
+		AccountLockout: AccountLockoutPolicy(a.Cfg),
+		IPLockout:      lockoutPolicy(a.Cfg.Lockout, a.Cfg.Lockout.IPThreshold, defaultIPThreshold),
 	}
 	res, err := a.Commands.Dispatch(ctx, cmd)
 	if err != nil {
+		var locked *entity.LockedError
+		if errors.As(err, &locked) {
+			return lockedResponse(c, locked)
+		}
+
 		return c.JSON(
 			http.StatusForbidden,
 			common_http.ResponseDTO{
@@ -304,9 +372,128 @@ func (a *AuthHandlers) Login(c echo.Context) error {
 		)
 	}
 
+	// The tokens are issued once the challenge is answered with the two-factor code
+	if !meta.Challenge.IsEmpty() {
+		return c.JSON(
+			http.StatusOK,
+			common_http.ResponseDTO{
+				Status:  http.StatusOK,
+				Message: "success",
+				Data: map[string]interface{}{
+					"mfa_required":    true,
+					"mfa_token":       meta.Challenge.ID().String(),
+					"expires_in":      meta.Challenge.ExpiresIn(),
+					"organization_id": meta.OrganizationID.String(),
+				},
+			},
+		)
+	}
+
+	return a.loginResponse(c, meta)
+}
+
+// LoginTwoFactor godoc
+// @Summary Login with two-factor code
+// @Description Answer the challenge returned by the login with a code of the authenticator app
+// @Description or a recovery code. The challenge is revoked after too many wrong codes,
+// @Description wrong codes lock the account like wrong passwords.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param params body dto.LoginTwoFactorDTO true "Challenge token and code"
+// @Success 200 {object} common_http.ResponseDTO
+// @Failure 429 {object} common_http.ResponseDTO "Too many failed logins, retry after the Retry-After seconds"
+// @Router /auth/login/2fa [post]
+func (a *AuthHandlers) LoginTwoFactor(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	params := dto.LoginTwoFactorDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			common_http.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	if err = c.Validate(params); err != nil {
+		return handleValidationErrors(c, err)
+	}
+
+	cmd := command.LoginTwoFactorCommand{
+		ChallengeID:    params.Token,
+		Code:           params.Code,
+		UserAgent:      c.Request().UserAgent(),
+		IP:             c.RealIP(),
+		MaxAttempts:    a.challengeAttempts(),
+		AccessTTL:      a.Cfg.Jwt.AccessTokenMaxAge,
+		RefreshTTL:     a.Cfg.Jwt.RefreshTokenMaxAge,
+		AccountLockout: AccountLockoutPolicy(a.Cfg),
+		IPLockout:      lockoutPolicy(a.Cfg.Lockout, a.Cfg.Lockout.IPThreshold, defaultIPThreshold),
+	}
+	res, err := a.Commands.Dispatch(ctx, cmd)
+	if err != nil {
+		var locked *entity.LockedError
+		if errors.As(err, &locked) {
+			return lockedResponse(c, locked)
+		}
+
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain_core.ErrNotFound),
+			errors.Is(err, entity.ErrChallengeFailed),
+			errors.Is(err, user_domain.ErrInvalidTwoFactorCode),
+			errors.Is(err, user_domain.ErrTwoFactorDisabled):
+			status = http.StatusForbidden
+		default:
+			a.Logger.Errorf("Failed to login with two-factor code %v", err)
+		}
+
+		return c.JSON(
+			status,
+			common_http.ResponseDTO{
+				Status:  status,
+				Message: "error",
+				Error:   err.Error(),
+			},
+		)
+	}
+
+	meta, ok := res.(command.LoginUserResult)
+	if !ok {
+		return c.JSON(
+			http.StatusInternalServerError,
+			common_http.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+			},
+		)
+	}
+
+	return a.loginResponse(c, meta)
+}
+
+// loginResponse issues the tokens of the opened session.
+func (a *AuthHandlers) loginResponse(c echo.Context, meta command.LoginUserResult) error {
 	accessToken, err := a.Jwt.CreateToken(
 		meta.AccessToken.ID().String(),
 		meta.UserID.String(),
+		meta.OrganizationID.String(),
 		a.Cfg.Jwt.AccessTokenMaxAge,
 	)
 	if err != nil {
@@ -323,6 +510,7 @@ func (a *AuthHandlers) Login(c echo.Context) error {
 	refreshToken, err := a.Jwt.CreateToken(
 		meta.RefreshToken.ID().String(),
 		meta.UserID.String(),
+		meta.OrganizationID.String(),
 		a.Cfg.Jwt.RefreshTokenMaxAge,
 	)
 	if err != nil {
@@ -344,9 +532,584 @@ func (a *AuthHandlers) Login(c echo.Context) error {
 			Status:  http.StatusOK,
 			Message: "success",
 			Data: map[string]interface{}{
-				"access_token":  accessToken.Token,
-				"refresh_token": refreshToken.Token,
+				"access_token":    accessToken.Token,
+				"refresh_token":   refreshToken.Token,
+				"organization_id": meta.OrganizationID.String(),
+			},
+		},
+	)
+}
+
+// LoginGuest godoc
+// @Summary Login guest
+// @Description Open a spectator session for the game of the join link
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param params body dto.GuestLoginDTO true "Join code and display name"
+// @Success 200 {object} common_http.ResponseDTO
+// @Router /auth/guest [post]
+func (a *AuthHandlers) LoginGuest(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	params := dto.GuestLoginDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			common_http.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	if err = c.Validate(params); err != nil {
+		return handleValidationErrors(c, err)
+	}
+
+	cmd := command.LoginGuestCommand{
+		Code:      params.Code,
+		Name:      params.Name,
+		AccessTTL: a.guestTokenMaxAge(),
+	}
+	res, err := a.Commands.Dispatch(ctx, cmd)
+	if err != nil {
+		status := http.StatusForbidden
+		switch {
+		case errors.Is(err, domain_core.ErrNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, game_domain.ErrJoinLinkExpired):
+			status = http.StatusGone
+		case errors.Is(err, entity.ErrInvalidGuestName):
+			status = http.StatusBadRequest
+		}
+
+		return c.JSON(
+			status,
+			common_http.ResponseDTO{
+				Status:  status,
+				Message: "error",
+				Error:   err.Error(),
+			},
+		)
+	}
+
+	meta, ok := res.(command.LoginGuestResult)
+	if !ok {
+		return c.JSON(
+			http.StatusInternalServerError,
+			common_http.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+			},
+		)
+	}
+
+	accessToken, err := a.Jwt.CreateGuestToken(
+		meta.AccessToken.ID().String(),
+		meta.Guest.ID().String(),
+		meta.OrganizationID.String(),
+		meta.Guest.GameID().String(),
+		meta.Guest.Name(),
+		time.Until(time.Unix(meta.AccessToken.ExpiresIn(), 0)),
+	)
+	if err != nil {
+		return c.JSON(
+			http.StatusInternalServerError,
+			common_http.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+				Error:   err.Error(),
+			},
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		common_http.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data: map[string]interface{}{
+				"access_token": accessToken.Token,
+				"guest_id":     meta.Guest.ID().String(),
+				"game_id":      meta.Guest.GameID().String(),
+				"expires_in":   meta.AccessToken.ExpiresIn(),
+			},
+		},
+	)
+}
+
+// SwitchOrganization godoc
+// @Summary Switch organization
+// @Description Replace the session with a new one opened in another organization of the user
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param params body dto.SwitchOrganizationDTO true "Organization"
+// @Success 200 {object} common_http.ResponseDTO
+// @Router /auth/organization [post]
+func (a *AuthHandlers) SwitchOrganization(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	params := dto.SwitchOrganizationDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			common_http.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	if err = c.Validate(params); err != nil {
+		return handleValidationErrors(c, err)
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			common_http.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	accessTokenID, ok := c.Get("access_token_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusInternalServerError,
+			common_http.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+			},
+		)
+	}
+
+	// The refresh token of the old session is revoked when the cookie is present
+	var refreshTokenID string
+	if cookie, cookieErr := c.Cookie(refreshTokenKey); cookieErr == nil {
+		if token, tokenErr := a.Jwt.ValidateToken(cookie.Value); tokenErr == nil && token.UserID == userID {
+			refreshTokenID = token.TokenUUID
+		}
+	}
+
+	cmd := command.SwitchOrganizationCommand{
+		UserID:         userID,
+		OrganizationID: params.OrganizationID,
+		AccessTokenID:  accessTokenID,
+		RefreshTokenID: refreshTokenID,
+		UserAgent:      c.Request().UserAgent(),
+		IP:             c.RealIP(),
+		AccessTTL:      a.Cfg.Jwt.AccessTokenMaxAge,
+		RefreshTTL:     a.Cfg.Jwt.RefreshTokenMaxAge,
+	}
+	res, err := a.Commands.Dispatch(ctx, cmd)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain_core.ErrNotFound), errors.Is(err, org_domain.ErrNotOrganizationMember):
+			status = http.StatusForbidden
+		case errors.Is(err, common.ErrEmptyUID), errors.Is(err, common.ErrUIDBadFormat):
+			status = http.StatusBadRequest
+		default:
+			a.Logger.Errorf("Failed to switch organization %v", err)
+		}
+
+		return c.JSON(
+			status,
+			common_http.ResponseDTO{
+				Status:  status,
+				Message: "error",
+				Error:   err.Error(),
+			},
+		)
+	}
+
+	meta, ok := res.(command.SwitchOrganizationResult)
+	if !ok {
+		return c.JSON(
+			http.StatusInternalServerError,
+			common_http.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+			},
+		)
+	}
+
+	accessToken, err := a.Jwt.CreateToken(
+		meta.AccessToken.ID().String(),
+		meta.UserID.String(),
+		meta.OrganizationID.String(),
+		a.Cfg.Jwt.AccessTokenMaxAge,
+	)
+	if err != nil {
+		return c.JSON(
+			http.StatusInternalServerError,
+			common_http.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+				Error:   err.Error(),
+			},
+		)
+	}
+
+	refreshToken, err := a.Jwt.CreateToken(
+		meta.RefreshToken.ID().String(),
+		meta.UserID.String(),
+		meta.OrganizationID.String(),
+		a.Cfg.Jwt.RefreshTokenMaxAge,
+	)
+	if err != nil {
+		return c.JSON(
+			http.StatusInternalServerError,
+			common_http.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+				Error:   err.Error(),
+			},
+		)
+	}
+
+	a.setCookie(c, accessToken, refreshToken)
+
+	return c.JSON(
+		http.StatusOK,
+		common_http.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data: map[string]interface{}{
+				"access_token":    accessToken.Token,
+				"refresh_token":   refreshToken.Token,
+				"organization_id": meta.OrganizationID.String(),
+			},
+		},
+	)
+}
+
+// FetchSessions godoc
+// @Summary List sessions
+// @Description List the devices the user is logged in on, the session of the request is marked as current
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} common_http.ResponseDTO
+// @Router /auth/sessions [get]
+func (a *AuthHandlers) FetchSessions(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			common_http.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	// Sessions opened before the sessions were recorded have no id
+	currentSessionID, _ := c.Get("session_id").(string)
+
+	res, err := a.Queries.Ask(ctx, query.FetchSessionsQuery{UserID: userID})
+	if err != nil {
+		return c.JSON(
+			http.StatusInternalServerError,
+			common_http.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+				Error:   err.Error(),
+			},
+		)
+	}
+
+	sessions, ok := res.([]entity.Session)
+	if !ok {
+		return c.JSON(
+			http.StatusInternalServerError,
+			common_http.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
 			},
+		)
+	}
+
+	data := make([]dto.SessionDTO, 0, len(sessions))
+	for _, session := range sessions {
+		data = append(data, dto.SessionToResponse(session, currentSessionID))
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		common_http.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data:    data,
+		},
+	)
+}
+
+// RevokeSession godoc
+// @Summary Revoke session
+// @Description Log the user out on the device of the session
+// @Tags Auth
+// @Produce json
+// @Param id path string true "Session ID"
+// @Success 200 {object} common_http.ResponseDTO
+// @Router /auth/sessions/{id} [delete]
+func (a *AuthHandlers) RevokeSession(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			common_http.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err := a.Commands.Dispatch(ctx, command.RevokeSessionCommand{
+		UserID:    userID,
+		SessionID: c.Param("id"),
+	})
+	if err != nil {
+		return a.revokeErrorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		common_http.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// RevokeOtherSessions godoc
+// @Summary Revoke other sessions
+// @Description Log the user out on all devices except the one of the request
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} common_http.ResponseDTO
+// @Router /auth/sessions [delete]
+func (a *AuthHandlers) RevokeOtherSessions(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			common_http.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	// The session of the request is needed to keep it, older logins have none
+	sessionID, ok := c.Get("session_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusConflict,
+			common_http.ResponseDTO{
+				Status:  http.StatusConflict,
+				Message: "error",
+				Error:   "current session is unknown, login again",
+			},
+		)
+	}
+
+	_, err := a.Commands.Dispatch(ctx, command.RevokeOtherSessionsCommand{
+		UserID:           userID,
+		CurrentSessionID: sessionID,
+	})
+	if err != nil {
+		return a.revokeErrorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		common_http.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// RevokeUserSessions godoc
+// @Summary Revoke user sessions
+// @Description Log a member of the organization out on all devices, allowed to owners and admins
+// @Tags Auth
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} common_http.ResponseDTO
+// @Router /auth/users/{id}/sessions [delete]
+func (a *AuthHandlers) RevokeUserSessions(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	actorID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			common_http.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	organizationID, ok := c.Get("organization_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			common_http.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	_, err := a.Commands.Dispatch(ctx, command.RevokeUserSessionsCommand{
+		ActorID:        actorID,
+		UserID:         c.Param("id"),
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		return a.revokeErrorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		common_http.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+func (a *AuthHandlers) revokeErrorResponse(c echo.Context, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain_core.ErrNotFound), errors.Is(err, org_domain.ErrNotOrganizationMember):
+		status = http.StatusNotFound
+	case errors.Is(err, org_domain.ErrPermissionDenied):
+		status = http.StatusForbidden
+	case errors.Is(err, common.ErrEmptyUID), errors.Is(err, common.ErrUIDBadFormat):
+		status = http.StatusBadRequest
+	default:
+		a.Logger.Errorf("Failed to revoke sessions %v", err)
+	}
+
+	return c.JSON(
+		status,
+		common_http.ResponseDTO{
+			Status:  status,
+			Message: "error",
+			Error:   err.Error(),
+		},
+	)
+}
+
+func (a *AuthHandlers) guestTokenMaxAge() time.Duration {
+	if a.Cfg.Jwt.GuestTokenMaxAge > 0 {
+		return a.Cfg.Jwt.GuestTokenMaxAge
+	}
+
+	return defaultGuestTokenMaxAge
+}
+
+func (a *AuthHandlers) challengeMaxAge() time.Duration {
+	if a.Cfg.TwoFactor.ChallengeMaxAge > 0 {
+		return a.Cfg.TwoFactor.ChallengeMaxAge
+	}
+
+	return defaultChallengeMaxAge
+}
+
+func (a *AuthHandlers) challengeAttempts() int {
+	if a.Cfg.TwoFactor.ChallengeAttempts > 0 {
+		return a.Cfg.TwoFactor.ChallengeAttempts
+	}
+
+	return defaultChallengeAttempts
+}
+
+// AccountLockoutPolicy is the lockout of the accounts, the two-factor settings of
+// the user module count their wrong codes by it too.
+func AccountLockoutPolicy(cfg *config.Config) entity.LockoutPolicy {
+	return lockoutPolicy(cfg.Lockout, cfg.Lockout.AccountThreshold, defaultAccountThreshold)
+}
+
+// lockoutPolicy falls back to the default settings missing in the config.
+func lockoutPolicy(cfg config.LockoutConfig, threshold, defaultThreshold int64) entity.LockoutPolicy {
+	policy := entity.LockoutPolicy{
+		Threshold: threshold,
+		Window:    cfg.Window,
+		Delay:     cfg.Delay,
+		MaxDelay:  cfg.MaxDelay,
+	}
+	if policy.Threshold <= 0 {
+		policy.Threshold = defaultThreshold
+	}
+	if policy.Window <= 0 {
+		policy.Window = defaultLockoutWindow
+	}
+	if policy.Delay <= 0 {
+		policy.Delay = defaultLockoutDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = defaultLockoutMaxDelay
+	}
+
+	return policy
+}
+
+// lockedResponse tells the client when the login may be tried again.
+func lockedResponse(c echo.Context, locked *entity.LockedError) error {
+	retryAfter := int64(math.Ceil(locked.RetryAfter.Seconds()))
+	c.Response().Header().Set(echo.HeaderRetryAfter, strconv.FormatInt(max(retryAfter, 1), 10))
+
+	return c.JSON(
+		http.StatusTooManyRequests,
+		common_http.ResponseDTO{
+			Status:  http.StatusTooManyRequests,
+			Message: "error",
+			Error:   entity.ErrLoginLocked.Error(),
 		},
 	)
 }
@@ -8,8 +8,10 @@ import (
 
 	"github.com/KyKyPy3/clean/internal/domain/common"
 	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
 	"github.com/KyKyPy3/clean/pkg/jwt"
 	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
 )
 
 type AuthMiddleware struct {
@@ -26,58 +28,116 @@ func NewAuthMiddleware(jwt *jwt.JWT, sessionStorage ports.SessionRedisStorage, l
 	}
 }
 
+// Process admits registered users only.
 func (a *AuthMiddleware) Process(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		var accessToken string
+		token, session, status := a.authenticate(c)
+		if status != http.StatusOK {
+			return c.NoContent(status)
+		}
+
+		if token.IsGuest() {
+			return c.NoContent(
+				http.StatusForbidden,
+			)
+		}
 
-		header := c.Request().Header
-		authorization := header.Get("Authorization")
+		a.setSession(c, session)
 
-		if strings.HasPrefix(authorization, "Bearer ") {
-			accessToken = strings.TrimPrefix(authorization, "Bearer ")
-		} else {
-			cookie, err := c.Cookie("access_token")
+		return next(c)
+	}
+}
+
+// ProcessGame admits registered users and guests. Guests may only read the
+// game their token was issued for, so they are limited to GET requests whose
+// id route parameter matches the game of the token.
+func (a *AuthMiddleware) ProcessGame(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		token, session, status := a.authenticate(c)
+		if status != http.StatusOK {
+			return c.NoContent(status)
+		}
 
-			if err != nil {
+		if token.IsGuest() {
+			if c.Request().Method != http.MethodGet || c.Param("id") != token.GameID {
 				return c.NoContent(
-					http.StatusUnauthorized,
+					http.StatusForbidden,
 				)
 			}
-			accessToken = cookie.Value
-		}
 
-		if accessToken == "" {
-			return c.NoContent(
-				http.StatusUnauthorized,
-			)
+			c.Set("guest", true)
+			c.Set("guest_name", token.Name)
 		}
 
-		token, err := a.jwt.ValidateToken(accessToken)
-		if err != nil {
-			return c.NoContent(
-				http.StatusForbidden,
-			)
-		}
+		a.setSession(c, session)
+
+		return next(c)
+	}
+}
 
-		tokenID, err := common.ParseUID(token.TokenUUID)
+// setSession exposes the session to the handlers and scopes the request
+// context to the organization of the session.
+func (a *AuthMiddleware) setSession(c echo.Context, session entity.Token) {
+	organizationID := session.OrganizationID().String()
+
+	c.Set("user_id", session.UserID().String())
+	c.Set("organization_id", organizationID)
+	c.Set("access_token_id", session.ID().String())
+	// Tokens issued before the token families have no session
+	if !session.FamilyID().IsEmpty() {
+		c.Set("session_id", session.FamilyID().String())
+	}
+	c.SetRequest(c.Request().WithContext(tenant.WithOrganization(c.Request().Context(), organizationID)))
+}
+
+// authenticate validates the request token and loads its session. It returns
+// the status to reply with when the request is not authenticated.
+func (a *AuthMiddleware) authenticate(c echo.Context) (*jwt.Token, entity.Token, int) {
+	var accessToken string
+
+	header := c.Request().Header
+	authorization := header.Get("Authorization")
+
+	if strings.HasPrefix(authorization, "Bearer ") {
+		accessToken = strings.TrimPrefix(authorization, "Bearer ")
+	} else {
+		cookie, err := c.Cookie("access_token")
 		if err != nil {
-			return c.NoContent(
-				http.StatusForbidden,
-			)
+			return nil, entity.Token{}, http.StatusUnauthorized
 		}
+		accessToken = cookie.Value
+	}
 
-		t, err := a.sessionStorage.Get(c.Request().Context(), tokenID)
-		if err != nil {
-			a.logger.Debugf("%s", err)
+	if accessToken == "" {
+		return nil, entity.Token{}, http.StatusUnauthorized
+	}
 
-			return c.NoContent(
-				http.StatusForbidden,
-			)
-		}
+	token, err := a.jwt.ValidateToken(accessToken)
+	if err != nil {
+		return nil, entity.Token{}, http.StatusForbidden
+	}
 
-		c.Set("user_id", t.UserID().String())
-		c.Set("access_token_id", t.ID().String())
+	tokenID, err := common.ParseUID(token.TokenUUID)
+	if err != nil {
+		return nil, entity.Token{}, http.StatusForbidden
+	}
 
-		return next(c)
+	t, err := a.sessionStorage.Get(c.Request().Context(), tokenID)
+	if err != nil {
+		a.logger.Debugf("%s", err)
+
+		return nil, entity.Token{}, http.StatusForbidden
+	}
+
+	// The session must belong to the subject of the token
+	if t.UserID().String() != token.UserID {
+		return nil, entity.Token{}, http.StatusForbidden
 	}
+
+	// Every session works within an organization, the one of the token
+	if t.OrganizationID().IsEmpty() || t.OrganizationID().String() != token.OrganizationID {
+		return nil, entity.Token{}, http.StatusForbidden
+	}
+
+	return token, t, http.StatusOK
 }
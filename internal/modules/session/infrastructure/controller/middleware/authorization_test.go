@@ -0,0 +1,255 @@
+package middleware_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/session/infrastructure/controller/middleware"
+	"github.com/KyKyPy3/clean/pkg/jwt"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+const keyBits = 2048
+
+type sessionStorageStub struct {
+	tokens map[common.UID]entity.Token
+}
+
+func (s *sessionStorageStub) Get(_ context.Context, tokenID common.UID) (entity.Token, error) {
+	token, ok := s.tokens[tokenID]
+	if !ok {
+		return entity.Token{}, errors.New("session not found")
+	}
+
+	return token, nil
+}
+
+func (s *sessionStorageStub) Set(_ context.Context, tokenID common.UID, token entity.Token) error {
+	s.tokens[tokenID] = token
+	return nil
+}
+
+func (s *sessionStorageStub) Delete(_ context.Context, tokenID common.UID) error {
+	delete(s.tokens, tokenID)
+	return nil
+}
+
+func (s *sessionStorageStub) DeleteByUser(_ context.Context, userID common.UID) error {
+	for tokenID, token := range s.tokens {
+		if token.UserID() == userID {
+			delete(s.tokens, tokenID)
+		}
+	}
+	return nil
+}
+
+func (s *sessionStorageStub) DeleteByUserExcept(_ context.Context, userID, familyID common.UID) error {
+	for tokenID, token := range s.tokens {
+		if token.UserID() == userID && token.FamilyID() != familyID {
+			delete(s.tokens, tokenID)
+		}
+	}
+
+	return nil
+}
+
+func (s *sessionStorageStub) Rotate(_ context.Context, previous, next entity.Token) error {
+	delete(s.tokens, previous.ID())
+	s.tokens[next.ID()] = next
+	return nil
+}
+
+func (s *sessionStorageStub) RotatedFamily(_ context.Context, _ common.UID) (common.UID, error) {
+	return common.UID{}, nil
+}
+
+func (s *sessionStorageStub) DeleteFamily(_ context.Context, familyID common.UID) error {
+	for tokenID, token := range s.tokens {
+		if token.FamilyID() == familyID {
+			delete(s.tokens, tokenID)
+		}
+	}
+	return nil
+}
+
+func (s *sessionStorageStub) SetSession(_ context.Context, _ entity.Session) error {
+	return nil
+}
+
+func (s *sessionStorageStub) GetSession(_ context.Context, _ common.UID) (entity.Session, error) {
+	return entity.Session{}, domain_core.ErrNotFound
+}
+
+func (s *sessionStorageStub) FetchSessions(_ context.Context, _ common.UID) ([]entity.Session, error) {
+	return nil, nil
+}
+
+func newJWT(t *testing.T) *jwt.JWT {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, keyBits)
+	require.NoError(t, err)
+
+	public, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public})
+
+	j, err := jwt.NewJWT(
+		base64.StdEncoding.EncodeToString(privatePEM),
+		base64.StdEncoding.EncodeToString(publicPEM),
+	)
+	require.NoError(t, err)
+
+	return j
+}
+
+func TestGuestTokens(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	j := newJWT(t)
+	storage := &sessionStorageStub{tokens: map[common.UID]entity.Token{}}
+	auth := middleware.NewAuthMiddleware(j, storage, log)
+	expiresIn := time.Now().Add(time.Hour).Unix()
+	orgID := common.NewUID()
+
+	userID := common.NewUID()
+	userSession := entity.NewToken(userID, orgID, expiresIn)
+	require.NoError(t, storage.Set(context.Background(), userSession.ID(), userSession))
+	userToken, err := j.CreateToken(userSession.ID().String(), userID.String(), orgID.String(), time.Hour)
+	require.NoError(t, err)
+
+	gameID := common.NewUID().String()
+	guestID := common.NewUID()
+	guestSession := entity.NewToken(guestID, orgID, expiresIn)
+	require.NoError(t, storage.Set(context.Background(), guestSession.ID(), guestSession))
+	guestToken, err := j.CreateGuestToken(
+		guestSession.ID().String(),
+		guestID.String(),
+		orgID.String(),
+		gameID,
+		"Alice",
+		time.Hour,
+	)
+	require.NoError(t, err)
+
+	e := echo.New()
+	ok := func(c echo.Context) error {
+		guest, _ := c.Get("guest").(bool)
+		if guest {
+			return c.String(http.StatusOK, c.Get("user_id").(string)+" "+c.Get("guest_name").(string))
+		}
+
+		return c.String(http.StatusOK, c.Get("user_id").(string))
+	}
+	e.GET("/users/me", ok, auth.Process)
+	e.GET("/game/:id", ok, auth.ProcessGame)
+	e.POST("/game/:id/round", ok, auth.ProcessGame)
+
+	do := func(method, path string, token *jwt.Token) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+*token.Token)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	rec := do(http.MethodGet, "/users/me", userToken)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, userID.String(), rec.Body.String())
+
+	rec = do(http.MethodGet, "/game/"+gameID, userToken)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Guests can watch the game of the token only
+	rec = do(http.MethodGet, "/game/"+gameID, guestToken)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, guestID.String()+" Alice", rec.Body.String())
+
+	rec = do(http.MethodGet, "/game/"+common.NewUID().String(), guestToken)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = do(http.MethodPost, "/game/"+gameID+"/round", guestToken)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = do(http.MethodGet, "/users/me", guestToken)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	// Revoked guest sessions are rejected
+	require.NoError(t, storage.Delete(context.Background(), guestSession.ID()))
+	rec = do(http.MethodGet, "/game/"+gameID, guestToken)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestOrganizationScope(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	j := newJWT(t)
+	storage := &sessionStorageStub{tokens: map[common.UID]entity.Token{}}
+	auth := middleware.NewAuthMiddleware(j, storage, log)
+	expiresIn := time.Now().Add(time.Hour).Unix()
+	userID := common.NewUID()
+	orgID := common.NewUID()
+
+	e := echo.New()
+	e.GET("/users/me", func(c echo.Context) error {
+		organizationID, err := tenant.Organization(c.Request().Context())
+		if err != nil {
+			return err
+		}
+
+		return c.String(http.StatusOK, organizationID+" "+c.Get("organization_id").(string))
+	}, auth.Process)
+
+	do := func(sessionOrgID common.UID, tokenOrgID string) *httptest.ResponseRecorder {
+		session := entity.NewToken(userID, sessionOrgID, expiresIn)
+		require.NoError(t, storage.Set(context.Background(), session.ID(), session))
+		token, err := j.CreateToken(session.ID().String(), userID.String(), tokenOrgID, time.Hour)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/users/me", nil)
+		req.Header.Set("Authorization", "Bearer "+*token.Token)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	// Requests are scoped to the organization of the session
+	rec := do(orgID, orgID.String())
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, orgID.String()+" "+orgID.String(), rec.Body.String())
+
+	// Tokens issued before organizations existed are rejected
+	rec = do(common.UID{}, "")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	// The token may not claim another organization than its session
+	rec = do(orgID, common.NewUID().String())
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -0,0 +1,137 @@
+package redis
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const (
+	challengePrefix = "sessions:challenge:"
+	attemptsPrefix  = "sessions:challenge-attempts:"
+)
+
+//go:embed script/attempt.lua
+var attemptScript string
+
+type challengeRedisStorage struct {
+	db      *redis.Client
+	attempt *redis.Script
+	logger  logger.Logger
+	tracer  trace.Tracer
+}
+
+func NewChallengeRedisStorage(db *redis.Client, logger logger.Logger) ports.ChallengeRedisStorage {
+	return &challengeRedisStorage{
+		db:      db,
+		attempt: redis.NewScript(attemptScript),
+		logger:  logger,
+		tracer:  otel.Tracer(""),
+	}
+}
+
+// Set stores the challenge until it expires.
+func (s *challengeRedisStorage) Set(ctx context.Context, challenge entity.Challenge) error {
+	_, span := s.tracer.Start(ctx, "challengeRedisStorage.Set")
+	defer span.End()
+
+	d := ChallengeToDB(challenge)
+	challengeBytes, err := json.Marshal(&d) //nolint:musttag // we read from redis
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Until(time.Unix(challenge.ExpiresIn(), 0))
+	if ttl <= 0 {
+		return core.ErrNotFound
+	}
+
+	return s.db.Set(ctx, s.createKey(challenge.ID().String()), challengeBytes, ttl).Err()
+}
+
+func (s *challengeRedisStorage) Get(ctx context.Context, id common.UID) (entity.Challenge, error) {
+	_, span := s.tracer.Start(ctx, "challengeRedisStorage.Get")
+	defer span.End()
+
+	challengeBytes, err := s.db.Get(ctx, s.createKey(id.String())).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return entity.Challenge{}, core.ErrNotFound
+	}
+
+	if err != nil {
+		return entity.Challenge{}, err
+	}
+
+	challenge := DBChallenge{}
+	if err = json.Unmarshal(challengeBytes, &challenge); err != nil { //nolint:musttag // we read from redis
+		return entity.Challenge{}, err
+	}
+
+	return ChallengeFromDB(challenge)
+}
+
+// Attempt counts an answer of the challenge atomically, so concurrent answers
+// can't exceed the attempts. The counter never recreates a removed challenge.
+func (s *challengeRedisStorage) Attempt(ctx context.Context, id common.UID) (int64, error) {
+	_, span := s.tracer.Start(ctx, "challengeRedisStorage.Attempt")
+	defer span.End()
+
+	attempts, err := s.attempt.Run(
+		ctx,
+		s.db,
+		[]string{s.createKey(id.String()), s.createAttemptsKey(id.String())},
+	).Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	if attempts == 0 {
+		return 0, core.ErrNotFound
+	}
+
+	return attempts, nil
+}
+
+// Delete removes the challenge, it fails with core.ErrNotFound when the challenge
+// is already removed, so a challenge is answered once.
+func (s *challengeRedisStorage) Delete(ctx context.Context, id common.UID) error {
+	_, span := s.tracer.Start(ctx, "challengeRedisStorage.Delete")
+	defer span.End()
+
+	var deleted *redis.IntCmd
+	_, err := s.db.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		deleted = pipe.Del(ctx, s.createKey(id.String()))
+		pipe.Del(ctx, s.createAttemptsKey(id.String()))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if deleted.Val() == 0 {
+		return core.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *challengeRedisStorage) createKey(id string) string {
+	return fmt.Sprintf("%s %s", challengePrefix, id)
+}
+
+func (s *challengeRedisStorage) createAttemptsKey(id string) string {
+	return fmt.Sprintf("%s %s", attemptsPrefix, id)
+}
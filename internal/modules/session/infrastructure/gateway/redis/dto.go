@@ -1,15 +1,19 @@
 package redis
 
 import (
+	"time"
+
 	"github.com/KyKyPy3/clean/internal/domain/common"
 	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
 )
 
 // DBToken Database session representation.
 type DBToken struct {
-	ID        string
-	UserID    string
-	ExpiresIn int64
+	ID             string
+	FamilyID       string
+	UserID         string
+	OrganizationID string
+	ExpiresIn      int64
 }
 
 // TokenFromDB Convert database token model to domain model.
@@ -24,7 +28,26 @@ func TokenFromDB(dbToken DBToken) (entity.Token, error) {
 		return entity.Token{}, err
 	}
 
-	token := entity.Hydrate(entityID, userID, dbToken.ExpiresIn)
+	// Sessions issued before organizations existed have no organization and
+	// are rejected by the auth middleware.
+	var organizationID common.UID
+	if dbToken.OrganizationID != "" {
+		organizationID, err = common.ParseUID(dbToken.OrganizationID)
+		if err != nil {
+			return entity.Token{}, err
+		}
+	}
+
+	// Sessions issued before the refresh token rotation have no family
+	var familyID common.UID
+	if dbToken.FamilyID != "" {
+		familyID, err = common.ParseUID(dbToken.FamilyID)
+		if err != nil {
+			return entity.Token{}, err
+		}
+	}
+
+	token := entity.Hydrate(entityID, familyID, userID, organizationID, dbToken.ExpiresIn)
 
 	return token, nil
 }
@@ -32,8 +55,112 @@ func TokenFromDB(dbToken DBToken) (entity.Token, error) {
 // TokenToDB Convert domain token model to database model.
 func TokenToDB(session entity.Token) DBToken {
 	return DBToken{
-		ID:        session.ID().String(),
-		UserID:    session.UserID().String(),
-		ExpiresIn: session.ExpiresIn(),
+		ID:             session.ID().String(),
+		FamilyID:       session.FamilyID().String(),
+		UserID:         session.UserID().String(),
+		OrganizationID: session.OrganizationID().String(),
+		ExpiresIn:      session.ExpiresIn(),
+	}
+}
+
+// DBSession Database representation of the session of a token family.
+type DBSession struct {
+	ID             string
+	UserID         string
+	OrganizationID string
+	UserAgent      string
+	IP             string
+	CreatedAt      time.Time
+	LastSeenAt     time.Time
+	ExpiresIn      int64
+}
+
+// SessionFromDB Convert database session model to domain model.
+func SessionFromDB(dbSession DBSession) (entity.Session, error) {
+	id, err := common.ParseUID(dbSession.ID)
+	if err != nil {
+		return entity.Session{}, err
+	}
+
+	userID, err := common.ParseUID(dbSession.UserID)
+	if err != nil {
+		return entity.Session{}, err
+	}
+
+	organizationID, err := common.ParseUID(dbSession.OrganizationID)
+	if err != nil {
+		return entity.Session{}, err
+	}
+
+	session := entity.HydrateSession(
+		id,
+		userID,
+		organizationID,
+		dbSession.UserAgent,
+		dbSession.IP,
+		dbSession.CreatedAt,
+		dbSession.LastSeenAt,
+		dbSession.ExpiresIn,
+	)
+
+	return session, nil
+}
+
+// SessionToDB Convert domain session model to database model.
+func SessionToDB(session entity.Session) DBSession {
+	return DBSession{
+		ID:             session.ID().String(),
+		UserID:         session.UserID().String(),
+		OrganizationID: session.OrganizationID().String(),
+		UserAgent:      session.UserAgent(),
+		IP:             session.IP(),
+		CreatedAt:      session.CreatedAt(),
+		LastSeenAt:     session.LastSeenAt(),
+		ExpiresIn:      session.ExpiresIn(),
+	}
+}
+
+// DBChallenge Database representation of the login waiting for the two-factor code.
+type DBChallenge struct {
+	ID             string
+	UserID         string
+	OrganizationID string
+	Email          string
+	ExpiresIn      int64
+}
+
+// ChallengeFromDB Convert database challenge model to domain model.
+func ChallengeFromDB(dbChallenge DBChallenge) (entity.Challenge, error) {
+	id, err := common.ParseUID(dbChallenge.ID)
+	if err != nil {
+		return entity.Challenge{}, err
+	}
+
+	userID, err := common.ParseUID(dbChallenge.UserID)
+	if err != nil {
+		return entity.Challenge{}, err
+	}
+
+	organizationID, err := common.ParseUID(dbChallenge.OrganizationID)
+	if err != nil {
+		return entity.Challenge{}, err
+	}
+
+	email, err := common.NewEmail(dbChallenge.Email)
+	if err != nil {
+		return entity.Challenge{}, err
+	}
+
+	return entity.HydrateChallenge(id, userID, organizationID, email, dbChallenge.ExpiresIn), nil
+}
+
+// ChallengeToDB Convert domain challenge model to database model.
+func ChallengeToDB(challenge entity.Challenge) DBChallenge {
+	return DBChallenge{
+		ID:             challenge.ID().String(),
+		UserID:         challenge.UserID().String(),
+		OrganizationID: challenge.OrganizationID().String(),
+		Email:          challenge.Email().String(),
+		ExpiresIn:      challenge.ExpiresIn(),
 	}
 }
@@ -0,0 +1,83 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const (
+	failuresPrefix = "sessions:login-failures:"
+	lockedPrefix   = "sessions:login-locked:"
+)
+
+type loginAttemptRedisStorage struct {
+	db     *redis.Client
+	logger logger.Logger
+	tracer trace.Tracer
+}
+
+func NewLoginAttemptRedisStorage(db *redis.Client, logger logger.Logger) ports.LoginAttemptRedisStorage {
+	return &loginAttemptRedisStorage{db: db, logger: logger, tracer: otel.Tracer("")}
+}
+
+// Fail increments the failures of the key, the counter expires window after the latest failure.
+func (s *loginAttemptRedisStorage) Fail(ctx context.Context, key string, window time.Duration) (int64, error) {
+	_, span := s.tracer.Start(ctx, "loginAttemptRedisStorage.Fail")
+	defer span.End()
+
+	var failures *redis.IntCmd
+	_, err := s.db.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		failures = pipe.Incr(ctx, s.createFailuresKey(key))
+		pipe.Expire(ctx, s.createFailuresKey(key), window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return failures.Val(), nil
+}
+
+func (s *loginAttemptRedisStorage) Lock(ctx context.Context, key string, duration time.Duration) error {
+	_, span := s.tracer.Start(ctx, "loginAttemptRedisStorage.Lock")
+	defer span.End()
+
+	return s.db.Set(ctx, s.createLockedKey(key), time.Now().Add(duration).Unix(), duration).Err()
+}
+
+func (s *loginAttemptRedisStorage) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	_, span := s.tracer.Start(ctx, "loginAttemptRedisStorage.LockedFor")
+	defer span.End()
+
+	ttl, err := s.db.PTTL(ctx, s.createLockedKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	// Missing keys have a negative TTL
+	return max(ttl, 0), nil
+}
+
+// Reset forgets the failures of the key, a running lockout is kept until it expires.
+func (s *loginAttemptRedisStorage) Reset(ctx context.Context, key string) error {
+	_, span := s.tracer.Start(ctx, "loginAttemptRedisStorage.Reset")
+	defer span.End()
+
+	return s.db.Del(ctx, s.createFailuresKey(key)).Err()
+}
+
+func (s *loginAttemptRedisStorage) createFailuresKey(key string) string {
+	return fmt.Sprintf("%s %s", failuresPrefix, key)
+}
+
+func (s *loginAttemptRedisStorage) createLockedKey(key string) string {
+	return fmt.Sprintf("%s %s", lockedPrefix, key)
+}
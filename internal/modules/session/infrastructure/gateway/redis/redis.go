@@ -3,7 +3,9 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -11,13 +13,20 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
 	"github.com/KyKyPy3/clean/internal/modules/session/application/ports"
 	"github.com/KyKyPy3/clean/internal/modules/session/domain/entity"
 	"github.com/KyKyPy3/clean/pkg/logger"
 )
 
 const (
-	basePrefix = "sessions:"
+	basePrefix    = "sessions:"
+	userPrefix    = "sessions:user:"
+	familyPrefix  = "sessions:family:"
+	rotatedPrefix = "sessions:rotated:"
+	// The session of a token family and the index of the sessions of a user
+	infoPrefix         = "sessions:info:"
+	userSessionsPrefix = "sessions:user-sessions:"
 )
 
 type sessionRedisStorage struct {
@@ -52,25 +61,63 @@ func (s *sessionRedisStorage) Set(ctx context.Context, tokenID common.UID, token
 	_, span := s.tracer.Start(ctx, "sessionRedisStorage.Set")
 	defer span.End()
 
-	t := TokenToDB(token)
-	tokenBytes, err := json.Marshal(&t) //nolint:musttag // we read from redis
+	_, err := s.db.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		return s.setToken(ctx, pipe, tokenID, token)
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Rotate replaces the refresh token with the next one of its family. The rotated
+// token is marked with its family, only the first rotation of a token succeeds.
+func (s *sessionRedisStorage) Rotate(ctx context.Context, previous, next entity.Token) error {
+	_, span := s.tracer.Start(ctx, "sessionRedisStorage.Rotate")
+	defer span.End()
+
+	// The marker lives as long as the rotated token would
+	ttl := time.Until(time.Unix(previous.ExpiresIn(), 0))
+	rotatedKey := s.createRotatedKey(previous.ID().String())
+	ok, err := s.db.SetNX(ctx, rotatedKey, next.FamilyID().String(), ttl).Result()
 	if err != nil {
 		return err
 	}
 
-	now := time.Now()
-	if err = s.db.Set(
-		ctx,
-		s.createKey(tokenID.String()),
-		tokenBytes,
-		time.Unix(token.ExpiresIn(), 0).Sub(now),
-	).Err(); err != nil {
+	if !ok {
+		return entity.ErrRefreshTokenReused
+	}
+
+	_, err = s.db.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, s.createKey(previous.ID().String()))
+
+		return s.setToken(ctx, pipe, next.ID(), next)
+	})
+	if err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// RotatedFamily returns the family of a rotated refresh token.
+func (s *sessionRedisStorage) RotatedFamily(ctx context.Context, tokenID common.UID) (common.UID, error) {
+	_, span := s.tracer.Start(ctx, "sessionRedisStorage.RotatedFamily")
+	defer span.End()
+
+	familyID, err := s.db.Get(ctx, s.createRotatedKey(tokenID.String())).Result()
+	if errors.Is(err, redis.Nil) {
+		return common.UID{}, nil
+	}
+
+	if err != nil {
+		return common.UID{}, err
+	}
+
+	return common.ParseUID(familyID)
+}
+
 func (s *sessionRedisStorage) Delete(ctx context.Context, tokenID common.UID) error {
 	_, span := s.tracer.Start(ctx, "sessionRedisStorage.Delete")
 	defer span.End()
@@ -83,6 +130,282 @@ func (s *sessionRedisStorage) Delete(ctx context.Context, tokenID common.UID) er
 	return nil
 }
 
+// DeleteByUser revokes all sessions of the user.
+func (s *sessionRedisStorage) DeleteByUser(ctx context.Context, userID common.UID) error {
+	_, span := s.tracer.Start(ctx, "sessionRedisStorage.DeleteByUser")
+	defer span.End()
+
+	userSessionsKey := s.createUserSessionsKey(userID.String())
+	sessionIDs, err := s.db.SMembers(ctx, userSessionsKey).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(sessionIDs)+1)
+	for _, sessionID := range sessionIDs {
+		keys = append(keys, s.createInfoKey(sessionID))
+	}
+	keys = append(keys, userSessionsKey)
+
+	return s.deleteIndexed(ctx, s.createUserKey(userID.String()), keys...)
+}
+
+// DeleteByUserExcept revokes all sessions of the user except the token family. The
+// tokens are taken from the index of the user, so the tokens without a family go too.
+func (s *sessionRedisStorage) DeleteByUserExcept(ctx context.Context, userID, familyID common.UID) error {
+	_, span := s.tracer.Start(ctx, "sessionRedisStorage.DeleteByUserExcept")
+	defer span.End()
+
+	userKey := s.createUserKey(userID.String())
+	tokenIDs, err := s.db.SDiff(ctx, userKey, s.createFamilyKey(familyID.String())).Result()
+	if err != nil {
+		return err
+	}
+
+	userSessionsKey := s.createUserSessionsKey(userID.String())
+	sessionIDs, err := s.db.SMembers(ctx, userSessionsKey).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(tokenIDs)+2*len(sessionIDs))
+	revokedTokens := make([]any, 0, len(tokenIDs))
+	for _, tokenID := range tokenIDs {
+		keys = append(keys, s.createKey(tokenID))
+		revokedTokens = append(revokedTokens, tokenID)
+	}
+
+	revokedSessions := make([]any, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		if sessionID == familyID.String() {
+			continue
+		}
+
+		keys = append(keys, s.createInfoKey(sessionID), s.createFamilyKey(sessionID))
+		revokedSessions = append(revokedSessions, sessionID)
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	_, err = s.db.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		if len(revokedTokens) > 0 {
+			pipe.SRem(ctx, userKey, revokedTokens...)
+		}
+		if len(revokedSessions) > 0 {
+			pipe.SRem(ctx, userSessionsKey, revokedSessions...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteFamily revokes all tokens of the family together with its session.
+func (s *sessionRedisStorage) DeleteFamily(ctx context.Context, familyID common.UID) error {
+	_, span := s.tracer.Start(ctx, "sessionRedisStorage.DeleteFamily")
+	defer span.End()
+
+	session, err := s.GetSession(ctx, familyID)
+	if err != nil && !errors.Is(err, core.ErrNotFound) {
+		return err
+	}
+
+	err = s.deleteIndexed(ctx, s.createFamilyKey(familyID.String()), s.createInfoKey(familyID.String()))
+	if err != nil {
+		return err
+	}
+
+	if session.IsEmpty() {
+		return nil
+	}
+
+	return s.db.SRem(ctx, s.createUserSessionsKey(session.UserID().String()), familyID.String()).Err()
+}
+
+// SetSession stores the session, it lives as long as the latest refresh token of its family.
+func (s *sessionRedisStorage) SetSession(ctx context.Context, session entity.Session) error {
+	_, span := s.tracer.Start(ctx, "sessionRedisStorage.SetSession")
+	defer span.End()
+
+	d := SessionToDB(session)
+	sessionBytes, err := json.Marshal(&d) //nolint:musttag // we read from redis
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Until(time.Unix(session.ExpiresIn(), 0))
+	_, err = s.db.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, s.createInfoKey(session.ID().String()), sessionBytes, ttl)
+		s.addToSet(ctx, pipe, s.createUserSessionsKey(session.UserID().String()), session.ID(), ttl)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRedisStorage) GetSession(ctx context.Context, id common.UID) (entity.Session, error) {
+	_, span := s.tracer.Start(ctx, "sessionRedisStorage.GetSession")
+	defer span.End()
+
+	sessionBytes, err := s.db.Get(ctx, s.createInfoKey(id.String())).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return entity.Session{}, core.ErrNotFound
+	}
+
+	if err != nil {
+		return entity.Session{}, err
+	}
+
+	return s.unmarshalSession(sessionBytes)
+}
+
+// FetchSessions returns the sessions of the user, the latest seen first.
+func (s *sessionRedisStorage) FetchSessions(ctx context.Context, userID common.UID) ([]entity.Session, error) {
+	_, span := s.tracer.Start(ctx, "sessionRedisStorage.FetchSessions")
+	defer span.End()
+
+	sessionIDs, err := s.db.SMembers(ctx, s.createUserSessionsKey(userID.String())).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]entity.Session, 0, len(sessionIDs))
+	if len(sessionIDs) == 0 {
+		return sessions, nil
+	}
+
+	keys := make([]string, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		keys = append(keys, s.createInfoKey(sessionID))
+	}
+
+	values, err := s.db.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, value := range values {
+		// Sessions expire before the index of the user
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		var session entity.Session
+		session, err = s.unmarshalSession([]byte(data))
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt().After(sessions[j].LastSeenAt())
+	})
+
+	return sessions, nil
+}
+
+func (s *sessionRedisStorage) unmarshalSession(sessionBytes []byte) (entity.Session, error) {
+	session := DBSession{}
+	if err := json.Unmarshal(sessionBytes, &session); err != nil { //nolint:musttag // we read from redis
+		return entity.Session{}, err
+	}
+
+	return SessionFromDB(session)
+}
+
+// setToken stores the token and adds it to the sets of its user and family, which
+// allow to revoke all the sessions at once.
+func (s *sessionRedisStorage) setToken(
+	ctx context.Context,
+	pipe redis.Pipeliner,
+	tokenID common.UID,
+	token entity.Token,
+) error {
+	t := TokenToDB(token)
+	tokenBytes, err := json.Marshal(&t) //nolint:musttag // we read from redis
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Until(time.Unix(token.ExpiresIn(), 0))
+	pipe.Set(ctx, s.createKey(tokenID.String()), tokenBytes, ttl)
+	s.addToSet(ctx, pipe, s.createUserKey(token.UserID().String()), tokenID, ttl)
+	if !token.FamilyID().IsEmpty() {
+		s.addToSet(ctx, pipe, s.createFamilyKey(token.FamilyID().String()), tokenID, ttl)
+	}
+
+	return nil
+}
+
+// addToSet adds the id to the set, the set lives as long as its longest living member.
+func (s *sessionRedisStorage) addToSet(
+	ctx context.Context,
+	pipe redis.Pipeliner,
+	key string,
+	id common.UID,
+	ttl time.Duration,
+) {
+	pipe.SAdd(ctx, key, id.String())
+	pipe.ExpireNX(ctx, key, ttl)
+	pipe.ExpireGT(ctx, key, ttl)
+}
+
+// deleteIndexed deletes the set, all the tokens listed in it and the extra keys.
+func (s *sessionRedisStorage) deleteIndexed(ctx context.Context, key string, extra ...string) error {
+	tokenIDs, err := s.db.SMembers(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(tokenIDs)+len(extra)+1)
+	for _, tokenID := range tokenIDs {
+		keys = append(keys, s.createKey(tokenID))
+	}
+	keys = append(keys, key)
+	keys = append(keys, extra...)
+
+	err = s.db.Del(ctx, keys...).Err()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRedisStorage) createUserKey(userID string) string {
+	return fmt.Sprintf("%s %s", userPrefix, userID)
+}
+
+func (s *sessionRedisStorage) createFamilyKey(familyID string) string {
+	return fmt.Sprintf("%s %s", familyPrefix, familyID)
+}
+
+func (s *sessionRedisStorage) createRotatedKey(tokenID string) string {
+	return fmt.Sprintf("%s %s", rotatedPrefix, tokenID)
+}
+
+func (s *sessionRedisStorage) createInfoKey(sessionID string) string {
+	return fmt.Sprintf("%s %s", infoPrefix, sessionID)
+}
+
+func (s *sessionRedisStorage) createUserSessionsKey(userID string) string {
+	return fmt.Sprintf("%s %s", userSessionsPrefix, userID)
+}
+
 func (s *sessionRedisStorage) createKey(tokenID string) string {
 	return fmt.Sprintf("%s: %s", s.basePrefix, tokenID)
 }
@@ -0,0 +1,12 @@
+-- Counts an answer of the challenge, the counter lives as long as the challenge.
+-- KEYS[1] - challenge key, KEYS[2] - attempts key.
+-- Returns the attempts including this one, 0 when the challenge is removed.
+local ttl = redis.call("PTTL", KEYS[1])
+if ttl < 0 then
+  return 0
+end
+
+local attempts = redis.call("INCR", KEYS[2])
+redis.call("PEXPIRE", KEYS[2], ttl)
+
+return attempts
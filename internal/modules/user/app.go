@@ -13,23 +13,87 @@ import (
 	"github.com/KyKyPy3/clean/internal/modules/user/application/event"
 	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
 	"github.com/KyKyPy3/clean/internal/modules/user/application/query"
+	user_event "github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
 	handlers "github.com/KyKyPy3/clean/internal/modules/user/infrastructure/controller/http/v1"
 	"github.com/KyKyPy3/clean/pkg/jwt"
 	"github.com/KyKyPy3/clean/pkg/logger"
 	"github.com/KyKyPy3/clean/pkg/mediator"
+	"github.com/KyKyPy3/clean/pkg/outbox"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+const (
+	queueTopic = "user"
 )
 
 func InitHandlers(
 	ctx context.Context,
 	userPgStorage ports.UserPgStorage,
-	mountPoint *echo.Group,
+	emailChangePgStorage ports.EmailChangePgStorage,
+	passwordResetPgStorage ports.PasswordResetPgStorage,
+	twoFactorPgStorage ports.TwoFactorPgStorage,
+	orgStorage ports.OrganizationViewStorage,
+	sessionStorage ports.SessionStorage,
+	accountLockout ports.AccountLockout,
+	publicMountPoint *echo.Group,
+	privateMountPoint *echo.Group,
 	pubsub *mediator.Mediator,
 	trManager *manager.Manager,
+	outboxManager outbox.Manager,
 	jwt *jwt.JWT,
+	passwordPolicy vo.PasswordPolicy,
+	hasher ports.PasswordHasher,
+	otp ports.OneTimePassword,
 	logger logger.Logger,
 ) {
-	regUniqPolicy := application.NewUniquenessPolicy(ctx, userPgStorage, logger)
+	regUniqPolicy := application.NewUniquenessPolicy(tenant.Unscoped(ctx), userPgStorage, logger)
 	userCmdBus := core.NewCommandBus()
+	userCmdBus.Register(
+		command.UpdateUserKind,
+		command.NewUpdateUser(userPgStorage, emailChangePgStorage, orgStorage, trManager, pubsub, regUniqPolicy, logger),
+	)
+	userCmdBus.Register(
+		command.ConfirmEmailChangeKind,
+		command.NewConfirmEmailChange(userPgStorage, emailChangePgStorage, trManager, pubsub, regUniqPolicy, logger),
+	)
+	userCmdBus.Register(
+		command.ChangePasswordKind,
+		command.NewChangePassword(userPgStorage, sessionStorage, passwordPolicy, hasher, trManager, pubsub, logger),
+	)
+	userCmdBus.Register(
+		command.RequestPasswordResetKind,
+		command.NewRequestPasswordReset(userPgStorage, passwordResetPgStorage, trManager, pubsub, logger),
+	)
+	userCmdBus.Register(
+		command.ResetPasswordKind,
+		command.NewResetPassword(
+			userPgStorage,
+			passwordResetPgStorage,
+			sessionStorage,
+			passwordPolicy,
+			hasher,
+			trManager,
+			pubsub,
+			logger,
+		),
+	)
+	userCmdBus.Register(
+		command.EnrollTwoFactorKind,
+		command.NewEnrollTwoFactor(userPgStorage, twoFactorPgStorage, otp, trManager, logger),
+	)
+	userCmdBus.Register(
+		command.EnableTwoFactorKind,
+		command.NewEnableTwoFactor(twoFactorPgStorage, otp, trManager, pubsub, logger),
+	)
+	userCmdBus.Register(
+		command.DisableTwoFactorKind,
+		command.NewDisableTwoFactor(userPgStorage, twoFactorPgStorage, accountLockout, otp, trManager, pubsub, logger),
+	)
+	userCmdBus.Register(
+		command.RegenerateRecoveryCodesKind,
+		command.NewRegenerateRecoveryCodes(userPgStorage, twoFactorPgStorage, accountLockout, otp, trManager, logger),
+	)
 	userCmdBus.Register(
 		command.DeleteUserKind,
 		command.NewDeleteUser(userPgStorage, trManager, pubsub, logger),
@@ -46,8 +110,22 @@ func InitHandlers(
 
 	pubsub.Subscribe(
 		reg_event.RegistrationVerified,
-		event.NewRegistrationVerified(logger, userPgStorage, regUniqPolicy).Handle,
+		event.NewRegistrationVerified(logger, userPgStorage, regUniqPolicy, pubsub).Handle,
 	)
 
-	handlers.NewUserHandlers(mountPoint, userCmdBus, userQueryBus, jwt, logger)
+	for _, kind := range []string{
+		user_event.UserCreated,
+		user_event.UserUpdated,
+		user_event.EmailChangeRequested,
+		user_event.PasswordResetRequested,
+		user_event.AccountLocked,
+	} {
+		pubsub.Subscribe(kind, func(ctx context.Context, e mediator.Event) error {
+			logger.Debugf("Receive domain event %v", e)
+
+			return outboxManager.Publish(ctx, queueTopic, e)
+		})
+	}
+
+	handlers.NewUserHandlers(publicMountPoint, privateMountPoint, userCmdBus, userQueryBus, jwt, logger)
 }
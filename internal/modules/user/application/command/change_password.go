@@ -0,0 +1,117 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const ChangePasswordKind = "ChangePassword"
+
+// ChangePasswordCommand sets the new password of the user after the current one is verified.
+// The sessions of the user are revoked once the password is stored.
+type ChangePasswordCommand struct {
+	ID          string
+	OldPassword string
+	NewPassword string
+}
+
+func (c ChangePasswordCommand) Type() core.CommandType {
+	return ChangePasswordKind
+}
+
+var _ core.Command = (*ChangePasswordCommand)(nil)
+
+type ChangePassword struct {
+	storage        ports.UserPgStorage
+	sessionStorage ports.SessionStorage
+	policy         vo.PasswordPolicy
+	hasher         ports.PasswordHasher
+	manager        ports.TrManager
+	mediator       ports.Mediator
+	logger         logger.Logger
+}
+
+func NewChangePassword(
+	storage ports.UserPgStorage,
+	sessionStorage ports.SessionStorage,
+	policy vo.PasswordPolicy,
+	hasher ports.PasswordHasher,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) ChangePassword {
+	return ChangePassword{
+		storage:        storage,
+		sessionStorage: sessionStorage,
+		policy:         policy,
+		hasher:         hasher,
+		manager:        manager,
+		mediator:       mediator,
+		logger:         logger,
+	}
+}
+
+func (c ChangePassword) Handle(ctx context.Context, command core.Command) (any, error) {
+	changeCommand, ok := command.(ChangePasswordCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	id, err := common.ParseUID(changeCommand.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var user entity.User
+		user, err = c.storage.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		var password vo.Password
+		password, err = vo.NewPassword(changeCommand.NewPassword, user.Email(), c.policy)
+		if err != nil {
+			return err
+		}
+
+		err = user.ChangePassword(changeCommand.OldPassword, password, c.hasher)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.UpdatePassword(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		err = c.mediator.Publish(ctx, user.Events()...)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The sessions opened with the old password are revoked after the commit, so a
+	// rolled back change keeps them and a failed revocation is reported
+	err = c.sessionStorage.DeleteByUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*ChangePassword)(nil)
@@ -0,0 +1,83 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+	"github.com/KyKyPy3/clean/pkg/hasher"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+type sessionStorageStub struct {
+	revoked []common.UID
+	err     error
+}
+
+func (s *sessionStorageStub) DeleteByUser(_ context.Context, userID common.UID) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.revoked = append(s.revoked, userID)
+	return nil
+}
+
+func TestChangePasswordRevokesSessions(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	passwordHasher := hasher.New(hasher.Params{Memory: 1024, Iterations: 1, Parallelism: 1})
+	hash, err := passwordHasher.Hash("old-password")
+	require.NoError(t, err)
+	user := entity.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		hash,
+		time.Now(),
+		time.Now(),
+	)
+
+	sessions := &sessionStorageStub{}
+	handler := command.NewChangePassword(
+		&userStorageStub{user: user},
+		sessions,
+		vo.PasswordPolicy{MinLength: 8, MaxLength: 64},
+		passwordHasher,
+		trManagerStub{},
+		&mediatorStub{},
+		log,
+	)
+	change := func(oldPassword string) error {
+		_, changeErr := handler.Handle(context.Background(), command.ChangePasswordCommand{
+			ID:          user.ID().String(),
+			OldPassword: oldPassword,
+			NewPassword: "new-password",
+		})
+
+		return changeErr
+	}
+
+	// The sessions are kept when the change fails
+	require.ErrorIs(t, change("wrong-password"), entity.ErrInvalidPassword)
+	assert.Empty(t, sessions.revoked)
+
+	// A failed revocation is reported
+	sessions.err = errors.New("redis is down")
+	require.ErrorIs(t, change("old-password"), sessions.err)
+
+	sessions.err = nil
+	require.NoError(t, change("new-password"))
+	assert.Equal(t, []common.UID{user.ID()}, sessions.revoked)
+}
@@ -0,0 +1,112 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+const ConfirmEmailChangeKind = "ConfirmEmailChange"
+
+// ConfirmEmailChangeCommand applies the email change with the token sent to the new address.
+type ConfirmEmailChangeCommand struct {
+	ID string
+}
+
+func (c ConfirmEmailChangeCommand) Type() core.CommandType {
+	return ConfirmEmailChangeKind
+}
+
+var _ core.Command = (*ConfirmEmailChangeCommand)(nil)
+
+type ConfirmEmailChange struct {
+	storage       ports.UserPgStorage
+	changeStorage ports.EmailChangePgStorage
+	policy        ports.UniquenessPolicer
+	manager       ports.TrManager
+	mediator      ports.Mediator
+	logger        logger.Logger
+}
+
+func NewConfirmEmailChange(
+	storage ports.UserPgStorage,
+	changeStorage ports.EmailChangePgStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	policy ports.UniquenessPolicer,
+	logger logger.Logger,
+) ConfirmEmailChange {
+	return ConfirmEmailChange{
+		storage:       storage,
+		changeStorage: changeStorage,
+		manager:       manager,
+		mediator:      mediator,
+		policy:        policy,
+		logger:        logger,
+	}
+}
+
+func (c ConfirmEmailChange) Handle(ctx context.Context, command core.Command) (any, error) {
+	confirmCommand, ok := command.(ConfirmEmailChangeCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	id, err := common.ParseUID(confirmCommand.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The confirmation link is opened without a session, the token alone selects the user
+	ctx = tenant.Unscoped(ctx)
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var change entity.EmailChange
+		change, err = c.changeStorage.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		var user entity.User
+		user, err = c.storage.GetByID(ctx, change.UserID())
+		if err != nil {
+			return err
+		}
+
+		err = user.ChangeEmail(change, c.policy)
+		if err != nil {
+			return err
+		}
+
+		err = c.storage.Update(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		err = c.changeStorage.DeleteByUser(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+
+		err = c.mediator.Publish(ctx, user.Events()...)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*ConfirmEmailChange)(nil)
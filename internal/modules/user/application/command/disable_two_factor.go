@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const DisableTwoFactorKind = "DisableTwoFactor"
+
+// DisableTwoFactorCommand turns the two-factor authentication off, a code of the
+// authenticator app or a recovery code is required. Wrong codes count toward the
+// lockout of the account like wrong passwords.
+type DisableTwoFactorCommand struct {
+	ID   string
+	Code string
+}
+
+func (c DisableTwoFactorCommand) Type() core.CommandType {
+	return DisableTwoFactorKind
+}
+
+var _ core.Command = (*DisableTwoFactorCommand)(nil)
+
+type DisableTwoFactor struct {
+	twoFactor ports.TwoFactorPgStorage
+	lockout   twoFactorLockout
+	otp       ports.OneTimePassword
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewDisableTwoFactor(
+	storage ports.UserPgStorage,
+	twoFactor ports.TwoFactorPgStorage,
+	accountLockout ports.AccountLockout,
+	otp ports.OneTimePassword,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) DisableTwoFactor {
+	return DisableTwoFactor{
+		twoFactor: twoFactor,
+		lockout:   twoFactorLockout{storage: storage, lockout: accountLockout},
+		otp:       otp,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (d DisableTwoFactor) Handle(ctx context.Context, command core.Command) (any, error) {
+	disableCommand, ok := command.(DisableTwoFactorCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	id, err := common.ParseUID(disableCommand.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := d.lockout.check(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.manager.Do(ctx, func(ctx context.Context) error {
+		var twoFactor entity.TwoFactor
+		twoFactor, err = d.twoFactor.GetByUser(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = twoFactor.Disable(disableCommand.Code, time.Now(), d.otp)
+		if err != nil {
+			return err
+		}
+
+		err = d.twoFactor.DeleteByUser(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return d.mediator.Publish(ctx, twoFactor.Events()...)
+	})
+	if err != nil {
+		return nil, d.lockout.fail(ctx, id, email, err)
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*DisableTwoFactor)(nil)
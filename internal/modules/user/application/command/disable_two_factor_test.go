@@ -0,0 +1,126 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/totp"
+)
+
+type twoFactorStorageStub struct {
+	twoFactor entity.TwoFactor
+}
+
+func (s *twoFactorStorageStub) Create(_ context.Context, twoFactor entity.TwoFactor) error {
+	s.twoFactor = twoFactor
+	return nil
+}
+
+func (s *twoFactorStorageStub) GetByUser(_ context.Context, _ common.UID) (entity.TwoFactor, error) {
+	if s.twoFactor.IsEmpty() {
+		return entity.TwoFactor{}, domain_core.ErrNotFound
+	}
+
+	return s.twoFactor, nil
+}
+
+func (s *twoFactorStorageStub) Update(_ context.Context, twoFactor entity.TwoFactor) error {
+	s.twoFactor = twoFactor
+	return nil
+}
+
+func (s *twoFactorStorageStub) DeleteByUser(_ context.Context, _ common.UID) error {
+	s.twoFactor = entity.TwoFactor{}
+	return nil
+}
+
+type accountLockoutStub struct {
+	threshold int
+	failures  map[common.Email]int
+}
+
+func (a *accountLockoutStub) LockedFor(_ context.Context, email common.Email) (time.Duration, error) {
+	if a.failures[email] >= a.threshold {
+		return time.Minute, nil
+	}
+
+	return 0, nil
+}
+
+func (a *accountLockoutStub) Fail(ctx context.Context, _ common.UID, email common.Email) (time.Duration, error) {
+	a.failures[email]++
+
+	return a.LockedFor(ctx, email)
+}
+
+func TestTwoFactorSettingsLockout(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	user := entity.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		"hash",
+		time.Now(),
+		time.Now(),
+	)
+
+	// Enroll the authenticator app a period ago, so the current code is not used yet
+	otp := totp.New("clean")
+	secret, err := otp.GenerateSecret()
+	require.NoError(t, err)
+	twoFactor, err := entity.NewTwoFactor(user.ID(), secret)
+	require.NoError(t, err)
+	code, err := otp.Code(secret, time.Now().Add(-totp.Period))
+	require.NoError(t, err)
+	_, err = twoFactor.Enable(code, time.Now().Add(-totp.Period), otp)
+	require.NoError(t, err)
+
+	users := &userStorageStub{user: user}
+	twoFactors := &twoFactorStorageStub{twoFactor: twoFactor}
+	lockout := &accountLockoutStub{threshold: 2, failures: map[common.Email]int{}}
+	disable := command.NewDisableTwoFactor(users, twoFactors, lockout, otp, trManagerStub{}, &mediatorStub{}, log)
+	regenerate := command.NewRegenerateRecoveryCodes(users, twoFactors, lockout, otp, trManagerStub{}, log)
+
+	code, err = otp.Code(secret, time.Now())
+	require.NoError(t, err)
+
+	// The wrong codes count toward the lockout of the account
+	_, err = disable.Handle(context.Background(), command.DisableTwoFactorCommand{ID: user.ID().String(), Code: "000000"})
+	require.ErrorIs(t, err, entity.ErrInvalidTwoFactorCode)
+	assert.Equal(t, 1, lockout.failures[user.Email()])
+
+	var locked *entity.TwoFactorLockedError
+	_, err = regenerate.Handle(context.Background(), command.RegenerateRecoveryCodesCommand{
+		ID:   user.ID().String(),
+		Code: "000000",
+	})
+	require.ErrorAs(t, err, &locked)
+	assert.Equal(t, time.Minute, locked.RetryAfter)
+
+	// The locked account can't use the right code either
+	_, err = disable.Handle(context.Background(), command.DisableTwoFactorCommand{ID: user.ID().String(), Code: code})
+	require.ErrorIs(t, err, entity.ErrTwoFactorLocked)
+	assert.True(t, twoFactors.twoFactor.Enabled())
+	assert.Equal(t, 2, lockout.failures[user.Email()])
+
+	// The right code works once the lockout passes
+	delete(lockout.failures, user.Email())
+	_, err = disable.Handle(context.Background(), command.DisableTwoFactorCommand{ID: user.ID().String(), Code: code})
+	require.NoError(t, err)
+	assert.True(t, twoFactors.twoFactor.IsEmpty())
+	assert.Empty(t, lockout.failures)
+}
@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const EnableTwoFactorKind = "EnableTwoFactor"
+
+// EnableTwoFactorCommand confirms the enrollment with the first code of the
+// authenticator app.
+type EnableTwoFactorCommand struct {
+	ID   string
+	Code string
+}
+
+// EnableTwoFactorResult holds the recovery codes, they are shown to the user once.
+type EnableTwoFactorResult struct {
+	RecoveryCodes []string
+}
+
+func (c EnableTwoFactorCommand) Type() core.CommandType {
+	return EnableTwoFactorKind
+}
+
+var _ core.Command = (*EnableTwoFactorCommand)(nil)
+
+type EnableTwoFactor struct {
+	twoFactor ports.TwoFactorPgStorage
+	otp       ports.OneTimePassword
+	manager   ports.TrManager
+	mediator  ports.Mediator
+	logger    logger.Logger
+}
+
+func NewEnableTwoFactor(
+	twoFactor ports.TwoFactorPgStorage,
+	otp ports.OneTimePassword,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) EnableTwoFactor {
+	return EnableTwoFactor{
+		twoFactor: twoFactor,
+		otp:       otp,
+		manager:   manager,
+		mediator:  mediator,
+		logger:    logger,
+	}
+}
+
+func (e EnableTwoFactor) Handle(ctx context.Context, command core.Command) (any, error) {
+	enableCommand, ok := command.(EnableTwoFactorCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	id, err := common.ParseUID(enableCommand.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result EnableTwoFactorResult
+	err = e.manager.Do(ctx, func(ctx context.Context) error {
+		var twoFactor entity.TwoFactor
+		twoFactor, err = e.twoFactor.GetByUser(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		result.RecoveryCodes, err = twoFactor.Enable(enableCommand.Code, time.Now(), e.otp)
+		if err != nil {
+			return err
+		}
+
+		err = e.twoFactor.Update(ctx, twoFactor)
+		if err != nil {
+			return err
+		}
+
+		return e.mediator.Publish(ctx, twoFactor.Events()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+var _ core.CommandHandler = (*EnableTwoFactor)(nil)
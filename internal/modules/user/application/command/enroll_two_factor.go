@@ -0,0 +1,120 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const EnrollTwoFactorKind = "EnrollTwoFactor"
+
+// EnrollTwoFactorCommand generates the secret of the authenticator app. The
+// two-factor authentication is enabled once the user confirms it with a code.
+type EnrollTwoFactorCommand struct {
+	ID string
+}
+
+type EnrollTwoFactorResult struct {
+	Secret string
+	URI    string
+}
+
+func (c EnrollTwoFactorCommand) Type() core.CommandType {
+	return EnrollTwoFactorKind
+}
+
+var _ core.Command = (*EnrollTwoFactorCommand)(nil)
+
+type EnrollTwoFactor struct {
+	storage   ports.UserPgStorage
+	twoFactor ports.TwoFactorPgStorage
+	otp       ports.OneTimePassword
+	manager   ports.TrManager
+	logger    logger.Logger
+}
+
+func NewEnrollTwoFactor(
+	storage ports.UserPgStorage,
+	twoFactor ports.TwoFactorPgStorage,
+	otp ports.OneTimePassword,
+	manager ports.TrManager,
+	logger logger.Logger,
+) EnrollTwoFactor {
+	return EnrollTwoFactor{
+		storage:   storage,
+		twoFactor: twoFactor,
+		otp:       otp,
+		manager:   manager,
+		logger:    logger,
+	}
+}
+
+func (e EnrollTwoFactor) Handle(ctx context.Context, command core.Command) (any, error) {
+	enrollCommand, ok := command.(EnrollTwoFactorCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	id, err := common.ParseUID(enrollCommand.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result EnrollTwoFactorResult
+	err = e.manager.Do(ctx, func(ctx context.Context) error {
+		var user entity.User
+		user, err = e.storage.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// The enrollment is started again until it is confirmed
+		var current entity.TwoFactor
+		current, err = e.twoFactor.GetByUser(ctx, id)
+		if err != nil && !errors.Is(err, domain_core.ErrNotFound) {
+			return err
+		}
+
+		if current.Enabled() {
+			return entity.ErrTwoFactorEnabled
+		}
+
+		var secret string
+		secret, err = e.otp.GenerateSecret()
+		if err != nil {
+			return err
+		}
+
+		var twoFactor entity.TwoFactor
+		twoFactor, err = entity.NewTwoFactor(user.ID(), secret)
+		if err != nil {
+			return err
+		}
+
+		err = e.twoFactor.Create(ctx, twoFactor)
+		if err != nil {
+			return err
+		}
+
+		result = EnrollTwoFactorResult{
+			Secret: secret,
+			URI:    e.otp.URI(user.Email().String(), secret),
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+var _ core.CommandHandler = (*EnrollTwoFactor)(nil)
@@ -0,0 +1,98 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+const RegenerateRecoveryCodesKind = "RegenerateRecoveryCodes"
+
+// RegenerateRecoveryCodesCommand replaces the recovery codes of the user, the
+// unused ones stop working. Wrong codes count toward the lockout of the account
+// like wrong passwords.
+type RegenerateRecoveryCodesCommand struct {
+	ID   string
+	Code string
+}
+
+type RegenerateRecoveryCodesResult struct {
+	RecoveryCodes []string
+}
+
+func (c RegenerateRecoveryCodesCommand) Type() core.CommandType {
+	return RegenerateRecoveryCodesKind
+}
+
+var _ core.Command = (*RegenerateRecoveryCodesCommand)(nil)
+
+type RegenerateRecoveryCodes struct {
+	twoFactor ports.TwoFactorPgStorage
+	lockout   twoFactorLockout
+	otp       ports.OneTimePassword
+	manager   ports.TrManager
+	logger    logger.Logger
+}
+
+func NewRegenerateRecoveryCodes(
+	storage ports.UserPgStorage,
+	twoFactor ports.TwoFactorPgStorage,
+	accountLockout ports.AccountLockout,
+	otp ports.OneTimePassword,
+	manager ports.TrManager,
+	logger logger.Logger,
+) RegenerateRecoveryCodes {
+	return RegenerateRecoveryCodes{
+		twoFactor: twoFactor,
+		lockout:   twoFactorLockout{storage: storage, lockout: accountLockout},
+		otp:       otp,
+		manager:   manager,
+		logger:    logger,
+	}
+}
+
+func (r RegenerateRecoveryCodes) Handle(ctx context.Context, command core.Command) (any, error) {
+	regenerateCommand, ok := command.(RegenerateRecoveryCodesCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	id, err := common.ParseUID(regenerateCommand.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := r.lockout.check(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var result RegenerateRecoveryCodesResult
+	err = r.manager.Do(ctx, func(ctx context.Context) error {
+		var twoFactor entity.TwoFactor
+		twoFactor, err = r.twoFactor.GetByUser(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		result.RecoveryCodes, err = twoFactor.RegenerateRecoveryCodes(regenerateCommand.Code, time.Now(), r.otp)
+		if err != nil {
+			return err
+		}
+
+		return r.twoFactor.Update(ctx, twoFactor)
+	})
+	if err != nil {
+		return nil, r.lockout.fail(ctx, id, email, err)
+	}
+
+	return result, nil
+}
+
+var _ core.CommandHandler = (*RegenerateRecoveryCodes)(nil)
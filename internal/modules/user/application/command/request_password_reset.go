@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+const RequestPasswordResetKind = "RequestPasswordReset"
+
+// RequestPasswordResetCommand emails a reset token to the user with the given email.
+type RequestPasswordResetCommand struct {
+	Email string
+}
+
+func (c RequestPasswordResetCommand) Type() core.CommandType {
+	return RequestPasswordResetKind
+}
+
+var _ core.Command = (*RequestPasswordResetCommand)(nil)
+
+type RequestPasswordReset struct {
+	storage      ports.UserPgStorage
+	resetStorage ports.PasswordResetPgStorage
+	manager      ports.TrManager
+	mediator     ports.Mediator
+	logger       logger.Logger
+}
+
+func NewRequestPasswordReset(
+	storage ports.UserPgStorage,
+	resetStorage ports.PasswordResetPgStorage,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) RequestPasswordReset {
+	return RequestPasswordReset{
+		storage:      storage,
+		resetStorage: resetStorage,
+		manager:      manager,
+		mediator:     mediator,
+		logger:       logger,
+	}
+}
+
+// Handle succeeds for unknown emails too, the response must not tell which emails are registered.
+func (c RequestPasswordReset) Handle(ctx context.Context, command core.Command) (any, error) {
+	requestCommand, ok := command.(RequestPasswordResetCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	email, err := common.NewEmail(requestCommand.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	// The reset is requested without a session
+	ctx = tenant.Unscoped(ctx)
+
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var user entity.User
+		user, err = c.storage.GetByEmail(ctx, email)
+		if errors.Is(err, domain_core.ErrNotFound) {
+			c.logger.Debugf("Password reset requested for unknown email %s", email)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		reset := entity.NewPasswordReset(user, entity.PasswordResetTTL)
+		err = c.resetStorage.Create(ctx, reset)
+		if err != nil {
+			return err
+		}
+
+		err = c.mediator.Publish(ctx, reset.Events()...)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*RequestPasswordReset)(nil)
@@ -0,0 +1,137 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+const ResetPasswordKind = "ResetPassword"
+
+// ResetPasswordCommand sets the new password with the emailed reset token. The
+// sessions of the user are revoked once the password is stored.
+type ResetPasswordCommand struct {
+	ID       string
+	Password string
+}
+
+func (c ResetPasswordCommand) Type() core.CommandType {
+	return ResetPasswordKind
+}
+
+var _ core.Command = (*ResetPasswordCommand)(nil)
+
+type ResetPassword struct {
+	storage        ports.UserPgStorage
+	resetStorage   ports.PasswordResetPgStorage
+	sessionStorage ports.SessionStorage
+	policy         vo.PasswordPolicy
+	hasher         ports.PasswordHasher
+	manager        ports.TrManager
+	mediator       ports.Mediator
+	logger         logger.Logger
+}
+
+func NewResetPassword(
+	storage ports.UserPgStorage,
+	resetStorage ports.PasswordResetPgStorage,
+	sessionStorage ports.SessionStorage,
+	policy vo.PasswordPolicy,
+	hasher ports.PasswordHasher,
+	manager ports.TrManager,
+	mediator ports.Mediator,
+	logger logger.Logger,
+) ResetPassword {
+	return ResetPassword{
+		storage:        storage,
+		resetStorage:   resetStorage,
+		sessionStorage: sessionStorage,
+		policy:         policy,
+		hasher:         hasher,
+		manager:        manager,
+		mediator:       mediator,
+		logger:         logger,
+	}
+}
+
+func (c ResetPassword) Handle(ctx context.Context, command core.Command) (any, error) {
+	resetCommand, ok := command.(ResetPasswordCommand)
+	if !ok {
+		return nil, fmt.Errorf("command type %s: %w", command.Type(), core.ErrUnexpectedCommand)
+	}
+
+	id, err := common.ParseUID(resetCommand.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The password is reset without a session, the token alone selects the user
+	ctx = tenant.Unscoped(ctx)
+
+	var userID common.UID
+	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		var reset entity.PasswordReset
+		reset, err = c.resetStorage.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		var user entity.User
+		user, err = c.storage.GetByID(ctx, reset.UserID())
+		if err != nil {
+			return err
+		}
+
+		var password vo.Password
+		password, err = vo.NewPassword(resetCommand.Password, user.Email(), c.policy)
+		if err != nil {
+			return err
+		}
+
+		err = user.ResetPassword(reset, password, c.hasher)
+		if err != nil {
+			return err
+		}
+		userID = user.ID()
+
+		err = c.storage.UpdatePassword(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		// The token is single-use
+		err = c.resetStorage.DeleteByUser(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+
+		err = c.mediator.Publish(ctx, user.Events()...)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The sessions opened with the old password are revoked after the commit, so a
+	// rolled back reset keeps them and a failed revocation is reported
+	err = c.sessionStorage.DeleteByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	return res, nil
+}
+
+var _ core.CommandHandler = (*ResetPassword)(nil)
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+)
+
+// twoFactorLockout counts the wrong codes sent to change the two-factor settings
+// toward the lockout of the account, so a stolen session can't guess them.
+type twoFactorLockout struct {
+	storage ports.UserPgStorage
+	lockout ports.AccountLockout
+}
+
+// check rejects the codes while the account is locked and returns the email of the user.
+func (l twoFactorLockout) check(ctx context.Context, userID common.UID) (common.Email, error) {
+	user, err := l.storage.GetByID(ctx, userID)
+	if err != nil {
+		return common.Email{}, err
+	}
+
+	lockedFor, err := l.lockout.LockedFor(ctx, user.Email())
+	if err != nil {
+		return common.Email{}, err
+	}
+
+	if lockedFor > 0 {
+		return common.Email{}, &entity.TwoFactorLockedError{RetryAfter: lockedFor}
+	}
+
+	return user.Email(), nil
+}
+
+// fail counts the error of a wrong code and passes the other errors through.
+func (l twoFactorLockout) fail(ctx context.Context, userID common.UID, email common.Email, err error) error {
+	if !errors.Is(err, entity.ErrInvalidTwoFactorCode) {
+		return err
+	}
+
+	lockedFor, failErr := l.lockout.Fail(ctx, userID, email)
+	if failErr != nil {
+		return failErr
+	}
+
+	if lockedFor > 0 {
+		return &entity.TwoFactorLockedError{RetryAfter: lockedFor}
+	}
+
+	return err
+}
@@ -16,12 +16,21 @@ import (
 
 const UpdateUserKind = "UpdateUser"
 
+// ErrPermissionDenied is returned when the actor is neither the user nor an admin of the
+// organization, or when the actor changes the email of another user.
+var ErrPermissionDenied = errors.New("user profile can be updated by the user or an organization admin")
+
+// UpdateUserCommand changes the profile fields which are set, nil fields keep their values.
+// A new email is not applied at once, it waits for confirmation from the new address.
+// Only users change their own email, admins of the organization update the name only.
 type UpdateUserCommand struct {
-	ID         string
-	Name       string
-	Surname    string
-	Middlename string
-	Email      string
+	ID             string
+	ActorID        string
+	OrganizationID string
+	Name           *string
+	Surname        *string
+	Middlename     *string
+	Email          *string
 }
 
 func (c UpdateUserCommand) Type() core.CommandType {
@@ -31,29 +40,36 @@ func (c UpdateUserCommand) Type() core.CommandType {
 var _ core.Command = (*UpdateUserCommand)(nil)
 
 type UpdateUser struct {
-	storage  ports.UserPgStorage
-	policy   ports.UniquenessPolicer
-	manager  ports.TrManager
-	mediator ports.Mediator
-	logger   logger.Logger
+	storage       ports.UserPgStorage
+	changeStorage ports.EmailChangePgStorage
+	orgStorage    ports.OrganizationViewStorage
+	policy        ports.UniquenessPolicer
+	manager       ports.TrManager
+	mediator      ports.Mediator
+	logger        logger.Logger
 }
 
 func NewUpdateUser(
 	storage ports.UserPgStorage,
+	changeStorage ports.EmailChangePgStorage,
+	orgStorage ports.OrganizationViewStorage,
 	manager ports.TrManager,
 	mediator ports.Mediator,
 	policy ports.UniquenessPolicer,
 	logger logger.Logger,
 ) UpdateUser {
 	return UpdateUser{
-		storage:  storage,
-		manager:  manager,
-		mediator: mediator,
-		policy:   policy,
-		logger:   logger,
+		storage:       storage,
+		changeStorage: changeStorage,
+		orgStorage:    orgStorage,
+		manager:       manager,
+		mediator:      mediator,
+		policy:        policy,
+		logger:        logger,
 	}
 }
 
+// Handle updates the profile and reports whether an email change waits for confirmation.
 func (c UpdateUser) Handle(ctx context.Context, command core.Command) (any, error) {
 	updateCommand, ok := command.(UpdateUserCommand)
 	if !ok {
@@ -65,19 +81,18 @@ func (c UpdateUser) Handle(ctx context.Context, command core.Command) (any, erro
 		return nil, err
 	}
 
-	fullname, err := vo.NewFullName(updateCommand.Name, updateCommand.Surname, updateCommand.Middlename)
+	actorID, err := common.ParseUID(updateCommand.ActorID)
 	if err != nil {
 		return nil, err
 	}
 
-	email, err := common.NewEmail(updateCommand.Email)
-	if err != nil {
-		return nil, err
-	}
-
-	// TOFIX: add check for email uniq
-
+	var emailChangePending bool
 	err = c.manager.Do(ctx, func(ctx context.Context) error {
+		err = c.authorize(ctx, id, actorID, updateCommand.OrganizationID)
+		if err != nil {
+			return err
+		}
+
 		var user entity.User
 		user, err = c.storage.GetByID(ctx, id)
 		if err != nil && !errors.Is(err, domain_core.ErrNotFound) {
@@ -88,30 +103,125 @@ func (c UpdateUser) Handle(ctx context.Context, command core.Command) (any, erro
 			return domain_core.ErrNotFound
 		}
 
-		err = user.UpdateEmail(email, c.policy)
+		var fullname vo.FullName
+		var email common.Email
+		fullname, email, err = patch(user, updateCommand)
+		if err != nil {
+			return err
+		}
+
+		// The new address receives the confirmation, so its owner could take over the account
+		if email != user.Email() && id != actorID {
+			return ErrPermissionDenied
+		}
+
+		err = user.Update(fullname)
+		if err != nil {
+			return err
+		}
+
+		if len(user.Events()) > 0 {
+			err = c.storage.Update(ctx, user)
+			if err != nil {
+				return err
+			}
+
+			err = c.mediator.Publish(ctx, user.Events()...)
+			if err != nil {
+				return err
+			}
+		}
+
+		if email == user.Email() {
+			return nil
+		}
+
+		var change entity.EmailChange
+		change, err = entity.NewEmailChange(user, email, entity.EmailChangeTTL, c.policy)
 		if err != nil {
 			return err
 		}
-		user.UpdateFullName(fullname)
 
-		err = c.storage.Update(ctx, user)
+		err = c.changeStorage.Create(ctx, change)
 		if err != nil {
 			return err
 		}
 
-		err = c.mediator.Publish(ctx, user.Events()...)
+		err = c.mediator.Publish(ctx, change.Events()...)
 		if err != nil {
 			return err
 		}
 
+		emailChangePending = true
+
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	var res interface{}
-	return res, nil
+	return emailChangePending, nil
+}
+
+// authorize allows users to update their own profile, other profiles are updated
+// by owners and admins of the organization.
+func (c UpdateUser) authorize(ctx context.Context, id, actorID common.UID, organizationID string) error {
+	if id == actorID {
+		return nil
+	}
+
+	orgID, err := common.ParseUID(organizationID)
+	if err != nil {
+		return err
+	}
+
+	org, err := c.orgStorage.GetByID(ctx, orgID)
+	if errors.Is(err, domain_core.ErrNotFound) {
+		return ErrPermissionDenied
+	}
+	if err != nil {
+		return err
+	}
+
+	member, ok := org.Member(actorID)
+	if !ok || !member.Role().CanManageMembers() {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// patch builds the new profile from the stored one and the fields set in the command.
+func patch(user entity.User, cmd UpdateUserCommand) (vo.FullName, common.Email, error) {
+	name := user.FullName().FirstName()
+	if cmd.Name != nil {
+		name = *cmd.Name
+	}
+
+	surname := user.FullName().LastName()
+	if cmd.Surname != nil {
+		surname = *cmd.Surname
+	}
+
+	middlename := user.FullName().MiddleName()
+	if cmd.Middlename != nil {
+		middlename = *cmd.Middlename
+	}
+
+	fullname, err := vo.NewFullName(name, surname, middlename)
+	if err != nil {
+		return vo.FullName{}, common.Email{}, err
+	}
+
+	email := user.Email()
+	if cmd.Email != nil {
+		email, err = common.NewEmail(*cmd.Email)
+		if err != nil {
+			return vo.FullName{}, common.Email{}, err
+		}
+	}
+
+	return fullname, email, nil
 }
 
 var _ core.CommandHandler = (*UpdateUser)(nil)
@@ -0,0 +1,172 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
+	org_domain "github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/command"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+type userStorageStub struct {
+	user entity.User
+}
+
+func (u *userStorageStub) Fetch(_ context.Context, _, _ int64) ([]entity.User, error) {
+	return []entity.User{u.user}, nil
+}
+
+func (u *userStorageStub) Create(_ context.Context, user entity.User) error {
+	u.user = user
+	return nil
+}
+
+func (u *userStorageStub) Update(_ context.Context, user entity.User) error {
+	u.user = user
+	return nil
+}
+
+func (u *userStorageStub) UpdatePassword(_ context.Context, user entity.User) error {
+	u.user = user
+	return nil
+}
+
+func (u *userStorageStub) GetByEmail(_ context.Context, _ common.Email) (entity.User, error) {
+	return u.user, nil
+}
+
+func (u *userStorageStub) GetByID(_ context.Context, id common.UID) (entity.User, error) {
+	if id != u.user.ID() {
+		return entity.User{}, domain_core.ErrNotFound
+	}
+
+	return u.user, nil
+}
+
+func (u *userStorageStub) Delete(_ context.Context, _ common.UID) error {
+	return nil
+}
+
+type emailChangeStorageStub struct {
+	changes []entity.EmailChange
+}
+
+func (e *emailChangeStorageStub) Create(_ context.Context, change entity.EmailChange) error {
+	e.changes = append(e.changes, change)
+	return nil
+}
+
+func (e *emailChangeStorageStub) GetByID(_ context.Context, _ common.UID) (entity.EmailChange, error) {
+	return entity.EmailChange{}, domain_core.ErrNotFound
+}
+
+func (e *emailChangeStorageStub) DeleteByUser(_ context.Context, _ common.UID) error {
+	return nil
+}
+
+type orgStorageStub struct {
+	organization org_domain.Organization
+}
+
+func (o *orgStorageStub) GetByID(_ context.Context, _ common.UID) (org_domain.Organization, error) {
+	return o.organization, nil
+}
+
+type trManagerStub struct{}
+
+func (trManagerStub) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type mediatorStub struct {
+	events []mediator.Event
+}
+
+func (m *mediatorStub) Publish(_ context.Context, events ...mediator.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
+type uniquePolicyStub struct{}
+
+func (uniquePolicyStub) IsUnique(_ common.Email) (bool, error) {
+	return true, nil
+}
+
+func TestUpdateUserByAdmin(t *testing.T) {
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	user := entity.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		"hash",
+		time.Now(),
+		time.Now(),
+	)
+	adminID := common.NewUID()
+	organization := org_domain.Hydrate(
+		common.NewUID(),
+		"Acme",
+		[]org_domain.Member{
+			org_domain.HydrateMember(adminID, "Bob", org_domain.RoleAdmin, time.Now()),
+			org_domain.HydrateMember(user.ID(), "Alise", org_domain.RoleMember, time.Now()),
+		},
+		time.Now(),
+		time.Now(),
+	)
+
+	users := &userStorageStub{user: user}
+	changes := &emailChangeStorageStub{}
+	handler := command.NewUpdateUser(
+		users,
+		changes,
+		&orgStorageStub{organization: organization},
+		trManagerStub{},
+		&mediatorStub{},
+		uniquePolicyStub{},
+		log,
+	)
+	update := func(actorID common.UID, name, email *string) error {
+		_, err := handler.Handle(context.Background(), command.UpdateUserCommand{
+			ID:             user.ID().String(),
+			ActorID:        actorID.String(),
+			OrganizationID: organization.ID().String(),
+			Name:           name,
+			Email:          email,
+		})
+
+		return err
+	}
+
+	// Admins can't redirect the account to an address they control
+	name := "Alice"
+	email := "bob@email.com"
+	require.ErrorIs(t, update(adminID, &name, &email), command.ErrPermissionDenied)
+	assert.Empty(t, changes.changes)
+	assert.Equal(t, "Alise", users.user.FullName().FirstName())
+
+	// The unchanged email is accepted with the name
+	unchanged := user.Email().String()
+	require.NoError(t, update(adminID, &name, &unchanged))
+	assert.Equal(t, "Alice", users.user.FullName().FirstName())
+	assert.Empty(t, changes.changes)
+
+	// The user changes the own email
+	require.NoError(t, update(user.ID(), nil, &email))
+	require.Len(t, changes.changes, 1)
+	assert.Equal(t, email, changes.changes[0].Email().String())
+}
@@ -11,23 +11,27 @@ import (
 	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
 	"github.com/KyKyPy3/clean/pkg/logger"
 	"github.com/KyKyPy3/clean/pkg/mediator"
+	"github.com/KyKyPy3/clean/pkg/tenant"
 )
 
 type RegistrationVerified struct {
-	storage ports.UserPgStorage
-	policy  ports.UniquenessPolicer
-	logger  logger.Logger
+	storage  ports.UserPgStorage
+	policy   ports.UniquenessPolicer
+	mediator ports.Mediator
+	logger   logger.Logger
 }
 
 func NewRegistrationVerified(
 	logger logger.Logger,
 	storage ports.UserPgStorage,
 	policy ports.UniquenessPolicer,
+	mediator ports.Mediator,
 ) *RegistrationVerified {
 	return &RegistrationVerified{
-		storage: storage,
-		policy:  policy,
-		logger:  logger,
+		storage:  storage,
+		policy:   policy,
+		mediator: mediator,
+		logger:   logger,
 	}
 }
 
@@ -43,6 +47,9 @@ func (r *RegistrationVerified) Handle(ctx context.Context, e mediator.Event) err
 func (r *RegistrationVerified) handleEmailVerified(ctx context.Context, e event.RegistrationVerifiedEvent) error {
 	r.logger.Debugf("Get email verified event")
 
+	// The user does not belong to any organization before it is created.
+	ctx = tenant.Unscoped(ctx)
+
 	fullName, err := vo.NewFullName(strings.Split(e.Email.String(), "@")[0], "", "")
 	if err != nil {
 		return err
@@ -60,5 +67,5 @@ func (r *RegistrationVerified) handleEmailVerified(ctx context.Context, e event.
 		return err
 	}
 
-	return nil
+	return r.mediator.Publish(ctx, user.Events()...)
 }
@@ -2,10 +2,12 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/KyKyPy3/clean/pkg/mediator"
 
 	"github.com/KyKyPy3/clean/internal/domain/common"
+	org_domain "github.com/KyKyPy3/clean/internal/modules/organization/domain/entity"
 	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
 )
 
@@ -17,6 +19,12 @@ type TrManager interface {
 	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
 }
 
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Verify(hash, password string) error
+	NeedsRehash(hash string) bool
+}
+
 type UserViewStorage interface {
 	Fetch(ctx context.Context, limit, offset int64) ([]entity.User, error)
 	GetByEmail(ctx context.Context, email common.Email) (entity.User, error)
@@ -27,11 +35,64 @@ type UserPgStorage interface {
 	Fetch(ctx context.Context, limit, offset int64) ([]entity.User, error)
 	Create(ctx context.Context, data entity.User) error
 	Update(ctx context.Context, data entity.User) error
+	UpdatePassword(ctx context.Context, data entity.User) error
 	GetByEmail(ctx context.Context, email common.Email) (entity.User, error)
 	GetByID(ctx context.Context, id common.UID) (entity.User, error)
 	Delete(ctx context.Context, id common.UID) error
 }
 
+// EmailChangePgStorage keeps the email changes waiting for confirmation, a user
+// has at most one pending change.
+type EmailChangePgStorage interface {
+	Create(ctx context.Context, change entity.EmailChange) error
+	GetByID(ctx context.Context, id common.UID) (entity.EmailChange, error)
+	DeleteByUser(ctx context.Context, userID common.UID) error
+}
+
+// PasswordResetPgStorage keeps the password reset tokens, a user has at most one
+// usable token.
+type PasswordResetPgStorage interface {
+	Create(ctx context.Context, reset entity.PasswordReset) error
+	GetByID(ctx context.Context, id common.UID) (entity.PasswordReset, error)
+	DeleteByUser(ctx context.Context, userID common.UID) error
+}
+
+// TwoFactorPgStorage keeps the authenticator apps of the users, a user has at most one.
+type TwoFactorPgStorage interface {
+	Create(ctx context.Context, twoFactor entity.TwoFactor) error
+	GetByUser(ctx context.Context, userID common.UID) (entity.TwoFactor, error)
+	Update(ctx context.Context, twoFactor entity.TwoFactor) error
+	DeleteByUser(ctx context.Context, userID common.UID) error
+}
+
+// SessionStorage revokes the sessions of the users.
+type SessionStorage interface {
+	// DeleteByUser revokes all sessions of the user.
+	DeleteByUser(ctx context.Context, userID common.UID) error
+}
+
+// AccountLockout is the lockout of the accounts after the failed logins, the wrong
+// two-factor codes sent to change the settings count toward it as well.
+type AccountLockout interface {
+	// LockedFor returns the remaining lockout of the account, it is zero when the account is not locked.
+	LockedFor(ctx context.Context, email common.Email) (time.Duration, error)
+	// Fail counts a wrong code of the user and returns the lockout it starts or extends.
+	Fail(ctx context.Context, userID common.UID, email common.Email) (time.Duration, error)
+}
+
+// OneTimePassword generates the secrets of authenticator apps and verifies their codes.
+type OneTimePassword interface {
+	GenerateSecret() (string, error)
+	URI(account, secret string) string
+	Verify(secret, code string, now time.Time) (int64, error)
+}
+
+// OrganizationViewStorage provides the organization roles used to authorize
+// changes made on behalf of other users.
+type OrganizationViewStorage interface {
+	GetByID(ctx context.Context, id common.UID) (org_domain.Organization, error)
+}
+
 type UniquenessPolicer interface {
 	IsUnique(email common.Email) (bool, error)
 }
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+// EmailChangeTTL is the time the new address has to confirm the change.
+const EmailChangeTTL = 24 * time.Hour
+
+var ErrEmailChangeExpired = errors.New("email change has expired")
+
+// EmailChange is a pending change of the user email. Its id is the confirmation
+// token sent to the new address, the user email is changed only after it is confirmed.
+type EmailChange struct {
+	*core.BaseAggregateRoot
+
+	id        common.UID
+	userID    common.UID
+	email     common.Email
+	expiresAt time.Time
+	createdAt time.Time
+}
+
+// NewEmailChange - creates a pending change of the user email to the given one.
+func NewEmailChange(
+	user User,
+	email common.Email,
+	ttl time.Duration,
+	uniqPolicy domain.UniqueEmailPolicy,
+) (EmailChange, error) {
+	if email.IsEmpty() {
+		return EmailChange{}, fmt.Errorf("user email is empty, err: %w", core.ErrInvalidEntity)
+	}
+
+	if email == user.Email() {
+		return EmailChange{}, fmt.Errorf("user email is not changed, err: %w", core.ErrNoChanges)
+	}
+
+	ok, err := uniqPolicy.IsUnique(email)
+	if err != nil {
+		return EmailChange{}, fmt.Errorf("failed to check uniqueness of email on user, err: %w", err)
+	}
+
+	if !ok {
+		return EmailChange{}, fmt.Errorf("user with same email already exists, err: %w", core.ErrAlreadyExist)
+	}
+
+	now := time.Now().UTC()
+	change := EmailChange{
+		BaseAggregateRoot: &core.BaseAggregateRoot{},
+		id:                common.NewUID(),
+		userID:            user.ID(),
+		email:             email,
+		expiresAt:         now.Add(ttl),
+		createdAt:         now,
+	}
+
+	change.BaseAggregateRoot.AddEvent(event.EmailChangeRequestedEvent{
+		ID:        change.ID().String(),
+		UserID:    user.ID().String(),
+		Email:     email,
+		OldEmail:  user.Email(),
+		ExpiresAt: change.expiresAt,
+	})
+
+	return change, nil
+}
+
+func HydrateEmailChange(id, userID common.UID, email common.Email, expiresAt, createdAt time.Time) EmailChange {
+	return EmailChange{
+		BaseAggregateRoot: &core.BaseAggregateRoot{},
+		id:                id,
+		userID:            userID,
+		email:             email,
+		expiresAt:         expiresAt,
+		createdAt:         createdAt,
+	}
+}
+
+func (c *EmailChange) ID() common.UID {
+	return c.id
+}
+
+func (c *EmailChange) IsEmpty() bool {
+	return *c == EmailChange{}
+}
+
+func (c *EmailChange) UserID() common.UID {
+	return c.userID
+}
+
+// Email returns the new email of the user.
+func (c *EmailChange) Email() common.Email {
+	return c.email
+}
+
+func (c *EmailChange) ExpiresAt() time.Time {
+	return c.expiresAt
+}
+
+func (c *EmailChange) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// IsExpired reports whether the change can no longer be confirmed at the given time.
+func (c *EmailChange) IsExpired(now time.Time) bool {
+	return !now.Before(c.expiresAt)
+}
+
+func (c *EmailChange) Events() []mediator.Event {
+	return c.BaseAggregateRoot.Events()
+}
@@ -0,0 +1,110 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+)
+
+func newUser() entity.User {
+	return entity.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		"12345",
+		time.Now(),
+		time.Now(),
+	)
+}
+
+func TestNewEmailChange(t *testing.T) {
+	user := newUser()
+
+	_, err := entity.NewEmailChange(user, user.Email(), entity.EmailChangeTTL, &policyMock{})
+	require.ErrorIs(t, err, core.ErrNoChanges)
+
+	_, err = entity.NewEmailChange(user, common.MustNewEmail("not_unique@gmail.com"), entity.EmailChangeTTL, &policyMock{})
+	require.ErrorIs(t, err, core.ErrAlreadyExist)
+
+	_, err = entity.NewEmailChange(user, common.MustNewEmail("error@gmail.com"), entity.EmailChangeTTL, &policyMock{})
+	require.ErrorIs(t, err, errUnique)
+
+	email := common.MustNewEmail("alise@new.com")
+	change, err := entity.NewEmailChange(user, email, entity.EmailChangeTTL, &policyMock{})
+	require.NoError(t, err)
+	assert.Equal(t, user.ID(), change.UserID())
+	assert.Equal(t, email, change.Email())
+	assert.False(t, change.IsExpired(time.Now()))
+
+	events := change.Events()
+	require.Len(t, events, 1)
+	requested, ok := events[0].(event.EmailChangeRequestedEvent)
+	require.True(t, ok)
+	assert.Equal(t, change.ID().String(), requested.ID)
+	assert.Equal(t, email, requested.Email)
+	assert.Equal(t, user.Email(), requested.OldEmail)
+
+	// The email of the user is kept until the change is confirmed
+	assert.Equal(t, "alise@email.com", user.Email().String())
+	assert.Empty(t, user.Events())
+}
+
+func TestChangeEmail(t *testing.T) {
+	user := newUser()
+
+	expired := entity.HydrateEmailChange(
+		common.NewUID(),
+		user.ID(),
+		common.MustNewEmail("alise@new.com"),
+		time.Now().Add(-time.Minute),
+		time.Now().Add(-time.Hour),
+	)
+	err := user.ChangeEmail(expired, &policyMock{})
+	require.ErrorIs(t, err, entity.ErrEmailChangeExpired)
+
+	foreign := entity.HydrateEmailChange(
+		common.NewUID(),
+		common.NewUID(),
+		common.MustNewEmail("alise@new.com"),
+		time.Now().Add(time.Hour),
+		time.Now(),
+	)
+	err = user.ChangeEmail(foreign, &policyMock{})
+	require.ErrorIs(t, err, core.ErrInvalidEntity)
+
+	taken := entity.HydrateEmailChange(
+		common.NewUID(),
+		user.ID(),
+		common.MustNewEmail("not_unique@gmail.com"),
+		time.Now().Add(time.Hour),
+		time.Now(),
+	)
+	err = user.ChangeEmail(taken, &policyMock{})
+	require.ErrorIs(t, err, core.ErrAlreadyExist)
+	assert.Equal(t, "alise@email.com", user.Email().String())
+
+	change := entity.HydrateEmailChange(
+		common.NewUID(),
+		user.ID(),
+		common.MustNewEmail("alise@new.com"),
+		time.Now().Add(time.Hour),
+		time.Now(),
+	)
+	err = user.ChangeEmail(change, &policyMock{})
+	require.NoError(t, err)
+	assert.Equal(t, "alise@new.com", user.Email().String())
+
+	events := user.Events()
+	require.Len(t, events, 1)
+	updated, ok := events[0].(event.UserUpdatedEvent)
+	require.True(t, ok)
+	assert.Equal(t, change.Email(), updated.Email)
+}
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+// PasswordResetTTL is the time the emailed token can be used to set a new password.
+const PasswordResetTTL = time.Hour
+
+var ErrPasswordResetExpired = errors.New("password reset has expired")
+
+// PasswordReset is a single-use token which lets the user set a new password
+// without the old one. Its id is the token sent to the user email.
+type PasswordReset struct {
+	*core.BaseAggregateRoot
+
+	id        common.UID
+	userID    common.UID
+	expiresAt time.Time
+	createdAt time.Time
+}
+
+// NewPasswordReset - creates a password reset for the user.
+func NewPasswordReset(user User, ttl time.Duration) PasswordReset {
+	now := time.Now().UTC()
+	reset := PasswordReset{
+		BaseAggregateRoot: &core.BaseAggregateRoot{},
+		id:                common.NewUID(),
+		userID:            user.ID(),
+		expiresAt:         now.Add(ttl),
+		createdAt:         now,
+	}
+
+	reset.BaseAggregateRoot.AddEvent(event.PasswordResetRequestedEvent{
+		ID:        reset.ID().String(),
+		UserID:    user.ID().String(),
+		Email:     user.Email(),
+		ExpiresAt: reset.expiresAt,
+	})
+
+	return reset
+}
+
+func HydratePasswordReset(id, userID common.UID, expiresAt, createdAt time.Time) PasswordReset {
+	return PasswordReset{
+		BaseAggregateRoot: &core.BaseAggregateRoot{},
+		id:                id,
+		userID:            userID,
+		expiresAt:         expiresAt,
+		createdAt:         createdAt,
+	}
+}
+
+func (r *PasswordReset) ID() common.UID {
+	return r.id
+}
+
+func (r *PasswordReset) IsEmpty() bool {
+	return *r == PasswordReset{}
+}
+
+func (r *PasswordReset) UserID() common.UID {
+	return r.userID
+}
+
+func (r *PasswordReset) ExpiresAt() time.Time {
+	return r.expiresAt
+}
+
+func (r *PasswordReset) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// IsExpired reports whether the token can no longer be used at the given time.
+func (r *PasswordReset) IsExpired(now time.Time) bool {
+	return !now.Before(r.expiresAt)
+}
+
+func (r *PasswordReset) Events() []mediator.Event {
+	return r.BaseAggregateRoot.Events()
+}
@@ -0,0 +1,56 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+)
+
+func TestNewPasswordReset(t *testing.T) {
+	user := newUser()
+
+	reset := entity.NewPasswordReset(user, entity.PasswordResetTTL)
+	assert.Equal(t, user.ID(), reset.UserID())
+	assert.False(t, reset.IsExpired(time.Now()))
+	assert.True(t, reset.IsExpired(time.Now().Add(entity.PasswordResetTTL)))
+
+	events := reset.Events()
+	require.Len(t, events, 1)
+	requested, ok := events[0].(event.PasswordResetRequestedEvent)
+	require.True(t, ok)
+	assert.Equal(t, reset.ID().String(), requested.ID)
+	assert.Equal(t, user.Email(), requested.Email)
+}
+
+func TestResetPassword(t *testing.T) {
+	user := newUser()
+	password, err := vo.NewPassword("Correct-h0rse", user.Email(), vo.PasswordPolicy{})
+	require.NoError(t, err)
+
+	expired := entity.HydratePasswordReset(common.NewUID(), user.ID(), time.Now().Add(-time.Minute), time.Now())
+	err = user.ResetPassword(expired, password, passwordHasher)
+	require.ErrorIs(t, err, entity.ErrPasswordResetExpired)
+
+	foreign := entity.HydratePasswordReset(common.NewUID(), common.NewUID(), time.Now().Add(time.Hour), time.Now())
+	err = user.ResetPassword(foreign, password, passwordHasher)
+	require.ErrorIs(t, err, core.ErrInvalidEntity)
+	assert.Empty(t, user.Events())
+
+	reset := entity.HydratePasswordReset(common.NewUID(), user.ID(), time.Now().Add(time.Hour), time.Now())
+	err = user.ResetPassword(reset, password, passwordHasher)
+	require.NoError(t, err)
+	require.NoError(t, user.ValidatePassword("Correct-h0rse", passwordHasher))
+
+	events := user.Events()
+	require.Len(t, events, 1)
+	_, ok := events[0].(event.PasswordChangedEvent)
+	assert.True(t, ok)
+}
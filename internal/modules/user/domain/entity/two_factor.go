@@ -0,0 +1,290 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/pkg/mediator"
+)
+
+const (
+	// RecoveryCodesCount is the number of recovery codes issued at once.
+	RecoveryCodesCount = 10
+
+	recoveryCodeLength   = 10
+	recoveryCodeAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+)
+
+var (
+	ErrTwoFactorEnabled     = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorDisabled    = errors.New("two-factor authentication is not enabled")
+	ErrInvalidTwoFactorCode = errors.New("invalid two-factor authentication code")
+	ErrTwoFactorLocked      = errors.New("too many invalid two-factor authentication codes")
+)
+
+// TwoFactorLockedError rejects the codes until RetryAfter passes.
+type TwoFactorLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *TwoFactorLockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrTwoFactorLocked, e.RetryAfter)
+}
+
+func (e *TwoFactorLockedError) Unwrap() error {
+	return ErrTwoFactorLocked
+}
+
+// TwoFactor is the authenticator app of the user. It is enrolled disabled and
+// enabled once the user confirms it with a first code. Recovery codes replace
+// the app when it is lost, each of them is used once and only its hash is kept.
+type TwoFactor struct {
+	*core.BaseAggregateRoot
+
+	userID        common.UID
+	secret        string
+	enabled       bool
+	recoveryCodes []string
+	lastStep      int64
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// NewTwoFactor - starts the enrollment of the authenticator app with the secret.
+func NewTwoFactor(userID common.UID, secret string) (TwoFactor, error) {
+	if userID.IsEmpty() {
+		return TwoFactor{}, fmt.Errorf("two-factor user is empty, err: %w", core.ErrInvalidEntity)
+	}
+
+	if secret == "" {
+		return TwoFactor{}, fmt.Errorf("two-factor secret is empty, err: %w", core.ErrInvalidEntity)
+	}
+
+	now := time.Now().UTC()
+
+	return TwoFactor{
+		BaseAggregateRoot: &core.BaseAggregateRoot{},
+		userID:            userID,
+		secret:            secret,
+		recoveryCodes:     []string{},
+		createdAt:         now,
+		updatedAt:         now,
+	}, nil
+}
+
+func HydrateTwoFactor(
+	userID common.UID,
+	secret string,
+	enabled bool,
+	recoveryCodes []string,
+	lastStep int64,
+	createdAt time.Time,
+	updatedAt time.Time,
+) TwoFactor {
+	return TwoFactor{
+		BaseAggregateRoot: &core.BaseAggregateRoot{},
+		userID:            userID,
+		secret:            secret,
+		enabled:           enabled,
+		recoveryCodes:     recoveryCodes,
+		lastStep:          lastStep,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+	}
+}
+
+func (t *TwoFactor) UserID() common.UID {
+	return t.userID
+}
+
+func (t *TwoFactor) Secret() string {
+	return t.secret
+}
+
+func (t *TwoFactor) Enabled() bool {
+	return t.enabled
+}
+
+// RecoveryCodes returns the hashes of the unused recovery codes.
+func (t *TwoFactor) RecoveryCodes() []string {
+	return t.recoveryCodes
+}
+
+// LastStep returns the time step of the latest accepted code.
+func (t *TwoFactor) LastStep() int64 {
+	return t.lastStep
+}
+
+func (t *TwoFactor) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *TwoFactor) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+func (t *TwoFactor) IsEmpty() bool {
+	return t.userID.IsEmpty()
+}
+
+// Enable confirms the enrollment with the first code of the app. It returns the
+// recovery codes, they are shown to the user once.
+func (t *TwoFactor) Enable(code string, now time.Time, otp domain.OneTimePassword) ([]string, error) {
+	if t.enabled {
+		return nil, ErrTwoFactorEnabled
+	}
+
+	err := t.verifyCode(code, now, otp)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := t.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	t.enabled = true
+	t.updatedAt = now.UTC()
+	t.BaseAggregateRoot.AddEvent(event.TwoFactorEnabledEvent{ID: t.userID.String()})
+
+	return codes, nil
+}
+
+// Authenticate checks the code of the app or one of the recovery codes, the
+// recovery code is used up.
+func (t *TwoFactor) Authenticate(code string, now time.Time, otp domain.OneTimePassword) error {
+	if !t.enabled {
+		return ErrTwoFactorDisabled
+	}
+
+	code = strings.TrimSpace(code)
+	if isOneTimePassword(code) {
+		return t.verifyCode(code, now, otp)
+	}
+
+	hash := hashRecoveryCode(code)
+	for i, recoveryCode := range t.recoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(recoveryCode), []byte(hash)) != 1 {
+			continue
+		}
+
+		t.recoveryCodes = append(t.recoveryCodes[:i:i], t.recoveryCodes[i+1:]...)
+		t.updatedAt = now.UTC()
+		t.BaseAggregateRoot.AddEvent(event.RecoveryCodeUsedEvent{
+			ID:        t.userID.String(),
+			Remaining: len(t.recoveryCodes),
+		})
+
+		return nil
+	}
+
+	return ErrInvalidTwoFactorCode
+}
+
+// Disable turns the two-factor authentication off after the code is checked.
+func (t *TwoFactor) Disable(code string, now time.Time, otp domain.OneTimePassword) error {
+	err := t.Authenticate(code, now, otp)
+	if err != nil {
+		return err
+	}
+
+	t.enabled = false
+	t.recoveryCodes = []string{}
+	t.updatedAt = now.UTC()
+	t.BaseAggregateRoot.AddEvent(event.TwoFactorDisabledEvent{ID: t.userID.String()})
+
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes after the code is checked.
+func (t *TwoFactor) RegenerateRecoveryCodes(code string, now time.Time, otp domain.OneTimePassword) ([]string, error) {
+	err := t.Authenticate(code, now, otp)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := t.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	t.updatedAt = now.UTC()
+
+	return codes, nil
+}
+
+func (t *TwoFactor) Events() []mediator.Event {
+	return t.BaseAggregateRoot.Events()
+}
+
+// verifyCode checks the code of the app, a code is accepted once.
+func (t *TwoFactor) verifyCode(code string, now time.Time, otp domain.OneTimePassword) error {
+	step, err := otp.Verify(t.secret, code, now)
+	if err != nil || step <= t.lastStep {
+		return ErrInvalidTwoFactorCode
+	}
+
+	t.lastStep = step
+	t.updatedAt = now.UTC()
+
+	return nil
+}
+
+func (t *TwoFactor) generateRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, RecoveryCodesCount)
+	hashes := make([]string, 0, RecoveryCodesCount)
+	for range RecoveryCodesCount {
+		random := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(random); err != nil {
+			return nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+
+		var code strings.Builder
+		for i, b := range random {
+			if i == recoveryCodeLength/2 {
+				code.WriteByte('-')
+			}
+			code.WriteByte(recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)])
+		}
+
+		codes = append(codes, code.String())
+		hashes = append(hashes, hashRecoveryCode(code.String()))
+	}
+
+	t.recoveryCodes = hashes
+
+	return codes, nil
+}
+
+// hashRecoveryCode hashes the random recovery code, it is long enough for SHA-256.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func isOneTimePassword(code string) bool {
+	if len(code) != 6 { //nolint:mnd // digits of the authenticator app codes
+		return false
+	}
+
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,93 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/event"
+	"github.com/KyKyPy3/clean/pkg/totp"
+)
+
+func TestEnableTwoFactor(t *testing.T) {
+	otp := totp.New("clean")
+
+	_, err := entity.NewTwoFactor(common.NewUID(), "")
+	require.ErrorIs(t, err, core.ErrInvalidEntity)
+
+	secret, err := otp.GenerateSecret()
+	require.NoError(t, err)
+
+	twoFactor, err := entity.NewTwoFactor(common.NewUID(), secret)
+	require.NoError(t, err)
+	assert.False(t, twoFactor.Enabled())
+
+	// Codes are not accepted before the enrollment is confirmed
+	now := time.Now()
+	code, err := otp.Code(secret, now)
+	require.NoError(t, err)
+	require.ErrorIs(t, twoFactor.Authenticate(code, now, otp), entity.ErrTwoFactorDisabled)
+
+	_, err = twoFactor.Enable("000000", now.Add(-time.Hour), otp)
+	require.ErrorIs(t, err, entity.ErrInvalidTwoFactorCode)
+	assert.False(t, twoFactor.Enabled())
+
+	codes, err := twoFactor.Enable(code, now, otp)
+	require.NoError(t, err)
+	assert.True(t, twoFactor.Enabled())
+	assert.Len(t, codes, entity.RecoveryCodesCount)
+	assert.Len(t, twoFactor.RecoveryCodes(), entity.RecoveryCodesCount)
+	assert.NotContains(t, twoFactor.RecoveryCodes(), codes[0])
+
+	_, err = twoFactor.Enable(code, now, otp)
+	require.ErrorIs(t, err, entity.ErrTwoFactorEnabled)
+
+	events := twoFactor.Events()
+	require.Len(t, events, 1)
+	enabled, ok := events[0].(event.TwoFactorEnabledEvent)
+	require.True(t, ok)
+	assert.Equal(t, twoFactor.UserID().String(), enabled.ID)
+}
+
+func TestAuthenticateTwoFactor(t *testing.T) {
+	otp := totp.New("clean")
+
+	secret, err := otp.GenerateSecret()
+	require.NoError(t, err)
+	twoFactor, err := entity.NewTwoFactor(common.NewUID(), secret)
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := otp.Code(secret, now.Add(-totp.Period))
+	require.NoError(t, err)
+	codes, err := twoFactor.Enable(code, now.Add(-totp.Period), otp)
+	require.NoError(t, err)
+
+	// A code is accepted once
+	code, err = otp.Code(secret, now)
+	require.NoError(t, err)
+	require.NoError(t, twoFactor.Authenticate(code, now, otp))
+	require.ErrorIs(t, twoFactor.Authenticate(code, now, otp), entity.ErrInvalidTwoFactorCode)
+
+	// Recovery codes are used up, their format is not significant
+	require.NoError(t, twoFactor.Authenticate(" "+codes[0]+" ", now, otp))
+	require.ErrorIs(t, twoFactor.Authenticate(codes[0], now, otp), entity.ErrInvalidTwoFactorCode)
+	assert.Len(t, twoFactor.RecoveryCodes(), entity.RecoveryCodesCount-1)
+
+	require.NoError(t, twoFactor.Authenticate(strings.ToUpper(codes[1]), now, otp))
+	require.ErrorIs(t, twoFactor.Authenticate("unknown-code", now, otp), entity.ErrInvalidTwoFactorCode)
+
+	used, ok := twoFactor.Events()[2].(event.RecoveryCodeUsedEvent)
+	require.True(t, ok)
+	assert.Equal(t, entity.RecoveryCodesCount-2, used.Remaining)
+
+	require.NoError(t, twoFactor.Disable(codes[2], now, otp))
+	assert.False(t, twoFactor.Enabled())
+	assert.Empty(t, twoFactor.RecoveryCodes())
+}
@@ -1,12 +1,11 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
-	"golang.org/x/crypto/bcrypt"
-
 	"github.com/KyKyPy3/clean/internal/domain/common"
 	"github.com/KyKyPy3/clean/internal/domain/core"
 	"github.com/KyKyPy3/clean/internal/modules/user/domain"
@@ -15,7 +14,8 @@ import (
 	"github.com/KyKyPy3/clean/pkg/mediator"
 )
 
-const passwordCost = 10
+// ErrInvalidPassword is returned when the given current password does not match the stored one.
+var ErrInvalidPassword = errors.New("invalid password")
 
 // User struct.
 type User struct {
@@ -110,9 +110,18 @@ func (u *User) Email() common.Email {
 	return u.email
 }
 
-// UpdateEmail set the email of the user.
-func (u *User) UpdateEmail(email common.Email, uniqPolicy domain.UniqueEmailPolicy) error {
-	ok, err := uniqPolicy.IsUnique(email)
+// ChangeEmail applies the confirmed email change. The uniqueness is checked once more,
+// the address could be taken while the change was waiting for confirmation.
+func (u *User) ChangeEmail(change EmailChange, uniqPolicy domain.UniqueEmailPolicy) error {
+	if change.UserID() != u.id {
+		return fmt.Errorf("email change belongs to another user, err: %w", core.ErrInvalidEntity)
+	}
+
+	if change.IsExpired(time.Now()) {
+		return ErrEmailChangeExpired
+	}
+
+	ok, err := uniqPolicy.IsUnique(change.Email())
 	if err != nil {
 		return fmt.Errorf("failed to check uniqueness of email on user, err: %w", err)
 	}
@@ -121,7 +130,27 @@ func (u *User) UpdateEmail(email common.Email, uniqPolicy domain.UniqueEmailPoli
 		return fmt.Errorf("user with same email already exists, err: %w", core.ErrAlreadyExist)
 	}
 
-	u.email = email
+	u.email = change.Email()
+
+	u.BaseAggregateRoot.AddEvent(event.UserUpdatedEvent{ID: u.ID().String(), FullName: u.fullName, Email: u.email})
+
+	return nil
+}
+
+// Update applies the profile changes and records UserUpdated when the profile differs
+// from the stored one. The email is changed only through a confirmed EmailChange.
+func (u *User) Update(fullName vo.FullName) error {
+	if fullName.IsEmpty() {
+		return fmt.Errorf("user fullname is empty, err: %w", core.ErrInvalidEntity)
+	}
+
+	if fullName == u.fullName {
+		return nil
+	}
+
+	u.UpdateFullName(fullName)
+
+	u.BaseAggregateRoot.AddEvent(event.UserUpdatedEvent{ID: u.ID().String(), FullName: u.fullName, Email: u.email})
 
 	return nil
 }
@@ -132,20 +161,75 @@ func (u *User) Password() string {
 }
 
 // UpdatePassword set the password of the user.
-func (u *User) UpdatePassword(password string) error {
-	u.password = password
+func (u *User) UpdatePassword(password vo.Password, hasher domain.PasswordHasher) error {
+	if password.IsEmpty() {
+		return fmt.Errorf("user password is empty, err: %w", core.ErrInvalidEntity)
+	}
+
+	u.password = password.String()
+
+	return u.hashPassword(hasher)
+}
+
+// ChangePassword sets the new password after the current one is verified.
+func (u *User) ChangePassword(oldPassword string, newPassword vo.Password, hasher domain.PasswordHasher) error {
+	if err := u.ValidatePassword(oldPassword, hasher); err != nil {
+		return ErrInvalidPassword
+	}
+
+	return u.setPassword(newPassword, hasher)
+}
+
+// ResetPassword sets the new password with the reset token sent to the user email.
+func (u *User) ResetPassword(reset PasswordReset, newPassword vo.Password, hasher domain.PasswordHasher) error {
+	if reset.UserID() != u.id {
+		return fmt.Errorf("password reset belongs to another user, err: %w", core.ErrInvalidEntity)
+	}
+
+	if reset.IsExpired(time.Now()) {
+		return ErrPasswordResetExpired
+	}
+
+	return u.setPassword(newPassword, hasher)
+}
+
+// RehashPassword hashes the password once more when the stored hash was made by an
+// older algorithm or with older parameters. The password must be verified with
+// ValidatePassword before. The sessions of the user stay, so nothing is recorded.
+func (u *User) RehashPassword(password string, hasher domain.PasswordHasher) (bool, error) {
+	if !hasher.NeedsRehash(u.password) {
+		return false, nil
+	}
+
+	hash, err := hasher.Hash(password)
+	if err != nil {
+		return false, err
+	}
 
-	return u.hashPassword()
+	u.password = hash
+
+	return true, nil
+}
+
+// setPassword hashes the new password and records PasswordChanged.
+func (u *User) setPassword(password vo.Password, hasher domain.PasswordHasher) error {
+	if err := u.UpdatePassword(password, hasher); err != nil {
+		return err
+	}
+
+	u.BaseAggregateRoot.AddEvent(event.PasswordChangedEvent{ID: u.ID().String()})
+
+	return nil
 }
 
 // hashPassword hash user password.
-func (u *User) hashPassword() error {
-	hash, err := bcrypt.GenerateFromPassword([]byte(u.password), passwordCost)
+func (u *User) hashPassword(hasher domain.PasswordHasher) error {
+	hash, err := hasher.Hash(u.password)
 	if err != nil {
 		return err
 	}
 
-	u.password = string(hash)
+	u.password = hash
 
 	return nil
 }
@@ -159,12 +243,8 @@ func (u *User) UpdatedAt() time.Time {
 }
 
 // ValidatePassword compare provided password with stored.
-func (u *User) ValidatePassword(password string) error {
-	err := bcrypt.CompareHashAndPassword([]byte(u.password), []byte(password))
-	if err != nil {
-		return err
-	}
-	return nil
+func (u *User) ValidatePassword(password string, hasher domain.PasswordHasher) error {
+	return hasher.Verify(u.password, password)
 }
 
 // String returns the string representation of the user.
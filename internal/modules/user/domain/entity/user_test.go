@@ -2,7 +2,9 @@ package entity_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -11,11 +13,15 @@ import (
 	"github.com/KyKyPy3/clean/internal/domain/common"
 	"github.com/KyKyPy3/clean/internal/domain/core"
 	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/event"
 	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+	"github.com/KyKyPy3/clean/pkg/hasher"
 )
 
 var (
 	errUnique = errors.New("unique error")
+	// Cheap argon2id parameters keep the tests fast
+	passwordHasher = hasher.New(hasher.Params{Memory: 1024, Iterations: 1, Parallelism: 1})
 )
 
 type policyMock struct {
@@ -51,9 +57,9 @@ func TestPasswordValidation(t *testing.T) {
 	hash, _ := bcrypt.GenerateFromPassword([]byte("12345"), 10)
 
 	user, _ := entity.NewUser(fullName, email, string(hash), &policyMock{})
-	err := user.ValidatePassword("12345")
+	err := user.ValidatePassword("12345", passwordHasher)
 	require.NoError(t, err)
-	err = user.ValidatePassword("password")
+	err = user.ValidatePassword("password", passwordHasher)
 	assert.Error(t, err)
 }
 
@@ -65,3 +71,91 @@ func TestUserValidation(t *testing.T) {
 	require.Error(t, err)
 	assert.ErrorIs(t, err, core.ErrInvalidEntity)
 }
+
+func TestUpdateUser(t *testing.T) {
+	user := entity.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		"12345",
+		time.Now(),
+		time.Now(),
+	)
+
+	// The unchanged profile records nothing
+	err := user.Update(user.FullName())
+	require.NoError(t, err)
+	assert.Empty(t, user.Events())
+
+	err = user.Update(vo.FullName{})
+	require.ErrorIs(t, err, core.ErrInvalidEntity)
+
+	fullName := vo.MustNewFullName("Alise", "Smith", "")
+	err = user.Update(fullName)
+	require.NoError(t, err)
+	assert.Equal(t, fullName, user.FullName())
+
+	events := user.Events()
+	require.Len(t, events, 1)
+	updated, ok := events[0].(event.UserUpdatedEvent)
+	require.True(t, ok)
+	assert.Equal(t, user.ID().String(), updated.ID)
+	assert.Equal(t, fullName, updated.FullName)
+}
+
+func TestChangePassword(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("12345"), 10)
+	user := entity.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		string(hash),
+		time.Now(),
+		time.Now(),
+	)
+
+	password, err := vo.NewPassword("Correct-h0rse", user.Email(), vo.PasswordPolicy{})
+	require.NoError(t, err)
+
+	err = user.ChangePassword("password", password, passwordHasher)
+	require.ErrorIs(t, err, entity.ErrInvalidPassword)
+
+	err = user.ChangePassword("12345", vo.Password{}, passwordHasher)
+	require.ErrorIs(t, err, core.ErrInvalidEntity)
+	assert.Empty(t, user.Events())
+
+	err = user.ChangePassword("12345", password, passwordHasher)
+	require.NoError(t, err)
+	require.NoError(t, user.ValidatePassword("Correct-h0rse", passwordHasher))
+	require.Error(t, user.ValidatePassword("12345", passwordHasher))
+
+	events := user.Events()
+	require.Len(t, events, 1)
+	changed, ok := events[0].(event.PasswordChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, user.ID().String(), changed.ID)
+}
+
+func TestRehashPassword(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("12345"), bcrypt.MinCost)
+	user := entity.Hydrate(
+		common.NewUID(),
+		vo.MustNewFullName("Alise", "Cooper", "Lee"),
+		common.MustNewEmail("alise@email.com"),
+		string(hash),
+		time.Now(),
+		time.Now(),
+	)
+
+	// Legacy bcrypt hashes are migrated to argon2id
+	rehashed, err := user.RehashPassword("12345", passwordHasher)
+	require.NoError(t, err)
+	assert.True(t, rehashed)
+	assert.True(t, strings.HasPrefix(user.Password(), "$argon2id$"))
+	require.NoError(t, user.ValidatePassword("12345", passwordHasher))
+	assert.Empty(t, user.Events())
+
+	rehashed, err = user.RehashPassword("12345", passwordHasher)
+	require.NoError(t, err)
+	assert.False(t, rehashed)
+}
@@ -0,0 +1,21 @@
+package event
+
+import (
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+const AccountLocked = "AccountLocked"
+
+// AccountLockedEvent is recorded when repeated failed logins lock the account, the
+// user is told by email.
+type AccountLockedEvent struct {
+	ID          string
+	Email       common.Email
+	LockedUntil time.Time
+}
+
+func (e AccountLockedEvent) Kind() string {
+	return AccountLocked
+}
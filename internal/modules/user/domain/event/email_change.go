@@ -0,0 +1,23 @@
+package event
+
+import (
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+const EmailChangeRequested = "EmailChangeRequested"
+
+// EmailChangeRequestedEvent carries the confirmation token for the new address
+// and the old address which is notified about the request.
+type EmailChangeRequestedEvent struct {
+	ID        string
+	UserID    string
+	Email     common.Email
+	OldEmail  common.Email
+	ExpiresAt time.Time
+}
+
+func (e EmailChangeRequestedEvent) Kind() string {
+	return EmailChangeRequested
+}
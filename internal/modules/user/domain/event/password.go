@@ -0,0 +1,34 @@
+package event
+
+import (
+	"time"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+const (
+	PasswordChanged        = "PasswordChanged"
+	PasswordResetRequested = "PasswordResetRequested"
+)
+
+// PasswordChangedEvent is recorded when the password is changed or reset, the
+// sessions of the user are revoked on it.
+type PasswordChangedEvent struct {
+	ID string
+}
+
+func (e PasswordChangedEvent) Kind() string {
+	return PasswordChanged
+}
+
+// PasswordResetRequestedEvent carries the reset token sent to the user email.
+type PasswordResetRequestedEvent struct {
+	ID        string
+	UserID    string
+	Email     common.Email
+	ExpiresAt time.Time
+}
+
+func (e PasswordResetRequestedEvent) Kind() string {
+	return PasswordResetRequested
+}
@@ -0,0 +1,36 @@
+package event
+
+const (
+	TwoFactorEnabled  = "TwoFactorEnabled"
+	TwoFactorDisabled = "TwoFactorDisabled"
+	RecoveryCodeUsed  = "RecoveryCodeUsed"
+)
+
+// TwoFactorEnabledEvent is recorded when the user confirms the enrollment of the
+// authenticator app.
+type TwoFactorEnabledEvent struct {
+	ID string
+}
+
+func (e TwoFactorEnabledEvent) Kind() string {
+	return TwoFactorEnabled
+}
+
+type TwoFactorDisabledEvent struct {
+	ID string
+}
+
+func (e TwoFactorDisabledEvent) Kind() string {
+	return TwoFactorDisabled
+}
+
+// RecoveryCodeUsedEvent is recorded when the user logs in with a recovery code
+// instead of the authenticator app.
+type RecoveryCodeUsedEvent struct {
+	ID        string
+	Remaining int
+}
+
+func (e RecoveryCodeUsedEvent) Kind() string {
+	return RecoveryCodeUsed
+}
@@ -0,0 +1,18 @@
+package event
+
+import (
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+)
+
+const UserUpdated = "UserUpdated"
+
+type UserUpdatedEvent struct {
+	ID       string
+	FullName vo.FullName
+	Email    common.Email
+}
+
+func (e UserUpdatedEvent) Kind() string {
+	return UserUpdated
+}
@@ -0,0 +1,9 @@
+package domain
+
+// PasswordHasher hashes passwords and verifies them against the stored hashes.
+// Hashes carry the algorithm prefix, so hashes of older algorithms are still verified.
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Verify(hash, password string) error
+	NeedsRehash(hash string) bool
+}
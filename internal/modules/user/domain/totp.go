@@ -0,0 +1,9 @@
+package domain
+
+import "time"
+
+// OneTimePassword verifies the codes of the authenticator app. Verify returns the
+// time step of the matching code, codes of a step already used are refused.
+type OneTimePassword interface {
+	Verify(secret, code string, now time.Time) (int64, error)
+}
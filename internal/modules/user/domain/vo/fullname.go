@@ -1,6 +1,7 @@
 package vo
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -17,6 +18,13 @@ type FullName struct {
 	middleName string
 }
 
+// fullNameJSON is the wire form of FullName used by the events sent to the broker.
+type fullNameJSON struct {
+	FirstName  string `json:"firstName"`
+	LastName   string `json:"lastName"`
+	MiddleName string `json:"middleName"`
+}
+
 func NewFullName(firstName, lastName, middleName string) (FullName, error) {
 	fullName := FullName{
 		firstName:  strings.TrimSpace(firstName),
@@ -59,3 +67,27 @@ func (f FullName) MiddleName() string {
 func (f FullName) String() string {
 	return fmt.Sprintf("%s %s %s", f.lastName, f.firstName, f.middleName)
 }
+
+func (f FullName) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fullNameJSON{
+		FirstName:  f.firstName,
+		LastName:   f.lastName,
+		MiddleName: f.middleName,
+	})
+}
+
+func (f *FullName) UnmarshalJSON(data []byte) error {
+	var raw fullNameJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	fullName, err := NewFullName(raw.FirstName, raw.LastName, raw.MiddleName)
+	if err != nil {
+		return err
+	}
+
+	*f = fullName
+
+	return nil
+}
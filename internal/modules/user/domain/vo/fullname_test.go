@@ -1,6 +1,7 @@
 package vo_test
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -52,3 +53,30 @@ func TestFullName_NewFullName(t *testing.T) {
 		})
 	}
 }
+
+func TestFullName_JSON(t *testing.T) {
+	fullName := vo.MustNewFullName("John", "Smith", "Sr")
+
+	data, err := json.Marshal(fullName)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if string(data) != `{"firstName":"John","lastName":"Smith","middleName":"Sr"}` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+
+	var decoded vo.FullName
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if decoded != fullName {
+		t.Errorf("Expected %v, got %v", fullName, decoded)
+	}
+
+	err = json.Unmarshal([]byte(`{"lastName":"Smith"}`), &decoded)
+	if !errors.Is(err, vo.ErrEmptyFirstName) {
+		t.Errorf("Expected error %v, got %v", vo.ErrEmptyFirstName, err)
+	}
+}
@@ -0,0 +1,138 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+)
+
+// Password policy violations reported in PasswordError.
+const (
+	PasswordTooShort    = "min"
+	PasswordTooLong     = "max"
+	PasswordNoUpper     = "upper"
+	PasswordNoLower     = "lower"
+	PasswordNoDigit     = "digit"
+	PasswordNoSymbol    = "symbol"
+	PasswordEqualsEmail = "email"
+	PasswordBreached    = "breached"
+)
+
+const defaultMinPasswordLength = 8
+
+var ErrWeakPassword = errors.New("password does not satisfy the password policy")
+
+// BreachedPasswords reports passwords known from data breaches.
+type BreachedPasswords interface {
+	IsBreached(password string) bool
+}
+
+// PasswordPolicy holds the rules a new password must satisfy. Zero MinLength
+// falls back to the default minimum, zero MaxLength means no maximum.
+type PasswordPolicy struct {
+	MinLength     int
+	MaxLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	Breached      BreachedPasswords
+}
+
+// PasswordError lists every rule of the policy the password violates.
+type PasswordError struct {
+	Violations []string
+}
+
+func (e *PasswordError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrWeakPassword, strings.Join(e.Violations, ", "))
+}
+
+func (e *PasswordError) Is(target error) bool {
+	return target == ErrWeakPassword
+}
+
+// Password is a value object holding a plain password which satisfies the policy.
+type Password struct {
+	value string
+}
+
+func NewPassword(password string, email common.Email, policy PasswordPolicy) (Password, error) {
+	violations := policy.violations(password, email)
+	if len(violations) > 0 {
+		return Password{}, &PasswordError{Violations: violations}
+	}
+
+	return Password{value: password}, nil
+}
+
+func (p Password) IsEmpty() bool {
+	return p == Password{}
+}
+
+// String returns the plain password, it must be hashed before it is stored.
+func (p Password) String() string {
+	return p.value
+}
+
+func (p PasswordPolicy) violations(password string, email common.Email) []string {
+	violations := make([]string, 0)
+
+	minLength := p.MinLength
+	if minLength <= 0 {
+		minLength = defaultMinPasswordLength
+	}
+
+	length := utf8.RuneCountInString(password)
+	if length < minLength {
+		violations = append(violations, PasswordTooShort)
+	}
+
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, PasswordTooLong)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r), unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+
+	if p.RequireUpper && !upper {
+		violations = append(violations, PasswordNoUpper)
+	}
+
+	if p.RequireLower && !lower {
+		violations = append(violations, PasswordNoLower)
+	}
+
+	if p.RequireDigit && !digit {
+		violations = append(violations, PasswordNoDigit)
+	}
+
+	if p.RequireSymbol && !symbol {
+		violations = append(violations, PasswordNoSymbol)
+	}
+
+	if !email.IsEmpty() && strings.EqualFold(strings.TrimSpace(password), email.String()) {
+		violations = append(violations, PasswordEqualsEmail)
+	}
+
+	if p.Breached != nil && p.Breached.IsBreached(password) {
+		violations = append(violations, PasswordBreached)
+	}
+
+	return violations
+}
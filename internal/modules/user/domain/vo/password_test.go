@@ -0,0 +1,94 @@
+package vo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+)
+
+type breachedMock struct{}
+
+func (breachedMock) IsBreached(password string) bool {
+	return password == "Passw0rd!"
+}
+
+func TestPassword_NewPassword(t *testing.T) {
+	policy := vo.PasswordPolicy{
+		MinLength:     8,
+		MaxLength:     16,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		Breached:      breachedMock{},
+	}
+	email := common.MustNewEmail("Alise1!@email.com")
+
+	// Build our needed testcase data struct
+	type testCase struct {
+		test       string
+		password   string
+		violations []string
+	}
+
+	// Create new test cases
+	testCases := []testCase{
+		{
+			test:     "Empty password",
+			password: "",
+			violations: []string{
+				vo.PasswordTooShort, vo.PasswordNoUpper, vo.PasswordNoLower, vo.PasswordNoDigit, vo.PasswordNoSymbol,
+			},
+		}, {
+			test:       "Too long password",
+			password:   "Correct-horse-battery-1",
+			violations: []string{vo.PasswordTooLong},
+		}, {
+			test:       "Missing character classes",
+			password:   "correcthorse",
+			violations: []string{vo.PasswordNoUpper, vo.PasswordNoDigit, vo.PasswordNoSymbol},
+		}, {
+			test:       "Password equals email",
+			password:   "ALISE1!@EMAIL.COM",
+			violations: []string{vo.PasswordTooLong, vo.PasswordNoLower, vo.PasswordEqualsEmail},
+		}, {
+			test:       "Breached password",
+			password:   "Passw0rd!",
+			violations: []string{vo.PasswordBreached},
+		}, {
+			test:     "Valid password",
+			password: "Correct-h0rse",
+		},
+	}
+
+	for _, tc := range testCases {
+		// Run Tests
+		t.Run(tc.test, func(t *testing.T) {
+			password, err := vo.NewPassword(tc.password, email, policy)
+			if len(tc.violations) == 0 {
+				require.NoError(t, err)
+				assert.Equal(t, tc.password, password.String())
+				return
+			}
+
+			require.ErrorIs(t, err, vo.ErrWeakPassword)
+			var passwordErr *vo.PasswordError
+			require.True(t, errors.As(err, &passwordErr))
+			assert.Equal(t, tc.violations, passwordErr.Violations)
+			assert.True(t, password.IsEmpty())
+		})
+	}
+}
+
+func TestPassword_DefaultMinLength(t *testing.T) {
+	_, err := vo.NewPassword("1234567", common.Email{}, vo.PasswordPolicy{})
+	require.ErrorIs(t, err, vo.ErrWeakPassword)
+
+	_, err = vo.NewPassword("12345678", common.Email{}, vo.PasswordPolicy{})
+	require.NoError(t, err)
+}
@@ -24,11 +24,40 @@ type FetchUsersDTO struct {
 	Offset int64 `query:"offset" validate:"gte=0,lte=1000"`
 }
 
+// UpdateUserDTO holds the profile fields to change, omitted fields are kept.
 type UpdateUserDTO struct {
-	Name       string `json:"name" validate:"required"`
-	Surname    string `json:"surname"`
-	Middlename string `json:"middlename"`
-	Email      string `json:"email" validate:"required"`
+	Name       *string `json:"name" validate:"omitempty,min=1"`
+	Surname    *string `json:"surname"`
+	Middlename *string `json:"middlename"`
+	Email      *string `json:"email" validate:"omitempty,email"`
+}
+
+type ChangePasswordDTO struct {
+	OldPassword string `json:"old_password" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required"`
+}
+
+type RequestPasswordResetDTO struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordDTO struct {
+	Password string `json:"password" validate:"required"`
+}
+
+type TwoFactorCodeDTO struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// TwoFactorEnrollmentDTO holds the secret of the authenticator app, the URI is
+// shown to the user as a QR code.
+type TwoFactorEnrollmentDTO struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type RecoveryCodesDTO struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
 }
 
 // UserToResponse - Convert domain user model to response model.
@@ -5,7 +5,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-playground/validator"
@@ -14,10 +16,13 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/KyKyPy3/clean/internal/application/core"
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
 	http_dto "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
 	"github.com/KyKyPy3/clean/internal/modules/user/application/command"
 	"github.com/KyKyPy3/clean/internal/modules/user/application/query"
 	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
 	"github.com/KyKyPy3/clean/internal/modules/user/infrastructure/controller/http/dto"
 	"github.com/KyKyPy3/clean/pkg/jwt"
 	"github.com/KyKyPy3/clean/pkg/logger"
@@ -44,7 +49,14 @@ type UserHandlers struct {
 	Logger   logger.Logger
 }
 
-func NewUserHandlers(v1 *echo.Group, commands CommandBus, queries QueryBus, jwt *jwt.JWT, logger logger.Logger) {
+func NewUserHandlers(
+	publicMountPoint *echo.Group,
+	privateMountPoint *echo.Group,
+	commands CommandBus,
+	queries QueryBus,
+	jwt *jwt.JWT,
+	logger logger.Logger,
+) {
 	handlers := &UserHandlers{
 		Commands: commands,
 		Queries:  queries,
@@ -53,11 +65,19 @@ func NewUserHandlers(v1 *echo.Group, commands CommandBus, queries QueryBus, jwt
 		tracer:   otel.Tracer(""),
 	}
 
-	v1.GET("/user/me", handlers.GetMe)
-	v1.GET("/user", handlers.Fetch)
-	v1.POST("/user/:id", handlers.Update)
-	v1.GET("/user/:id", handlers.GetByID)
-	v1.DELETE("/user/:id", handlers.Delete)
+	publicMountPoint.POST("/user/email/:id", handlers.ConfirmEmail)
+	publicMountPoint.POST("/user/password/reset", handlers.RequestPasswordReset)
+	publicMountPoint.POST("/user/password/reset/:id", handlers.ResetPassword)
+	privateMountPoint.POST("/user/password", handlers.ChangePassword)
+	privateMountPoint.POST("/user/2fa", handlers.EnrollTwoFactor)
+	privateMountPoint.POST("/user/2fa/enable", handlers.EnableTwoFactor)
+	privateMountPoint.POST("/user/2fa/disable", handlers.DisableTwoFactor)
+	privateMountPoint.POST("/user/2fa/recovery-codes", handlers.RegenerateRecoveryCodes)
+	privateMountPoint.GET("/user/me", handlers.GetMe)
+	privateMountPoint.GET("/user", handlers.Fetch)
+	privateMountPoint.PATCH("/user/:id", handlers.Update)
+	privateMountPoint.GET("/user/:id", handlers.GetByID)
+	privateMountPoint.DELETE("/user/:id", handlers.Delete)
 }
 
 // Fetch godoc
@@ -69,7 +89,7 @@ func NewUserHandlers(v1 *echo.Group, commands CommandBus, queries QueryBus, jwt
 // @Success 201 {object} entity.User
 // @Router /user [get]
 func (h *UserHandlers) Fetch(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
 	defer cancel()
 
 	var errorList []*http_dto.ValidationError
@@ -169,16 +189,37 @@ func (h *UserHandlers) Fetch(c echo.Context) error {
 
 // Update godoc
 // @Summary Update user
-// @Description Update user handler
+// @Description Update the profile fields sent in the request, other fields are kept.
+// @Description The profile is updated by the user or an organization owner or admin,
+// @Description the email is changed by the user only.
+// @Description A new email is applied after it is confirmed with the link sent to the new address.
 // @Tags User
 // @Accept json
 // @Produce json
-// @Success 200 {object} entity.User
-// @Router /user/{id} [post]
+// @Param id path string true "user_id"
+// @Param params body dto.UpdateUserDTO true "Profile fields"
+// @Success 200 {object} http_dto.ResponseDTO
+// @Failure 400 {object} http_dto.ResponseDTO
+// @Failure 403 {object} http_dto.ResponseDTO
+// @Failure 404 {object} http_dto.ResponseDTO
+// @Failure 409 {object} http_dto.ResponseDTO
+// @Router /user/{id} [patch]
 func (h *UserHandlers) Update(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
 	defer cancel()
 
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+	organizationID, _ := c.Get("organization_id").(string)
+
 	var errorList []*http_dto.ValidationError
 	params := dto.UpdateUserDTO{}
 
@@ -224,29 +265,313 @@ func (h *UserHandlers) Update(c echo.Context) error {
 		)
 	}
 
-	h.Logger.Debugf("Update user with params %v", params)
+	h.Logger.Debugf("Update user %s", c.Param("id"))
 
 	cmd := command.UpdateUserCommand{
-		Name:       params.Name,
-		Surname:    params.Surname,
-		Middlename: params.Middlename,
-		Email:      params.Email,
+		ID:             c.Param("id"),
+		ActorID:        userID,
+		OrganizationID: organizationID,
+		Name:           params.Name,
+		Surname:        params.Surname,
+		Middlename:     params.Middlename,
+		Email:          params.Email,
+	}
+
+	res, err := h.Commands.Dispatch(ctx, cmd)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	emailChangePending, _ := res.(bool)
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data: map[string]interface{}{
+				"email_change_pending": emailChangePending,
+			},
+		},
+	)
+}
+
+// ConfirmEmail godoc
+// @Summary Confirm email change
+// @Description Apply the email change with the token sent to the new address. The link of the email
+// @Description opens the confirmation page of the web client, which posts the token.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param id path string true "email change token"
+// @Success 200
+// @Failure 400 {object} http_dto.ResponseDTO
+// @Failure 404 {object} http_dto.ResponseDTO
+// @Failure 409 {object} http_dto.ResponseDTO
+// @Failure 410 {object} http_dto.ResponseDTO
+// @Router /user/email/{id} [post]
+func (h *UserHandlers) ConfirmEmail(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	id := c.Param("id")
+
+	h.Logger.Debugf("Confirm email change with id '%v'", id)
+
+	cmd := command.ConfirmEmailChangeCommand{
+		ID: id,
+	}
+	_, err := h.Commands.Dispatch(ctx, cmd)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// ChangePassword godoc
+// @Summary Change password
+// @Description Change the password of the current user, the current password is verified.
+// @Description All sessions of the user are revoked.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param params body dto.ChangePasswordDTO true "Passwords"
+// @Success 200 {object} http_dto.ResponseDTO
+// @Failure 400 {object} http_dto.ResponseDTO
+// @Failure 403 {object} http_dto.ResponseDTO
+// @Router /user/password [post]
+func (h *UserHandlers) ChangePassword(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	var errorList []*http_dto.ValidationError
+	params := dto.ChangePasswordDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
 	}
 
+	h.Logger.Debugf("Change password of user %s", userID)
+
+	cmd := command.ChangePasswordCommand{
+		ID:          userID,
+		OldPassword: params.OldPassword,
+		NewPassword: params.NewPassword,
+	}
 	_, err = h.Commands.Dispatch(ctx, cmd)
 	if err != nil {
-		h.Logger.Errorf("Failed to update user %v", err)
+		return h.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// RequestPasswordReset godoc
+// @Summary Request password reset
+// @Description Email a single-use password reset token to the user.
+// @Description The response is the same for unknown emails.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param params body dto.RequestPasswordResetDTO true "User email"
+// @Success 202 {object} http_dto.ResponseDTO
+// @Failure 400 {object} http_dto.ResponseDTO
+// @Router /user/password/reset [post]
+func (h *UserHandlers) RequestPasswordReset(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.RequestPasswordResetDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
 
 		return c.JSON(
-			http.StatusInternalServerError,
+			http.StatusBadRequest,
 			http_dto.ResponseDTO{
-				Status:  http.StatusInternalServerError,
+				Status:  http.StatusBadRequest,
 				Message: "error",
-				Error:   err.Error(),
+				Error:   validationErr,
 			},
 		)
 	}
 
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	cmd := command.RequestPasswordResetCommand{
+		Email: params.Email,
+	}
+	_, err = h.Commands.Dispatch(ctx, cmd)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusAccepted,
+		http_dto.ResponseDTO{
+			Status:  http.StatusAccepted,
+			Message: "success",
+		},
+	)
+}
+
+// ResetPassword godoc
+// @Summary Reset password
+// @Description Set a new password with the emailed reset token. All sessions of the user are revoked.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param id path string true "password reset token"
+// @Param params body dto.ResetPasswordDTO true "New password"
+// @Success 200 {object} http_dto.ResponseDTO
+// @Failure 400 {object} http_dto.ResponseDTO
+// @Failure 404 {object} http_dto.ResponseDTO
+// @Failure 410 {object} http_dto.ResponseDTO
+// @Router /user/password/reset/{id} [post]
+func (h *UserHandlers) ResetPassword(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	var errorList []*http_dto.ValidationError
+	params := dto.ResetPasswordDTO{}
+
+	// Parse given params
+	err := c.Bind(&params)
+	if err != nil {
+		var bindingError *echo.HTTPError
+		var validationErr string
+		if errors.As(err, &bindingError) {
+			validationErr = fmt.Sprint(bindingError.Message)
+		} else {
+			validationErr = err.Error()
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Error:   validationErr,
+			},
+		)
+	}
+
+	err = c.Validate(params)
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	h.Logger.Debugf("Reset password with id '%v'", c.Param("id"))
+
+	cmd := command.ResetPasswordCommand{
+		ID:       c.Param("id"),
+		Password: params.Password,
+	}
+	_, err = h.Commands.Dispatch(ctx, cmd)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
 	return c.JSON(
 		http.StatusOK,
 		http_dto.ResponseDTO{
@@ -266,7 +591,7 @@ func (h *UserHandlers) Update(c echo.Context) error {
 // @Success 200 {object} entity.User
 // @Router /user/{id} [get]
 func (h *UserHandlers) GetByID(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
 	defer cancel()
 
 	ctx, span := h.tracer.Start(ctx, "UserHandlers.GetByID")
@@ -317,7 +642,7 @@ func (h *UserHandlers) GetByID(c echo.Context) error {
 // @Success 200 {object} entity.User
 // @Router /me [get]
 func (h *UserHandlers) GetMe(c echo.Context) error {
-	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
 	defer cancel()
 
 	ctx, span := h.tracer.Start(ctx, "UserHandlers.GetMe")
@@ -379,6 +704,348 @@ func (h *UserHandlers) GetMe(c echo.Context) error {
 	)
 }
 
+// EnrollTwoFactor godoc
+// @Summary Enroll two-factor authentication
+// @Description Generate the secret of the authenticator app. The two-factor authentication
+// @Description is enabled once a first code is confirmed, until then the enrollment can be started again.
+// @Tags User
+// @Produce json
+// @Success 200 {object} http_dto.ResponseDTO{data=dto.TwoFactorEnrollmentDTO}
+// @Failure 409 {object} http_dto.ResponseDTO
+// @Router /user/2fa [post]
+func (h *UserHandlers) EnrollTwoFactor(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	res, err := h.Commands.Dispatch(ctx, command.EnrollTwoFactorCommand{ID: userID})
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	enrollment, ok := res.(command.EnrollTwoFactorResult)
+	if !ok {
+		return c.JSON(
+			http.StatusInternalServerError,
+			http_dto.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+			},
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data: dto.TwoFactorEnrollmentDTO{
+				Secret: enrollment.Secret,
+				URI:    enrollment.URI,
+			},
+		},
+	)
+}
+
+// EnableTwoFactor godoc
+// @Summary Enable two-factor authentication
+// @Description Confirm the enrollment with the first code of the authenticator app.
+// @Description The response holds the recovery codes, they are shown once.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param params body dto.TwoFactorCodeDTO true "Code of the authenticator app"
+// @Success 200 {object} http_dto.ResponseDTO{data=dto.RecoveryCodesDTO}
+// @Failure 403 {object} http_dto.ResponseDTO
+// @Router /user/2fa/enable [post]
+func (h *UserHandlers) EnableTwoFactor(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	params := dto.TwoFactorCodeDTO{}
+	if err := c.Bind(&params); err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	if err := c.Validate(params); err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	res, err := h.Commands.Dispatch(ctx, command.EnableTwoFactorCommand{ID: userID, Code: params.Code})
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	enabled, ok := res.(command.EnableTwoFactorResult)
+	if !ok {
+		return c.JSON(
+			http.StatusInternalServerError,
+			http_dto.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+			},
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data:    dto.RecoveryCodesDTO{RecoveryCodes: enabled.RecoveryCodes},
+		},
+	)
+}
+
+// DisableTwoFactor godoc
+// @Summary Disable two-factor authentication
+// @Description Turn the two-factor authentication off with a code of the authenticator app or a recovery code.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param params body dto.TwoFactorCodeDTO true "Code of the authenticator app or recovery code"
+// @Success 200 {object} http_dto.ResponseDTO
+// @Failure 403 {object} http_dto.ResponseDTO
+// @Failure 429 {object} http_dto.ResponseDTO
+// @Router /user/2fa/disable [post]
+func (h *UserHandlers) DisableTwoFactor(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	params := dto.TwoFactorCodeDTO{}
+	if err := c.Bind(&params); err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	if err := c.Validate(params); err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	_, err := h.Commands.Dispatch(ctx, command.DisableTwoFactorCommand{ID: userID, Code: params.Code})
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+		},
+	)
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary Regenerate recovery codes
+// @Description Replace the recovery codes, the unused ones stop working. The new codes are shown once.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param params body dto.TwoFactorCodeDTO true "Code of the authenticator app or recovery code"
+// @Success 200 {object} http_dto.ResponseDTO{data=dto.RecoveryCodesDTO}
+// @Failure 403 {object} http_dto.ResponseDTO
+// @Failure 429 {object} http_dto.ResponseDTO
+// @Router /user/2fa/recovery-codes [post]
+func (h *UserHandlers) RegenerateRecoveryCodes(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), requestTimeout)
+	defer cancel()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(
+			http.StatusForbidden,
+			http_dto.ResponseDTO{
+				Status:  http.StatusForbidden,
+				Message: "error",
+			},
+		)
+	}
+
+	params := dto.TwoFactorCodeDTO{}
+	if err := c.Bind(&params); err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	if err := c.Validate(params); err != nil {
+		return validationErrorResponse(c, err)
+	}
+
+	res, err := h.Commands.Dispatch(ctx, command.RegenerateRecoveryCodesCommand{ID: userID, Code: params.Code})
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	regenerated, ok := res.(command.RegenerateRecoveryCodesResult)
+	if !ok {
+		return c.JSON(
+			http.StatusInternalServerError,
+			http_dto.ResponseDTO{
+				Status:  http.StatusInternalServerError,
+				Message: "error",
+			},
+		)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		http_dto.ResponseDTO{
+			Status:  http.StatusOK,
+			Message: "success",
+			Data:    dto.RecoveryCodesDTO{RecoveryCodes: regenerated.RecoveryCodes},
+		},
+	)
+}
+
 func (h *UserHandlers) Delete(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
+
+// errorResponse maps domain errors to http status codes.
+func (h *UserHandlers) errorResponse(c echo.Context, err error) error {
+	var passwordErr *vo.PasswordError
+	if errors.As(err, &passwordErr) {
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  passwordErrors(passwordErr),
+			},
+		)
+	}
+
+	// The client is told when the codes may be tried again
+	var locked *entity.TwoFactorLockedError
+	if errors.As(err, &locked) {
+		retryAfter := int64(math.Ceil(locked.RetryAfter.Seconds()))
+		c.Response().Header().Set(echo.HeaderRetryAfter, strconv.FormatInt(max(retryAfter, 1), 10))
+
+		return c.JSON(
+			http.StatusTooManyRequests,
+			http_dto.ResponseDTO{
+				Status:  http.StatusTooManyRequests,
+				Message: "error",
+				Error:   entity.ErrTwoFactorLocked.Error(),
+			},
+		)
+	}
+
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain_core.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, command.ErrPermissionDenied),
+		errors.Is(err, entity.ErrInvalidPassword),
+		errors.Is(err, entity.ErrInvalidTwoFactorCode):
+		status = http.StatusForbidden
+	case errors.Is(err, domain_core.ErrAlreadyExist),
+		errors.Is(err, entity.ErrTwoFactorEnabled),
+		errors.Is(err, entity.ErrTwoFactorDisabled):
+		status = http.StatusConflict
+	case errors.Is(err, entity.ErrEmailChangeExpired),
+		errors.Is(err, entity.ErrPasswordResetExpired):
+		status = http.StatusGone
+	case errors.Is(err, vo.ErrEmptyFirstName),
+		errors.Is(err, domain_core.ErrInvalidEntity),
+		errors.Is(err, common.ErrEmptyUID),
+		errors.Is(err, common.ErrUIDBadFormat),
+		errors.Is(err, common.ErrEmptyEmail),
+		errors.Is(err, common.ErrBadFormat):
+		status = http.StatusBadRequest
+	default:
+		h.Logger.Errorf("user request failed: %v", err)
+	}
+
+	return c.JSON(
+		status,
+		http_dto.ResponseDTO{
+			Status:  status,
+			Message: "error",
+			Error:   err.Error(),
+		},
+	)
+}
+
+// validationErrorResponse reports the request which could not be bound or validated.
+func validationErrorResponse(c echo.Context, err error) error {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		var errorList []*http_dto.ValidationError
+		for _, e := range validationErrors {
+			errorList = append(errorList, &http_dto.ValidationError{
+				Field:  e.Field(),
+				Value:  e.Value(),
+				Reason: e.Tag(),
+			})
+		}
+
+		return c.JSON(
+			http.StatusBadRequest,
+			http_dto.ResponseDTO{
+				Status:  http.StatusBadRequest,
+				Message: "error",
+				Errors:  errorList,
+			},
+		)
+	}
+
+	validationErr := err.Error()
+	var bindingError *echo.HTTPError
+	if errors.As(err, &bindingError) {
+		validationErr = fmt.Sprint(bindingError.Message)
+	}
+
+	return c.JSON(
+		http.StatusBadRequest,
+		http_dto.ResponseDTO{
+			Status:  http.StatusBadRequest,
+			Message: "error",
+			Error:   validationErr,
+		},
+	)
+}
+
+// passwordErrors reports the violated password policy rules as validation errors.
+func passwordErrors(err *vo.PasswordError) []*http_dto.ValidationError {
+	errorList := make([]*http_dto.ValidationError, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		errorList = append(errorList, &http_dto.ValidationError{
+			Field:  "Password",
+			Reason: violation,
+		})
+	}
+
+	return errorList
+}
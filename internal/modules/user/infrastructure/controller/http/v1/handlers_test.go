@@ -10,13 +10,17 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/go-playground/validator"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	domain_core "github.com/KyKyPy3/clean/internal/domain/core"
 	common_http "github.com/KyKyPy3/clean/internal/infrastructure/controller/http"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/command"
 	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
 	handlers "github.com/KyKyPy3/clean/internal/modules/user/infrastructure/controller/http/v1"
 	mocks "github.com/KyKyPy3/clean/mocks/internal_/modules/user/infrastructure/controller/http/v1"
 	"github.com/KyKyPy3/clean/pkg/logger"
@@ -120,3 +124,363 @@ func TestFetchHandler(t *testing.T) {
 		})
 	}
 }
+
+type testValidator struct {
+	validator *validator.Validate
+}
+
+func (v *testValidator) Validate(i interface{}) error {
+	return v.validator.Struct(i)
+}
+
+func TestUpdateHandler(t *testing.T) {
+	t.Parallel()
+
+	// Create echo
+	e := echo.New()
+	e.Validator = &testValidator{validator: validator.New()}
+
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	userID := "d2a5e2c4-5f7b-4c4b-9d1e-0a8b7c6d5e4f"
+	name := "Alise"
+	email := "alise@new.com"
+
+	cases := []struct {
+		name        string
+		userID      string
+		body        string
+		respStatus  int
+		respMessage string
+		mockCommand interface{}
+		mockResp    interface{}
+		mockError   error
+	}{
+		{
+			name:        "Success",
+			userID:      userID,
+			body:        `{"name":"Alise"}`,
+			respStatus:  http.StatusOK,
+			respMessage: "success",
+			mockCommand: command.UpdateUserCommand{
+				ID:             userID,
+				ActorID:        userID,
+				OrganizationID: "org",
+				Name:           &name,
+			},
+			mockResp: false,
+		},
+		{
+			name:        "Email change pending",
+			userID:      userID,
+			body:        `{"email":"alise@new.com"}`,
+			respStatus:  http.StatusOK,
+			respMessage: "success",
+			mockCommand: command.UpdateUserCommand{
+				ID:             userID,
+				ActorID:        userID,
+				OrganizationID: "org",
+				Email:          &email,
+			},
+			mockResp: true,
+		},
+		{
+			name:        "Permission denied",
+			userID:      userID,
+			body:        `{"surname":"Cooper"}`,
+			respStatus:  http.StatusForbidden,
+			respMessage: "error",
+			mockCommand: mock.Anything,
+			mockError:   command.ErrPermissionDenied,
+		},
+		{
+			name:        "Email conflict",
+			userID:      userID,
+			body:        `{"email":"alise@email.com"}`,
+			respStatus:  http.StatusConflict,
+			respMessage: "error",
+			mockCommand: mock.Anything,
+			mockError:   domain_core.ErrAlreadyExist,
+		},
+		{
+			name:        "Invalid email",
+			userID:      userID,
+			body:        `{"email":"alise"}`,
+			respStatus:  http.StatusBadRequest,
+			respMessage: "error",
+		},
+		{
+			name:        "Empty name",
+			userID:      userID,
+			body:        `{"name":""}`,
+			respStatus:  http.StatusBadRequest,
+			respMessage: "error",
+		},
+		{
+			name:        "Unauthorized",
+			body:        `{"name":"Alise"}`,
+			respStatus:  http.StatusForbidden,
+			respMessage: "error",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			userCommandBusMock := mocks.NewCommandBus(t)
+			handler := handlers.UserHandlers{
+				Commands: userCommandBusMock,
+				Logger:   log,
+			}
+
+			if tc.mockCommand != nil {
+				userCommandBusMock.On("Dispatch", mock.Anything, tc.mockCommand).Return(tc.mockResp, tc.mockError).Once()
+			}
+
+			req, err := http.NewRequestWithContext(
+				context.TODO(),
+				http.MethodPatch,
+				"/api/v1/user/"+userID,
+				strings.NewReader(tc.body),
+			)
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(userID)
+			if tc.userID != "" {
+				c.Set("user_id", tc.userID)
+				c.Set("organization_id", "org")
+			}
+
+			err = handler.Update(c)
+			require.NoError(t, err)
+			assert.Equal(t, tc.respStatus, rec.Code)
+
+			var d *common_http.ResponseDTO
+			err = json.NewDecoder(rec.Body).Decode(&d)
+			require.NoError(t, err)
+			assert.Equal(t, tc.respMessage, d.Message)
+			if tc.respStatus == http.StatusOK {
+				assert.Equal(t, map[string]interface{}{"email_change_pending": tc.mockResp}, d.Data)
+			}
+		})
+	}
+}
+
+func TestConfirmEmailHandler(t *testing.T) {
+	t.Parallel()
+
+	// Create echo
+	e := echo.New()
+
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	changeID := "5b0c7a1e-8f3d-4e2a-b6c9-1d2e3f4a5b6c"
+
+	cases := []struct {
+		name       string
+		respStatus int
+		mockError  error
+	}{
+		{
+			name:       "Success",
+			respStatus: http.StatusOK,
+		},
+		{
+			name:       "Expired",
+			respStatus: http.StatusGone,
+			mockError:  entity.ErrEmailChangeExpired,
+		},
+		{
+			name:       "Not found",
+			respStatus: http.StatusNotFound,
+			mockError:  domain_core.ErrNotFound,
+		},
+		{
+			name:       "Email taken",
+			respStatus: http.StatusConflict,
+			mockError:  domain_core.ErrAlreadyExist,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			userCommandBusMock := mocks.NewCommandBus(t)
+			handler := handlers.UserHandlers{
+				Commands: userCommandBusMock,
+				Logger:   log,
+			}
+
+			userCommandBusMock.On("Dispatch", mock.Anything, command.ConfirmEmailChangeCommand{ID: changeID}).
+				Return(nil, tc.mockError).Once()
+
+			req, err := http.NewRequestWithContext(
+				context.TODO(),
+				http.MethodPost,
+				"/api/v1/user/email/"+changeID,
+				strings.NewReader(""),
+			)
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(changeID)
+
+			err = handler.ConfirmEmail(c)
+			require.NoError(t, err)
+			assert.Equal(t, tc.respStatus, rec.Code)
+		})
+	}
+}
+
+func TestConfirmEmailRoute(t *testing.T) {
+	t.Parallel()
+
+	e := echo.New()
+
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	changeID := "5b0c7a1e-8f3d-4e2a-b6c9-1d2e3f4a5b6c"
+	userCommandBusMock := mocks.NewCommandBus(t)
+	userCommandBusMock.On("Dispatch", mock.Anything, command.ConfirmEmailChangeCommand{ID: changeID}).
+		Return(nil, nil).Once()
+	userQueryBusMock := mocks.NewQueryBus(t)
+	userQueryBusMock.On("Ask", mock.Anything, mock.Anything).Return(nil, domain_core.ErrNotFound).Maybe()
+	handlers.NewUserHandlers(e.Group("/api/v1"), e.Group("/api/v1"), userCommandBusMock, userQueryBusMock, nil, log)
+
+	// Following the link doesn't apply the change, the page of the web client posts the token
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/user/email/"+changeID, nil))
+	assert.NotEqual(t, http.StatusOK, rec.Code)
+	userCommandBusMock.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/user/email/"+changeID, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestChangePasswordHandler(t *testing.T) {
+	t.Parallel()
+
+	// Create echo
+	e := echo.New()
+	e.Validator = &testValidator{validator: validator.New()}
+
+	log := logger.NewLogger(logger.Config{
+		Mode: "test",
+	})
+	log.Init()
+
+	userID := "d2a5e2c4-5f7b-4c4b-9d1e-0a8b7c6d5e4f"
+
+	cases := []struct {
+		name        string
+		userID      string
+		body        string
+		respStatus  int
+		respErrors  []*common_http.ValidationError
+		mockCommand interface{}
+		mockError   error
+	}{
+		{
+			name:       "Success",
+			userID:     userID,
+			body:       `{"old_password":"12345","new_password":"54321"}`,
+			respStatus: http.StatusOK,
+			mockCommand: command.ChangePasswordCommand{
+				ID:          userID,
+				OldPassword: "12345",
+				NewPassword: "54321",
+			},
+		},
+		{
+			name:        "Wrong password",
+			userID:      userID,
+			body:        `{"old_password":"password","new_password":"54321"}`,
+			respStatus:  http.StatusForbidden,
+			mockCommand: mock.Anything,
+			mockError:   entity.ErrInvalidPassword,
+		},
+		{
+			name:       "Weak password",
+			userID:     userID,
+			body:       `{"old_password":"12345","new_password":"54321"}`,
+			respStatus: http.StatusBadRequest,
+			respErrors: []*common_http.ValidationError{
+				{Field: "Password", Reason: vo.PasswordTooShort},
+				{Field: "Password", Reason: vo.PasswordNoUpper},
+			},
+			mockCommand: mock.Anything,
+			mockError:   &vo.PasswordError{Violations: []string{vo.PasswordTooShort, vo.PasswordNoUpper}},
+		},
+		{
+			name:       "Missing new password",
+			userID:     userID,
+			body:       `{"old_password":"12345"}`,
+			respStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Unauthorized",
+			body:       `{"old_password":"12345","new_password":"54321"}`,
+			respStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			userCommandBusMock := mocks.NewCommandBus(t)
+			handler := handlers.UserHandlers{
+				Commands: userCommandBusMock,
+				Logger:   log,
+			}
+
+			if tc.mockCommand != nil {
+				userCommandBusMock.On("Dispatch", mock.Anything, tc.mockCommand).Return(nil, tc.mockError).Once()
+			}
+
+			req, err := http.NewRequestWithContext(
+				context.TODO(),
+				http.MethodPost,
+				"/api/v1/user/password",
+				strings.NewReader(tc.body),
+			)
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tc.userID != "" {
+				c.Set("user_id", tc.userID)
+			}
+
+			err = handler.ChangePassword(c)
+			require.NoError(t, err)
+			assert.Equal(t, tc.respStatus, rec.Code)
+
+			if tc.respErrors != nil {
+				var d *common_http.ResponseDTO
+				err = json.NewDecoder(rec.Body).Decode(&d)
+				require.NoError(t, err)
+				assert.Equal(t, tc.respErrors, d.Errors)
+			}
+		})
+	}
+}
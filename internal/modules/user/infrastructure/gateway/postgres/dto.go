@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/KyKyPy3/clean/internal/domain/common"
@@ -53,3 +54,130 @@ func UserToDB(user entity.User) DBUser {
 		Password:   user.Password(),
 	}
 }
+
+// DBEmailChange Database email change representation.
+type DBEmailChange struct {
+	ID        string    `db:"id"`
+	UserID    string    `db:"user_id"`
+	Email     string    `db:"email"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// EmailChangeFromDB Convert database email change model to domain model.
+func EmailChangeFromDB(dbChange DBEmailChange) (entity.EmailChange, error) {
+	entityID, err := common.ParseUID(dbChange.ID)
+	if err != nil {
+		return entity.EmailChange{}, err
+	}
+
+	userID, err := common.ParseUID(dbChange.UserID)
+	if err != nil {
+		return entity.EmailChange{}, err
+	}
+
+	email, err := common.NewEmail(dbChange.Email)
+	if err != nil {
+		return entity.EmailChange{}, err
+	}
+
+	return entity.HydrateEmailChange(entityID, userID, email, dbChange.ExpiresAt, dbChange.CreatedAt), nil
+}
+
+// EmailChangeToDB Convert domain email change model to database model.
+func EmailChangeToDB(change entity.EmailChange) DBEmailChange {
+	return DBEmailChange{
+		ID:        change.ID().String(),
+		UserID:    change.UserID().String(),
+		Email:     change.Email().String(),
+		ExpiresAt: change.ExpiresAt(),
+		CreatedAt: change.CreatedAt(),
+	}
+}
+
+// DBPasswordReset Database password reset representation.
+type DBPasswordReset struct {
+	ID        string    `db:"id"`
+	UserID    string    `db:"user_id"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// PasswordResetFromDB Convert database password reset model to domain model.
+func PasswordResetFromDB(dbReset DBPasswordReset) (entity.PasswordReset, error) {
+	entityID, err := common.ParseUID(dbReset.ID)
+	if err != nil {
+		return entity.PasswordReset{}, err
+	}
+
+	userID, err := common.ParseUID(dbReset.UserID)
+	if err != nil {
+		return entity.PasswordReset{}, err
+	}
+
+	return entity.HydratePasswordReset(entityID, userID, dbReset.ExpiresAt, dbReset.CreatedAt), nil
+}
+
+// PasswordResetToDB Convert domain password reset model to database model.
+func PasswordResetToDB(reset entity.PasswordReset) DBPasswordReset {
+	return DBPasswordReset{
+		ID:        reset.ID().String(),
+		UserID:    reset.UserID().String(),
+		ExpiresAt: reset.ExpiresAt(),
+		CreatedAt: reset.CreatedAt(),
+	}
+}
+
+// DBTwoFactor Database two-factor representation.
+type DBTwoFactor struct {
+	UserID        string    `db:"user_id"`
+	Secret        string    `db:"secret"`
+	Enabled       bool      `db:"enabled"`
+	RecoveryCodes string    `db:"recovery_codes"`
+	LastStep      int64     `db:"last_step"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
+
+// TwoFactorFromDB Convert database two-factor model to domain model.
+func TwoFactorFromDB(dbTwoFactor DBTwoFactor) (entity.TwoFactor, error) {
+	userID, err := common.ParseUID(dbTwoFactor.UserID)
+	if err != nil {
+		return entity.TwoFactor{}, err
+	}
+
+	recoveryCodes := make([]string, 0)
+	if err = json.Unmarshal([]byte(dbTwoFactor.RecoveryCodes), &recoveryCodes); err != nil {
+		return entity.TwoFactor{}, err
+	}
+
+	twoFactor := entity.HydrateTwoFactor(
+		userID,
+		dbTwoFactor.Secret,
+		dbTwoFactor.Enabled,
+		recoveryCodes,
+		dbTwoFactor.LastStep,
+		dbTwoFactor.CreatedAt,
+		dbTwoFactor.UpdatedAt,
+	)
+
+	return twoFactor, nil
+}
+
+// TwoFactorToDB Convert domain two-factor model to database model.
+func TwoFactorToDB(twoFactor entity.TwoFactor) (DBTwoFactor, error) {
+	recoveryCodes, err := json.Marshal(twoFactor.RecoveryCodes())
+	if err != nil {
+		return DBTwoFactor{}, err
+	}
+
+	return DBTwoFactor{
+		UserID:        twoFactor.UserID().String(),
+		Secret:        twoFactor.Secret(),
+		Enabled:       twoFactor.Enabled(),
+		RecoveryCodes: string(recoveryCodes),
+		LastStep:      twoFactor.LastStep(),
+		CreatedAt:     twoFactor.CreatedAt(),
+		UpdatedAt:     twoFactor.UpdatedAt(),
+	}, nil
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+type emailChangePgStorage struct {
+	db     *sqlx.DB
+	logger logger.Logger
+	tracer trace.Tracer
+	getter *trmsqlx.CtxGetter
+}
+
+func NewEmailChangePgStorage(db *sqlx.DB, getter *trmsqlx.CtxGetter, logger logger.Logger) ports.EmailChangePgStorage {
+	return &emailChangePgStorage{
+		db:     db,
+		logger: logger,
+		getter: getter,
+		tracer: otel.Tracer(""),
+	}
+}
+
+// Create stores the email change, a pending change of the same user is replaced.
+func (e *emailChangePgStorage) Create(ctx context.Context, change entity.EmailChange) error {
+	ctx, span := e.tracer.Start(ctx, "emailChangePgStorage.Create")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Create.Organization")
+	}
+
+	dbChange := EmailChangeToDB(change)
+	res, err := e.getter.DefaultTrOrDB(ctx, e.db).ExecContext(
+		ctx,
+		CreateEmailChangeSQL,
+		dbChange.ID,
+		dbChange.UserID,
+		dbChange.Email,
+		dbChange.ExpiresAt,
+		dbChange.CreatedAt,
+		organizationID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Create.ExecContext")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "Create.RowsAffected")
+	}
+
+	if rowsAffected == 0 {
+		return core.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetByID Get email change by its confirmation token.
+func (e *emailChangePgStorage) GetByID(ctx context.Context, id common.UID) (entity.EmailChange, error) {
+	ctx, span := e.tracer.Start(ctx, "emailChangePgStorage.GetByID")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return entity.EmailChange{}, errors.Wrap(err, "GetByID.Organization")
+	}
+
+	changes := make([]DBEmailChange, 0)
+	if err = e.getter.DefaultTrOrDB(ctx, e.db).SelectContext(
+		ctx,
+		&changes,
+		GetEmailChangeSQL,
+		id.String(),
+		organizationID,
+	); err != nil {
+		return entity.EmailChange{}, errors.Wrap(err, "GetByID.SelectContext")
+	}
+
+	if len(changes) == 0 {
+		return entity.EmailChange{}, core.ErrNotFound
+	}
+
+	change, err := EmailChangeFromDB(changes[0])
+	if err != nil {
+		return entity.EmailChange{}, errors.Wrap(err, "GetByID.EmailChangeFromDB")
+	}
+
+	return change, nil
+}
+
+// DeleteByUser removes the pending email change of the user.
+func (e *emailChangePgStorage) DeleteByUser(ctx context.Context, userID common.UID) error {
+	ctx, span := e.tracer.Start(ctx, "emailChangePgStorage.DeleteByUser")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "DeleteByUser.Organization")
+	}
+
+	if _, err = e.getter.DefaultTrOrDB(ctx, e.db).ExecContext(
+		ctx,
+		DeleteEmailChangeSQL,
+		userID.String(),
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "DeleteByUser.ExecContext")
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+type passwordResetPgStorage struct {
+	db     *sqlx.DB
+	logger logger.Logger
+	tracer trace.Tracer
+	getter *trmsqlx.CtxGetter
+}
+
+func NewPasswordResetPgStorage(
+	db *sqlx.DB,
+	getter *trmsqlx.CtxGetter,
+	logger logger.Logger,
+) ports.PasswordResetPgStorage {
+	return &passwordResetPgStorage{
+		db:     db,
+		logger: logger,
+		getter: getter,
+		tracer: otel.Tracer(""),
+	}
+}
+
+// Create stores the password reset, a previous reset of the same user is replaced
+// so only the latest emailed token can be used.
+func (p *passwordResetPgStorage) Create(ctx context.Context, reset entity.PasswordReset) error {
+	ctx, span := p.tracer.Start(ctx, "passwordResetPgStorage.Create")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Create.Organization")
+	}
+
+	dbReset := PasswordResetToDB(reset)
+	res, err := p.getter.DefaultTrOrDB(ctx, p.db).ExecContext(
+		ctx,
+		CreatePasswordResetSQL,
+		dbReset.ID,
+		dbReset.UserID,
+		dbReset.ExpiresAt,
+		dbReset.CreatedAt,
+		organizationID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Create.ExecContext")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "Create.RowsAffected")
+	}
+
+	if rowsAffected == 0 {
+		return core.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetByID Get password reset by its token.
+func (p *passwordResetPgStorage) GetByID(ctx context.Context, id common.UID) (entity.PasswordReset, error) {
+	ctx, span := p.tracer.Start(ctx, "passwordResetPgStorage.GetByID")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return entity.PasswordReset{}, errors.Wrap(err, "GetByID.Organization")
+	}
+
+	resets := make([]DBPasswordReset, 0)
+	if err = p.getter.DefaultTrOrDB(ctx, p.db).SelectContext(
+		ctx,
+		&resets,
+		GetPasswordResetSQL,
+		id.String(),
+		organizationID,
+	); err != nil {
+		return entity.PasswordReset{}, errors.Wrap(err, "GetByID.SelectContext")
+	}
+
+	if len(resets) == 0 {
+		return entity.PasswordReset{}, core.ErrNotFound
+	}
+
+	reset, err := PasswordResetFromDB(resets[0])
+	if err != nil {
+		return entity.PasswordReset{}, errors.Wrap(err, "GetByID.PasswordResetFromDB")
+	}
+
+	return reset, nil
+}
+
+// DeleteByUser removes the password reset of the user, the token is used once.
+func (p *passwordResetPgStorage) DeleteByUser(ctx context.Context, userID common.UID) error {
+	ctx, span := p.tracer.Start(ctx, "passwordResetPgStorage.DeleteByUser")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "DeleteByUser.Organization")
+	}
+
+	if _, err = p.getter.DefaultTrOrDB(ctx, p.db).ExecContext(
+		ctx,
+		DeletePasswordResetSQL,
+		userID.String(),
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "DeleteByUser.ExecContext")
+	}
+
+	return nil
+}
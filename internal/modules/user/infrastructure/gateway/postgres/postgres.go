@@ -15,6 +15,7 @@ import (
 	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
 	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
 	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
 )
 
 type userPgStorage struct {
@@ -33,12 +34,18 @@ func NewUserPgStorage(db *sqlx.DB, getter *trmsqlx.CtxGetter, logger logger.Logg
 	}
 }
 
-// Fetch users with given limit.
+// Fetch users with given limit. Like every lookup of the storage it is limited
+// to the members of the organization of the context, only Create is not scoped.
 // TODO: think about offset - use numeric or time offset?
 func (u *userPgStorage) Fetch(ctx context.Context, limit, offset int64) ([]entity.User, error) {
 	ctx, span := u.tracer.Start(ctx, "userPgStorage.Fetch")
 	defer span.End()
 
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "Fetch.Organization")
+	}
+
 	stmt, err := u.getter.DefaultTrOrDB(ctx, u.db).PreparexContext(ctx, FetchSQL)
 	if err != nil {
 		return nil, errors.Wrap(err, "Fetch.PreparexContext")
@@ -50,7 +57,7 @@ func (u *userPgStorage) Fetch(ctx context.Context, limit, offset int64) ([]entit
 		}
 	}()
 
-	rows, err := stmt.QueryxContext(ctx, limit, offset)
+	rows, err := stmt.QueryxContext(ctx, limit, offset, organizationID)
 	if err != nil || rows.Err() != nil {
 		u.logger.Errorf("[userPgStorage.Fetch] Can't fetch user with limit %d and offset %d, err: %w", limit, offset, err)
 		return nil, errors.Wrap(err, "Fetch.QueryxContext")
@@ -121,6 +128,11 @@ func (u *userPgStorage) Update(ctx context.Context, d entity.User) error {
 	ctx, span := u.tracer.Start(ctx, "userPgStorage.Update")
 	defer span.End()
 
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Update.Organization")
+	}
+
 	stmt, err := u.getter.DefaultTrOrDB(ctx, u.db).PreparexContext(ctx, UpdateSQL)
 	if err != nil {
 		return errors.Wrap(err, "Update.PreparexContext")
@@ -140,6 +152,7 @@ func (u *userPgStorage) Update(ctx context.Context, d entity.User) error {
 		user.Surname,
 		user.Middlename,
 		user.Email,
+		organizationID,
 	).StructScan(&user); err != nil {
 		return errors.Wrap(err, "Update.QueryRowxContext")
 	}
@@ -147,6 +160,39 @@ func (u *userPgStorage) Update(ctx context.Context, d entity.User) error {
 	return nil
 }
 
+// UpdatePassword stores the password hash of the user.
+func (u *userPgStorage) UpdatePassword(ctx context.Context, d entity.User) error {
+	ctx, span := u.tracer.Start(ctx, "userPgStorage.UpdatePassword")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "UpdatePassword.Organization")
+	}
+
+	res, err := u.getter.DefaultTrOrDB(ctx, u.db).ExecContext(
+		ctx,
+		UpdatePasswordSQL,
+		d.ID().String(),
+		d.Password(),
+		organizationID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "UpdatePassword.ExecContext")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "UpdatePassword.RowsAffected")
+	}
+
+	if rowsAffected == 0 {
+		return core.ErrNotFound
+	}
+
+	return nil
+}
+
 // GetByID Get user by id.
 func (u *userPgStorage) GetByID(ctx context.Context, id common.UID) (entity.User, error) {
 	ctx, span := u.tracer.Start(ctx, "userPgStorage.GetByID")
@@ -164,6 +210,11 @@ func (u *userPgStorage) GetByEmail(ctx context.Context, email common.Email) (ent
 }
 
 func (u *userPgStorage) getByParam(ctx context.Context, sqlQuery string, param any) (entity.User, error) {
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return entity.User{}, errors.Wrap(err, "getByParam.Organization")
+	}
+
 	stmt, err := u.getter.DefaultTrOrDB(ctx, u.db).PreparexContext(ctx, sqlQuery)
 	if err != nil {
 		return entity.User{}, errors.Wrap(err, "getByParam.PreparexContext")
@@ -175,7 +226,7 @@ func (u *userPgStorage) getByParam(ctx context.Context, sqlQuery string, param a
 		}
 	}()
 
-	rows, err := stmt.QueryxContext(ctx, param)
+	rows, err := stmt.QueryxContext(ctx, param, organizationID)
 	if err != nil || rows.Err() != nil {
 		u.logger.Errorf("Can't fetch user by param %v, err: %v", param, err)
 		return entity.User{}, errors.Wrap(err, "getByParam.QueryxContext")
@@ -220,6 +271,11 @@ func (u *userPgStorage) Delete(ctx context.Context, id common.UID) error {
 	ctx, span := u.tracer.Start(ctx, "userPgStorage.Delete")
 	defer span.End()
 
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Delete.Organization")
+	}
+
 	stmt, err := u.getter.DefaultTrOrDB(ctx, u.db).PreparexContext(ctx, DeleteSQL)
 	if err != nil {
 		return errors.Wrap(err, "Delete.PreparexContext")
@@ -231,7 +287,7 @@ func (u *userPgStorage) Delete(ctx context.Context, id common.UID) error {
 		}
 	}()
 
-	res, err := stmt.ExecContext(ctx, id.GetID())
+	res, err := stmt.ExecContext(ctx, id.GetID(), organizationID)
 	if err != nil {
 		return errors.Wrap(err, "Delete.ExecContext")
 	}
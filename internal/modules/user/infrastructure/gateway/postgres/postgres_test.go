@@ -16,6 +16,7 @@ import (
 
 	"github.com/KyKyPy3/clean/internal/modules/user/infrastructure/gateway/postgres"
 	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
 )
 
 func TestFetch(t *testing.T) {
@@ -26,6 +27,9 @@ func TestFetch(t *testing.T) {
 	})
 	log.Init()
 
+	orgID := uuid.New().String()
+	ctx := tenant.WithOrganization(context.TODO(), orgID)
+
 	t.Run("Successfully retrieve users", func(t *testing.T) {
 		mockDB, mock, err := sqlmock.New()
 		if err != nil {
@@ -71,10 +75,10 @@ func TestFetch(t *testing.T) {
 
 		mock.ExpectPrepare(regexp.QuoteMeta(postgres.FetchSQL))
 		mock.ExpectQuery(regexp.QuoteMeta(postgres.FetchSQL)).
-			WithArgs(limit, offset).
+			WithArgs(limit, offset, orgID).
 			WillReturnRows(rows)
 
-		users, err := repo.Fetch(context.TODO(), limit, offset)
+		users, err := repo.Fetch(ctx, limit, offset)
 		require.NoError(t, err)
 
 		assert.Len(t, users, 1)
@@ -120,10 +124,10 @@ func TestFetch(t *testing.T) {
 
 		mock.ExpectPrepare(regexp.QuoteMeta(postgres.FetchSQL))
 		mock.ExpectQuery(regexp.QuoteMeta(postgres.FetchSQL)).
-			WithArgs(limit, offset).
+			WithArgs(limit, offset, orgID).
 			WillReturnRows(rows)
 
-		users, err := repo.Fetch(context.TODO(), limit, offset)
+		users, err := repo.Fetch(ctx, limit, offset)
 		require.NoError(t, err)
 
 		assert.Empty(t, users)
@@ -150,10 +154,10 @@ func TestFetch(t *testing.T) {
 
 		mock.ExpectPrepare(regexp.QuoteMeta(postgres.FetchSQL))
 		mock.ExpectQuery(regexp.QuoteMeta(postgres.FetchSQL)).
-			WithArgs(limit, offset).
+			WithArgs(limit, offset, orgID).
 			WillReturnError(sql.ErrNoRows)
 
-		users, err := repo.Fetch(context.TODO(), limit, offset)
+		users, err := repo.Fetch(ctx, limit, offset)
 		require.ErrorIs(t, err, sql.ErrNoRows)
 		assert.Empty(t, users)
 
@@ -177,7 +181,7 @@ func TestFetch(t *testing.T) {
 		mock.ExpectPrepare(regexp.QuoteMeta(postgres.FetchSQL)).
 			WillReturnError(sql.ErrConnDone)
 
-		users, err := repo.Fetch(context.TODO(), int64(10), int64(0))
+		users, err := repo.Fetch(ctx, int64(10), int64(0))
 		require.ErrorIs(t, err, sql.ErrConnDone)
 		assert.Empty(t, users)
 
@@ -223,14 +227,36 @@ func TestFetch(t *testing.T) {
 
 		mock.ExpectPrepare(regexp.QuoteMeta(postgres.FetchSQL))
 		mock.ExpectQuery(regexp.QuoteMeta(postgres.FetchSQL)).
-			WithArgs(limit, offset).
+			WithArgs(limit, offset, orgID).
 			WillReturnRows(rows)
 
-		_, err = repo.Fetch(context.TODO(), limit, offset)
+		_, err = repo.Fetch(ctx, limit, offset)
 		require.Error(t, err)
 
 		// ensure that all expectations are met in the mock
 		errExpectations := mock.ExpectationsWereMet()
 		assert.NoError(t, errExpectations)
 	})
+
+	t.Run("Failed without organization", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() {
+			_ = mockDB.Close()
+		}()
+		sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+
+		repo := postgres.NewUserPgStorage(sqlxDB, trmsqlx.DefaultCtxGetter, log)
+
+		// Queries never run outside an organization scope
+		users, err := repo.Fetch(context.TODO(), int64(10), int64(0))
+		require.ErrorIs(t, err, tenant.ErrNoOrganization)
+		assert.Empty(t, users)
+
+		// ensure that all expectations are met in the mock
+		errExpectations := mock.ExpectationsWereMet()
+		assert.NoError(t, errExpectations)
+	})
 }
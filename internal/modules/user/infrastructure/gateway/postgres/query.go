@@ -18,6 +18,39 @@ var (
 	//go:embed query/getByID.sql
 	GetByIDSQL string
 
+	//go:embed query/updatePassword.sql
+	UpdatePasswordSQL string
+
 	//go:embed query/delete.sql
 	DeleteSQL string
+
+	//go:embed query/createEmailChange.sql
+	CreateEmailChangeSQL string
+
+	//go:embed query/getEmailChange.sql
+	GetEmailChangeSQL string
+
+	//go:embed query/deleteEmailChange.sql
+	DeleteEmailChangeSQL string
+
+	//go:embed query/createPasswordReset.sql
+	CreatePasswordResetSQL string
+
+	//go:embed query/getPasswordReset.sql
+	GetPasswordResetSQL string
+
+	//go:embed query/deletePasswordReset.sql
+	DeletePasswordResetSQL string
+
+	//go:embed query/createTwoFactor.sql
+	CreateTwoFactorSQL string
+
+	//go:embed query/getTwoFactor.sql
+	GetTwoFactorSQL string
+
+	//go:embed query/updateTwoFactor.sql
+	UpdateTwoFactorSQL string
+
+	//go:embed query/deleteTwoFactor.sql
+	DeleteTwoFactorSQL string
 )
@@ -0,0 +1,11 @@
+INSERT INTO user_email_changes (id, user_id, email, expires_at, created_at)
+SELECT $1::varchar, $2::varchar, $3::varchar, $4::timestamptz, $5::timestamptz
+WHERE $6 = '' OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.user_id = $2 AND m.organization_id = $6
+)
+ON CONFLICT (user_id) DO UPDATE
+SET id = EXCLUDED.id,
+    email = EXCLUDED.email,
+    expires_at = EXCLUDED.expires_at,
+    created_at = EXCLUDED.created_at
\ No newline at end of file
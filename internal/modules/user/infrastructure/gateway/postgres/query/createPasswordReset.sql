@@ -0,0 +1,10 @@
+INSERT INTO user_password_resets (id, user_id, expires_at, created_at)
+SELECT $1::varchar, $2::varchar, $3::timestamptz, $4::timestamptz
+WHERE $5 = '' OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.user_id = $2 AND m.organization_id = $5
+)
+ON CONFLICT (user_id) DO UPDATE
+SET id = EXCLUDED.id,
+    expires_at = EXCLUDED.expires_at,
+    created_at = EXCLUDED.created_at
\ No newline at end of file
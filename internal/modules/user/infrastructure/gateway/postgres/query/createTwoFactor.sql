@@ -0,0 +1,13 @@
+INSERT INTO user_two_factors (user_id, secret, enabled, recovery_codes, last_step, created_at, updated_at)
+SELECT $1::varchar, $2::varchar, FALSE, $3::jsonb, $4::bigint, $5::timestamptz, $6::timestamptz
+WHERE $7 = '' OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.user_id = $1 AND m.organization_id = $7
+)
+ON CONFLICT (user_id) DO UPDATE
+SET secret = EXCLUDED.secret,
+    recovery_codes = EXCLUDED.recovery_codes,
+    last_step = EXCLUDED.last_step,
+    created_at = EXCLUDED.created_at,
+    updated_at = EXCLUDED.updated_at
+WHERE user_two_factors.enabled = FALSE
\ No newline at end of file
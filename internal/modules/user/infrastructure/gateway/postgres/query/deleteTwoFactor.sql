@@ -0,0 +1,6 @@
+DELETE FROM user_two_factors
+WHERE user_id = $1
+  AND ($2 = '' OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.user_id = user_two_factors.user_id AND m.organization_id = $2
+))
\ No newline at end of file
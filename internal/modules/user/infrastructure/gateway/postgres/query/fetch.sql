@@ -7,5 +7,9 @@ SELECT
     created_at,
     updated_at
 FROM users
+WHERE ($3 = '' OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.user_id = users.id AND m.organization_id = $3
+))
 ORDER BY created_at
-LIMIT $1 OFFSET $2
+LIMIT $1 OFFSET $2
\ No newline at end of file
@@ -1,3 +1,7 @@
-SELECT id, name, surname, middlename, email, created_at, updated_at
+SELECT id, name, surname, middlename, email, password, created_at, updated_at
 FROM users
 WHERE id = $1
+  AND ($2 = '' OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.user_id = users.id AND m.organization_id = $2
+))
\ No newline at end of file
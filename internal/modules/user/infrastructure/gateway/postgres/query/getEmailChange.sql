@@ -0,0 +1,7 @@
+SELECT id, user_id, email, expires_at, created_at
+FROM user_email_changes
+WHERE id = $1
+  AND ($2 = '' OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.user_id = user_email_changes.user_id AND m.organization_id = $2
+))
\ No newline at end of file
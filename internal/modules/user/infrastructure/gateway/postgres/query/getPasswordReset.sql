@@ -0,0 +1,7 @@
+SELECT id, user_id, expires_at, created_at
+FROM user_password_resets
+WHERE id = $1
+  AND ($2 = '' OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.user_id = user_password_resets.user_id AND m.organization_id = $2
+))
\ No newline at end of file
@@ -4,4 +4,8 @@ SET name = $2,
     middlename = $4,
     email = $5
 WHERE id = $1
+  AND ($6 = '' OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.user_id = users.id AND m.organization_id = $6
+))
 RETURNING id
\ No newline at end of file
@@ -0,0 +1,7 @@
+UPDATE users
+SET password = $2
+WHERE id = $1
+  AND ($3 = '' OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.user_id = users.id AND m.organization_id = $3
+))
\ No newline at end of file
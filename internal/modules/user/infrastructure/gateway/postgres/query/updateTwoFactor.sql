@@ -0,0 +1,10 @@
+UPDATE user_two_factors
+SET enabled = $2,
+    recovery_codes = $3::jsonb,
+    last_step = $4,
+    updated_at = $5
+WHERE user_id = $1
+  AND ($6 = '' OR EXISTS (
+    SELECT 1 FROM organization_members m
+    WHERE m.user_id = user_two_factors.user_id AND m.organization_id = $6
+))
\ No newline at end of file
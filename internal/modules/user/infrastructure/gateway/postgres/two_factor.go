@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"context"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/KyKyPy3/clean/internal/domain/common"
+	"github.com/KyKyPy3/clean/internal/domain/core"
+	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
+	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
+	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+type twoFactorPgStorage struct {
+	db     *sqlx.DB
+	logger logger.Logger
+	tracer trace.Tracer
+	getter *trmsqlx.CtxGetter
+}
+
+func NewTwoFactorPgStorage(
+	db *sqlx.DB,
+	getter *trmsqlx.CtxGetter,
+	logger logger.Logger,
+) ports.TwoFactorPgStorage {
+	return &twoFactorPgStorage{
+		db:     db,
+		logger: logger,
+		getter: getter,
+		tracer: otel.Tracer(""),
+	}
+}
+
+// Create stores the enrollment, a previous enrollment of the user which was not
+// confirmed is replaced. Enabled two-factors are not replaced.
+func (t *twoFactorPgStorage) Create(ctx context.Context, twoFactor entity.TwoFactor) error {
+	ctx, span := t.tracer.Start(ctx, "twoFactorPgStorage.Create")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Create.Organization")
+	}
+
+	dbTwoFactor, err := TwoFactorToDB(twoFactor)
+	if err != nil {
+		return errors.Wrap(err, "Create.TwoFactorToDB")
+	}
+
+	res, err := t.getter.DefaultTrOrDB(ctx, t.db).ExecContext(
+		ctx,
+		CreateTwoFactorSQL,
+		dbTwoFactor.UserID,
+		dbTwoFactor.Secret,
+		dbTwoFactor.RecoveryCodes,
+		dbTwoFactor.LastStep,
+		dbTwoFactor.CreatedAt,
+		dbTwoFactor.UpdatedAt,
+		organizationID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Create.ExecContext")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "Create.RowsAffected")
+	}
+
+	if rowsAffected == 0 {
+		return core.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetByUser Get two-factor of the user.
+func (t *twoFactorPgStorage) GetByUser(ctx context.Context, userID common.UID) (entity.TwoFactor, error) {
+	ctx, span := t.tracer.Start(ctx, "twoFactorPgStorage.GetByUser")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return entity.TwoFactor{}, errors.Wrap(err, "GetByUser.Organization")
+	}
+
+	twoFactors := make([]DBTwoFactor, 0)
+	if err = t.getter.DefaultTrOrDB(ctx, t.db).SelectContext(
+		ctx,
+		&twoFactors,
+		GetTwoFactorSQL,
+		userID.String(),
+		organizationID,
+	); err != nil {
+		return entity.TwoFactor{}, errors.Wrap(err, "GetByUser.SelectContext")
+	}
+
+	if len(twoFactors) == 0 {
+		return entity.TwoFactor{}, core.ErrNotFound
+	}
+
+	twoFactor, err := TwoFactorFromDB(twoFactors[0])
+	if err != nil {
+		return entity.TwoFactor{}, errors.Wrap(err, "GetByUser.TwoFactorFromDB")
+	}
+
+	return twoFactor, nil
+}
+
+// Update stores the state, the recovery codes and the latest used code of the two-factor.
+func (t *twoFactorPgStorage) Update(ctx context.Context, twoFactor entity.TwoFactor) error {
+	ctx, span := t.tracer.Start(ctx, "twoFactorPgStorage.Update")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Update.Organization")
+	}
+
+	dbTwoFactor, err := TwoFactorToDB(twoFactor)
+	if err != nil {
+		return errors.Wrap(err, "Update.TwoFactorToDB")
+	}
+
+	res, err := t.getter.DefaultTrOrDB(ctx, t.db).ExecContext(
+		ctx,
+		UpdateTwoFactorSQL,
+		dbTwoFactor.UserID,
+		dbTwoFactor.Enabled,
+		dbTwoFactor.RecoveryCodes,
+		dbTwoFactor.LastStep,
+		dbTwoFactor.UpdatedAt,
+		organizationID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Update.ExecContext")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "Update.RowsAffected")
+	}
+
+	if rowsAffected == 0 {
+		return core.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteByUser removes the two-factor of the user.
+func (t *twoFactorPgStorage) DeleteByUser(ctx context.Context, userID common.UID) error {
+	ctx, span := t.tracer.Start(ctx, "twoFactorPgStorage.DeleteByUser")
+	defer span.End()
+
+	organizationID, err := tenant.Organization(ctx)
+	if err != nil {
+		return errors.Wrap(err, "DeleteByUser.Organization")
+	}
+
+	if _, err = t.getter.DefaultTrOrDB(ctx, t.db).ExecContext(
+		ctx,
+		DeleteTwoFactorSQL,
+		userID.String(),
+		organizationID,
+	); err != nil {
+		return errors.Wrap(err, "DeleteByUser.ExecContext")
+	}
+
+	return nil
+}
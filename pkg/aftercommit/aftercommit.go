@@ -0,0 +1,65 @@
+// Package aftercommit defers side effects, like notifying clients, until the
+// transaction that caused them is committed.
+package aftercommit
+
+import (
+	"context"
+
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+type ctxKey struct{}
+
+type queue struct {
+	callbacks []func(ctx context.Context) error
+}
+
+// TrManager runs the function in a transaction, nested calls join the outer one.
+type TrManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+// Manager runs the callbacks registered with Run once the outermost transaction
+// commits. The callbacks of a rolled back transaction are dropped.
+type Manager struct {
+	next   TrManager
+	logger logger.Logger
+}
+
+func New(next TrManager, logger logger.Logger) *Manager {
+	return &Manager{next: next, logger: logger}
+}
+
+func (m *Manager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(ctxKey{}).(*queue); ok {
+		return m.next.Do(ctx, fn)
+	}
+
+	q := &queue{}
+	err := m.next.Do(context.WithValue(ctx, ctxKey{}, q), fn)
+	if err != nil {
+		return err
+	}
+
+	// The transaction is committed, failed callbacks can't undo it
+	for _, callback := range q.callbacks {
+		if err = callback(ctx); err != nil {
+			m.logger.Errorf("after commit callback failed, err: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// Run registers the callback of the transaction of the context. Outside of the
+// transactions of a Manager the callback runs at once.
+func Run(ctx context.Context, callback func(ctx context.Context) error) error {
+	q, ok := ctx.Value(ctxKey{}).(*queue)
+	if !ok {
+		return callback(ctx)
+	}
+
+	q.callbacks = append(q.callbacks, callback)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package aftercommit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/pkg/aftercommit"
+	"github.com/KyKyPy3/clean/pkg/logger"
+)
+
+type managerStub struct{}
+
+func (managerStub) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestRunAfterCommit(t *testing.T) {
+	log := logger.NewLogger(logger.Config{Mode: "test"})
+	log.Init()
+
+	manager := aftercommit.New(managerStub{}, log)
+	var calls []string
+	record := func(name string) func(ctx context.Context) error {
+		return func(_ context.Context) error {
+			calls = append(calls, name)
+			return nil
+		}
+	}
+
+	// Nested transactions run the callbacks after the outermost one
+	err := manager.Do(context.Background(), func(ctx context.Context) error {
+		require.NoError(t, aftercommit.Run(ctx, record("outer")))
+
+		return manager.Do(ctx, func(ctx context.Context) error {
+			require.NoError(t, aftercommit.Run(ctx, record("nested")))
+			assert.Empty(t, calls)
+
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"outer", "nested"}, calls)
+
+	// Rolled back transactions drop the callbacks
+	calls = nil
+	failure := errors.New("rollback")
+	err = manager.Do(context.Background(), func(ctx context.Context) error {
+		require.NoError(t, aftercommit.Run(ctx, record("rolled back")))
+
+		return failure
+	})
+	require.ErrorIs(t, err, failure)
+	assert.Empty(t, calls)
+
+	// Outside of transactions the callback runs at once
+	require.NoError(t, aftercommit.Run(context.Background(), record("at once")))
+	assert.Equal(t, []string{"at once"}, calls)
+}
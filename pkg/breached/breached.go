@@ -0,0 +1,73 @@
+package breached
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec // breach lists are published as SHA-1 hashes
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// List holds the SHA-1 hashes of passwords known from data breaches.
+type List struct {
+	hashes map[[sha1.Size]byte]struct{}
+}
+
+// Load reads the breach list from the file. Every line holds a hex SHA-1 hash,
+// optionally followed by ":<count>" like in the Have I Been Pwned dumps.
+func Load(path string) (*List, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open breached passwords file: %w", err)
+	}
+	defer file.Close()
+
+	return Read(file)
+}
+
+// Read reads the breach list in the format of Load.
+func Read(r io.Reader) (*List, error) {
+	list := &List{hashes: make(map[[sha1.Size]byte]struct{})}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		value, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if value == "" || strings.HasPrefix(value, "#") {
+			continue
+		}
+
+		var hash [sha1.Size]byte
+		if len(value) != hex.EncodedLen(sha1.Size) {
+			return nil, fmt.Errorf("line %d: invalid SHA-1 hash %q", line, value)
+		}
+
+		if _, err := hex.Decode(hash[:], []byte(value)); err != nil {
+			return nil, fmt.Errorf("line %d: invalid SHA-1 hash %q", line, value)
+		}
+
+		list.hashes[hash] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read breached passwords: %w", err)
+	}
+
+	return list, nil
+}
+
+// IsBreached reports whether the password is in the list.
+func (l *List) IsBreached(password string) bool {
+	_, ok := l.hashes[sha1.Sum([]byte(password))] //nolint:gosec // see import
+
+	return ok
+}
+
+// Len returns the number of hashes in the list.
+func (l *List) Len() int {
+	return len(l.hashes)
+}
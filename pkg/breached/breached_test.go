@@ -0,0 +1,34 @@
+package breached_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/pkg/breached"
+)
+
+func TestRead(t *testing.T) {
+	// SHA-1 of "password" and "123456", the second one in the Have I Been Pwned format
+	list, err := breached.Read(strings.NewReader(`# known passwords
+5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+
+7c4a8d09ca3762af61e59520943dc26494f8941b:37359195
+`))
+	require.NoError(t, err)
+	assert.Equal(t, 2, list.Len())
+
+	assert.True(t, list.IsBreached("password"))
+	assert.True(t, list.IsBreached("123456"))
+	assert.False(t, list.IsBreached("Correct-h0rse"))
+}
+
+func TestReadInvalidHash(t *testing.T) {
+	_, err := breached.Read(strings.NewReader("5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8AA\n"))
+	require.Error(t, err)
+
+	_, err = breached.Read(strings.NewReader("password\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package hasher_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/KyKyPy3/clean/pkg/hasher"
+)
+
+// Cheap parameters keep the tests fast.
+var testParams = hasher.Params{Memory: 1024, Iterations: 1, Parallelism: 1} //nolint:gochecknoglobals // test fixture
+
+func TestHash(t *testing.T) {
+	h := hasher.New(testParams)
+
+	hash, err := h.Hash("Correct-h0rse")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	// Every hash gets its own salt
+	other, err := h.Hash("Correct-h0rse")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+
+	require.NoError(t, h.Verify(hash, "Correct-h0rse"))
+	require.ErrorIs(t, h.Verify(hash, "correct-h0rse"), hasher.ErrMismatchedPassword)
+	assert.False(t, h.NeedsRehash(hash))
+}
+
+func TestVerifyBcrypt(t *testing.T) {
+	h := hasher.New(testParams)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("12345"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	require.NoError(t, h.Verify(string(hash), "12345"))
+	require.ErrorIs(t, h.Verify(string(hash), "54321"), hasher.ErrMismatchedPassword)
+	assert.True(t, h.NeedsRehash(string(hash)))
+}
+
+func TestNeedsRehashOnNewParams(t *testing.T) {
+	hash, err := hasher.New(testParams).Hash("Correct-h0rse")
+	require.NoError(t, err)
+
+	stronger := hasher.New(hasher.Params{Memory: 2048, Iterations: 1, Parallelism: 1})
+	assert.True(t, stronger.NeedsRehash(hash))
+
+	// Hashes made with old parameters are still verified
+	require.NoError(t, stronger.Verify(hash, "Correct-h0rse"))
+}
+
+func TestVerifyInvalidHash(t *testing.T) {
+	h := hasher.New(testParams)
+
+	require.ErrorIs(t, h.Verify("plain", "plain"), hasher.ErrUnknownHash)
+	require.ErrorIs(t, h.Verify("$argon2id$v=19$m=1024,t=1,p=1$salt", "plain"), hasher.ErrInvalidHash)
+	require.ErrorIs(t, h.Verify("$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$a2V5", "plain"), hasher.ErrInvalidHash)
+}
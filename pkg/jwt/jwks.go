@@ -0,0 +1,51 @@
+package jwt
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+)
+
+// JSONWebKeySet is the JWKS document publishing the public keys, see RFC 7517.
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func newJSONWebKey(id string, key *rsa.PublicKey) JSONWebKey {
+	n, e := encodePublicKey(key)
+
+	return JSONWebKey{
+		Kty: "RSA",
+		Use: "sig",
+		Alg: "RS256",
+		Kid: id,
+		N:   n,
+		E:   e,
+	}
+}
+
+// thumbprint is the RFC 7638 thumbprint of the public key.
+func thumbprint(key *rsa.PublicKey) string {
+	n, e := encodePublicKey(key)
+
+	// The members are required in the lexicographic order without whitespace
+	sum := sha256.Sum256([]byte(fmt.Sprintf(`{"e":%q,"kty":"RSA","n":%q}`, e, n)))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func encodePublicKey(key *rsa.PublicKey) (string, string) {
+	return base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+}
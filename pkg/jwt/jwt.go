@@ -1,6 +1,7 @@
 package jwt
 
 import (
+	"crypto/rsa"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -9,57 +10,194 @@ import (
 	"github.com/golang-jwt/jwt"
 )
 
-var errInvalidToken = errors.New("invalid token")
+// GuestScope marks tokens of guests allowed to watch a single game.
+const GuestScope = "guest"
 
+var (
+	errInvalidToken = errors.New("invalid token")
+	errUnknownKey   = errors.New("unknown signing key")
+)
+
+// Key is an RSA key pair of the key ring, the PEM keys are base64 encoded. Keys
+// without the private key only verify tokens. Retired keys are skipped, so the
+// tokens signed by them are rejected.
+type Key struct {
+	ID         string
+	PrivateKey string
+	PublicKey  string
+	Retired    bool
+}
+
+// JWT signs the tokens by the signing key of the key ring and verifies them by
+// any key which is not retired. The keys are parsed once.
 type JWT struct {
-	privateKey []byte
-	publicKey  []byte
+	signingKeyID string
+	signingKey   *rsa.PrivateKey
+	keyIDs       []string
+	publicKeys   map[string]*rsa.PublicKey
+	jwks         JSONWebKeySet
 }
 
 type Token struct {
 	Token     *string
 	TokenUUID string
 	UserID    string
+	// OrganizationID is the organization the token holder currently works in.
+	OrganizationID string
+	Scope          string
+	GameID         string
+	Name           string
 }
 
+// IsGuest reports whether the token was issued to a guest.
+func (t Token) IsGuest() bool {
+	return t.Scope == GuestScope
+}
+
+// NewJWT creates the key ring of the single key pair, its key id is the thumbprint
+// of the public key.
 func NewJWT(privateKey string, publicKey string) (*JWT, error) {
-	decodedPrivateKey, err := base64.StdEncoding.DecodeString(privateKey)
-	if err != nil {
-		return nil, fmt.Errorf("could not decode token private key: %w", err)
+	return NewKeyRing([]Key{{PrivateKey: privateKey, PublicKey: publicKey}}, "")
+}
+
+// NewKeyRing creates the key ring of the keys. The tokens are signed by the key of
+// signingKeyID, or by the first key with a private key when it is empty.
+func NewKeyRing(keys []Key, signingKeyID string) (*JWT, error) {
+	j := &JWT{publicKeys: make(map[string]*rsa.PublicKey, len(keys))}
+	for _, key := range keys {
+		if key.Retired {
+			continue
+		}
+
+		privateKey, publicKey, err := parseKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		id := key.ID
+		if id == "" {
+			id = thumbprint(publicKey)
+		}
+
+		if _, ok := j.publicKeys[id]; ok {
+			return nil, fmt.Errorf("duplicate key %s", id)
+		}
+
+		j.keyIDs = append(j.keyIDs, id)
+		j.publicKeys[id] = publicKey
+		j.jwks.Keys = append(j.jwks.Keys, newJSONWebKey(id, publicKey))
+
+		if privateKey != nil && j.signingKey == nil && (signingKeyID == "" || signingKeyID == id) {
+			j.signingKeyID = id
+			j.signingKey = privateKey
+		}
 	}
 
-	decodedPublicKey, err := base64.StdEncoding.DecodeString(publicKey)
-	if err != nil {
-		return nil, fmt.Errorf("could not decode: %w", err)
+	if j.signingKey == nil {
+		return nil, fmt.Errorf("signing key %q with private key not found", signingKeyID)
 	}
 
-	return &JWT{
-		privateKey: decodedPrivateKey,
-		publicKey:  decodedPublicKey,
-	}, nil
+	return j, nil
 }
 
-func (j JWT) CreateToken(sessionID, userID string, ttl time.Duration) (*Token, error) {
-	now := time.Now().UTC()
-	token := &Token{
-		Token: new(string),
+// parseKey decodes the key pair, the public key is derived from the private one when it is missing.
+func parseKey(key Key) (*rsa.PrivateKey, *rsa.PublicKey, error) {
+	var privateKey *rsa.PrivateKey
+	if key.PrivateKey != "" {
+		decodedPrivateKey, err := base64.StdEncoding.DecodeString(key.PrivateKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not decode token private key: %w", err)
+		}
+
+		privateKey, err = jwt.ParseRSAPrivateKeyFromPEM(decodedPrivateKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse token private key: %w", err)
+		}
+	}
+
+	if key.PublicKey == "" {
+		if privateKey == nil {
+			return nil, nil, fmt.Errorf("key %q has no public key", key.ID)
+		}
+
+		return privateKey, &privateKey.PublicKey, nil
+	}
+
+	decodedPublicKey, err := base64.StdEncoding.DecodeString(key.PublicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not decode: %w", err)
 	}
-	token.TokenUUID = sessionID
-	token.UserID = userID
 
-	key, err := jwt.ParseRSAPrivateKeyFromPEM(j.privateKey)
+	publicKey, err := jwt.ParseRSAPublicKeyFromPEM(decodedPublicKey)
 	if err != nil {
-		return nil, fmt.Errorf("create: parse token private key: %w", err)
+		return nil, nil, fmt.Errorf("parse token public key: %w", err)
+	}
+
+	if privateKey != nil && !privateKey.PublicKey.Equal(publicKey) {
+		return nil, nil, fmt.Errorf("key %q: public key does not match private key", key.ID)
 	}
 
+	return privateKey, publicKey, nil
+}
+
+// SigningKeyID returns the id of the key signing the new tokens.
+func (j *JWT) SigningKeyID() string {
+	return j.signingKeyID
+}
+
+// JWKS returns the public keys verifying the tokens.
+func (j *JWT) JWKS() JSONWebKeySet {
+	return j.jwks
+}
+
+func (j *JWT) CreateToken(sessionID, userID, organizationID string, ttl time.Duration) (*Token, error) {
+	return j.sign(&Token{
+		Token:          new(string),
+		TokenUUID:      sessionID,
+		UserID:         userID,
+		OrganizationID: organizationID,
+	}, ttl)
+}
+
+// CreateGuestToken creates a token of the guest scoped to the given game of the organization.
+func (j *JWT) CreateGuestToken(
+	sessionID, guestID, organizationID, gameID, name string,
+	ttl time.Duration,
+) (*Token, error) {
+	return j.sign(&Token{
+		Token:          new(string),
+		TokenUUID:      sessionID,
+		UserID:         guestID,
+		OrganizationID: organizationID,
+		Scope:          GuestScope,
+		GameID:         gameID,
+		Name:           name,
+	}, ttl)
+}
+
+func (j *JWT) sign(token *Token, ttl time.Duration) (*Token, error) {
+	now := time.Now().UTC()
+
 	atClaims := make(jwt.MapClaims)
-	atClaims["sub"] = userID
+	atClaims["sub"] = token.UserID
 	atClaims["token_uuid"] = token.TokenUUID
 	atClaims["exp"] = now.Add(ttl).Unix()
 	atClaims["iat"] = now.Unix()
 	atClaims["nbf"] = now.Unix()
+	if token.OrganizationID != "" {
+		atClaims["org_id"] = token.OrganizationID
+	}
+	if token.Scope != "" {
+		atClaims["scope"] = token.Scope
+		atClaims["game_id"] = token.GameID
+		atClaims["name"] = token.Name
+	}
+
+	signed := jwt.NewWithClaims(jwt.SigningMethodRS256, atClaims)
+	signed.Header["kid"] = j.signingKeyID
 
-	*token.Token, err = jwt.NewWithClaims(jwt.SigningMethodRS256, atClaims).SignedString(key)
+	var err error
+	*token.Token, err = signed.SignedString(j.signingKey)
 	if err != nil {
 		return nil, fmt.Errorf("create: sign token: %w", err)
 	}
@@ -67,17 +205,8 @@ func (j JWT) CreateToken(sessionID, userID string, ttl time.Duration) (*Token, e
 	return token, nil
 }
 
-func (j JWT) ValidateToken(token string) (*Token, error) {
-	key, err := jwt.ParseRSAPublicKeyFromPEM(j.publicKey)
-	if err != nil {
-		return nil, fmt.Errorf("validate: parse key: %w", err)
-	}
-	parsedToken, err := jwt.Parse(token, func(t *jwt.Token) (interface{}, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, fmt.Errorf("unexpected method: %s", t.Header["alg"])
-		}
-		return key, nil
-	})
+func (j *JWT) ValidateToken(token string) (*Token, error) {
+	parsedToken, err := j.parse(token)
 	if err != nil {
 		return nil, fmt.Errorf("validate: %w", err)
 	}
@@ -86,8 +215,50 @@ func (j JWT) ValidateToken(token string) (*Token, error) {
 		return nil, errInvalidToken
 	}
 
-	return &Token{
+	result := &Token{
 		TokenUUID: fmt.Sprint(claims["token_uuid"]),
 		UserID:    fmt.Sprint(claims["sub"]),
-	}, nil
+	}
+	if organizationID, ok := claims["org_id"].(string); ok {
+		result.OrganizationID = organizationID
+	}
+	if scope, ok := claims["scope"].(string); ok {
+		result.Scope = scope
+		result.GameID = fmt.Sprint(claims["game_id"])
+		result.Name = fmt.Sprint(claims["name"])
+	}
+
+	return result, nil
+}
+
+// parse verifies the token by the key of its kid. Tokens issued before the key
+// ring have no kid, they are checked against every key.
+func (j *JWT) parse(token string) (*jwt.Token, error) {
+	unverified, _, err := new(jwt.Parser).ParseUnverified(token, jwt.MapClaims{})
+	if err != nil {
+		return nil, err
+	}
+
+	keyIDs := j.keyIDs
+	if kid, ok := unverified.Header["kid"].(string); ok {
+		if _, ok = j.publicKeys[kid]; !ok {
+			return nil, errUnknownKey
+		}
+		keyIDs = []string{kid}
+	}
+
+	var parsedToken *jwt.Token
+	for _, id := range keyIDs {
+		parsedToken, err = jwt.Parse(token, func(t *jwt.Token) (interface{}, error) {
+			if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+				return nil, fmt.Errorf("unexpected method: %s", t.Header["alg"])
+			}
+			return j.publicKeys[id], nil
+		})
+		if err == nil {
+			return parsedToken, nil
+		}
+	}
+
+	return nil, err
 }
@@ -0,0 +1,132 @@
+package jwt_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/pkg/jwt"
+)
+
+func encodePublicKey(t *testing.T, key *rsa.PublicKey) string {
+	t.Helper()
+
+	public, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public}))
+}
+
+func newKey(t *testing.T, id string) (jwt.Key, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	private := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return jwt.Key{
+		ID:         id,
+		PrivateKey: base64.StdEncoding.EncodeToString(private),
+		PublicKey:  encodePublicKey(t, &key.PublicKey),
+	}, key
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldKey, _ := newKey(t, "2024-01")
+	nextKey, _ := newKey(t, "2024-02")
+
+	ring, err := jwt.NewKeyRing([]jwt.Key{oldKey}, "")
+	require.NoError(t, err)
+	oldToken, err := ring.CreateToken("session", "user", "org", time.Hour)
+	require.NoError(t, err)
+
+	// The new key signs while the old one still verifies the issued tokens
+	verifyOnly := oldKey
+	verifyOnly.PrivateKey = ""
+	ring, err = jwt.NewKeyRing([]jwt.Key{verifyOnly, nextKey}, "2024-02")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02", ring.SigningKeyID())
+
+	token, err := ring.ValidateToken(*oldToken.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "user", token.UserID)
+	assert.Equal(t, "org", token.OrganizationID)
+
+	newToken, err := ring.CreateToken("session", "user", "org", time.Hour)
+	require.NoError(t, err)
+	parsed, _, err := new(gojwt.Parser).ParseUnverified(*newToken.Token, gojwt.MapClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02", parsed.Header["kid"])
+
+	// Tokens of the retired key are rejected
+	oldKey.Retired = true
+	ring, err = jwt.NewKeyRing([]jwt.Key{oldKey, nextKey}, "2024-02")
+	require.NoError(t, err)
+	_, err = ring.ValidateToken(*oldToken.Token)
+	require.Error(t, err)
+	_, err = ring.ValidateToken(*newToken.Token)
+	require.NoError(t, err)
+
+	_, err = jwt.NewKeyRing([]jwt.Key{oldKey, nextKey}, "2024-01")
+	require.Error(t, err)
+	_, err = jwt.NewKeyRing([]jwt.Key{verifyOnly}, "")
+	require.Error(t, err)
+}
+
+func TestLegacyToken(t *testing.T) {
+	key, privateKey := newKey(t, "")
+	other, _ := newKey(t, "")
+
+	ring, err := jwt.NewKeyRing([]jwt.Key{other, key}, "")
+	require.NoError(t, err)
+
+	// Tokens issued before the key ring have no kid
+	legacy, err := gojwt.NewWithClaims(gojwt.SigningMethodRS256, gojwt.MapClaims{
+		"sub":        "user",
+		"token_uuid": "session",
+		"exp":        time.Now().Add(time.Hour).Unix(),
+	}).SignedString(privateKey)
+	require.NoError(t, err)
+
+	token, err := ring.ValidateToken(legacy)
+	require.NoError(t, err)
+	assert.Equal(t, "user", token.UserID)
+	assert.Equal(t, "session", token.TokenUUID)
+}
+
+func TestJWKS(t *testing.T) {
+	// The example key of RFC 7638
+	n, err := base64.RawURLEncoding.DecodeString("0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAt" +
+		"VT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w" +
+		"6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08q" +
+		"NLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw")
+	require.NoError(t, err)
+	example := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: 65537}
+
+	signing, privateKey := newKey(t, "signing")
+	ring, err := jwt.NewKeyRing([]jwt.Key{{PublicKey: encodePublicKey(t, example)}, signing}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "signing", ring.SigningKeyID())
+
+	keys := ring.JWKS().Keys
+	require.Len(t, keys, 2)
+	assert.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", keys[0].Kid)
+	assert.Equal(t, "AQAB", keys[0].E)
+
+	assert.Equal(t, "signing", keys[1].Kid)
+	assert.Equal(t, "RSA", keys[1].Kty)
+	assert.Equal(t, "RS256", keys[1].Alg)
+	assert.Equal(t, "sig", keys[1].Use)
+	modulus, err := base64.RawURLEncoding.DecodeString(keys[1].N)
+	require.NoError(t, err)
+	assert.Equal(t, privateKey.N.Bytes(), modulus)
+}
@@ -0,0 +1,101 @@
+package sse
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Event is a single server-sent event.
+type Event struct {
+	ID    string
+	Event string
+	Data  []byte
+	Retry time.Duration
+}
+
+// Write encodes the event in text/event-stream format.
+func Write(w io.Writer, e Event) error {
+	var buf bytes.Buffer
+
+	if e.ID != "" {
+		fmt.Fprintf(&buf, "id: %s\n", e.ID)
+	}
+
+	if e.Event != "" {
+		fmt.Fprintf(&buf, "event: %s\n", e.Event)
+	}
+
+	if e.Retry > 0 {
+		fmt.Fprintf(&buf, "retry: %d\n", e.Retry.Milliseconds())
+	}
+
+	for _, line := range bytes.Split(e.Data, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+// Stream is an open event stream to a single client.
+type Stream struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+// NewStream writes the event stream headers and disables the server write
+// timeout for the response, so the stream can outlive it.
+func NewStream(w http.ResponseWriter) (*Stream, error) {
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return nil, err
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	// Disable response buffering in nginx based proxies.
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	s := &Stream{w: w, rc: rc}
+
+	return s, s.flush()
+}
+
+// Send writes the event and flushes it to the client.
+func (s *Stream) Send(e Event) error {
+	if err := Write(s.w, e); err != nil {
+		return err
+	}
+
+	return s.flush()
+}
+
+// SendID writes the event with a numeric id.
+func (s *Stream) SendID(id int64, event string, data []byte) error {
+	return s.Send(Event{ID: strconv.FormatInt(id, 10), Event: event, Data: data})
+}
+
+// Heartbeat writes a comment line that keeps idle proxies from closing the stream.
+func (s *Stream) Heartbeat() error {
+	if _, err := io.WriteString(s.w, ": ping\n\n"); err != nil {
+		return err
+	}
+
+	return s.flush()
+}
+
+func (s *Stream) flush() error {
+	return s.rc.Flush()
+}
@@ -0,0 +1,39 @@
+package sse_test
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/pkg/sse"
+)
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := sse.Write(&buf, sse.Event{
+		ID:    "7",
+		Event: "VoteCast",
+		Data:  []byte("{\"a\":1}\n{\"b\":2}"),
+		Retry: 3 * time.Second,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "id: 7\nevent: VoteCast\nretry: 3000\ndata: {\"a\":1}\ndata: {\"b\":2}\n\n", buf.String())
+}
+
+func TestStream(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	stream, err := sse.NewStream(rec)
+	require.NoError(t, err)
+	require.NoError(t, stream.SendID(1, "RoundStarted", []byte(`{}`)))
+	require.NoError(t, stream.Heartbeat())
+
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.True(t, rec.Flushed)
+	assert.Equal(t, "id: 1\nevent: RoundStarted\ndata: {}\n\n: ping\n\n", rec.Body.String())
+}
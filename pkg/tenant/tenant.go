@@ -0,0 +1,38 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoOrganization is returned when data is accessed without an organization scope.
+var ErrNoOrganization = errors.New("organization is not set")
+
+type ctxKey struct{}
+
+type scope struct {
+	organizationID string
+	unscoped       bool
+}
+
+// WithOrganization scopes the data accessed with the context to the organization.
+func WithOrganization(ctx context.Context, organizationID string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, scope{organizationID: organizationID})
+}
+
+// Unscoped lets the context access data of every organization. It is meant for
+// system flows running outside a tenant, like login, registration and background jobs.
+func Unscoped(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKey{}, scope{unscoped: true})
+}
+
+// Organization returns the organization the context is scoped to. It is empty
+// for unscoped contexts and fails when the context has no scope at all.
+func Organization(ctx context.Context) (string, error) {
+	s, ok := ctx.Value(ctxKey{}).(scope)
+	if !ok || (!s.unscoped && s.organizationID == "") {
+		return "", ErrNoOrganization
+	}
+
+	return s.organizationID, nil
+}
@@ -0,0 +1,27 @@
+package tenant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/pkg/tenant"
+)
+
+func TestOrganization(t *testing.T) {
+	_, err := tenant.Organization(context.Background())
+	require.ErrorIs(t, err, tenant.ErrNoOrganization)
+
+	_, err = tenant.Organization(tenant.WithOrganization(context.Background(), ""))
+	require.ErrorIs(t, err, tenant.ErrNoOrganization)
+
+	organizationID, err := tenant.Organization(tenant.WithOrganization(context.Background(), "org"))
+	require.NoError(t, err)
+	assert.Equal(t, "org", organizationID)
+
+	organizationID, err = tenant.Organization(tenant.Unscoped(context.Background()))
+	require.NoError(t, err)
+	assert.Empty(t, organizationID)
+}
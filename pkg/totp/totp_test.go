@@ -0,0 +1,71 @@
+package totp_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyKyPy3/clean/pkg/totp"
+)
+
+// The SHA-1 secret of the RFC 6238 test vectors, "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	otp := totp.New("clean")
+
+	// The last six digits of the eight digit RFC 6238 values
+	for unix, expected := range map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	} {
+		code, err := otp.Code(rfcSecret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, expected, code, unix)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	otp := totp.New("clean")
+
+	secret, err := otp.GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := otp.Code(secret, now)
+	require.NoError(t, err)
+
+	step, err := otp.Verify(secret, code, now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Unix()/30, step)
+
+	// Codes of the neighbouring periods are accepted
+	_, err = otp.Verify(secret, code, now.Add(totp.Period))
+	require.NoError(t, err)
+
+	_, err = otp.Verify(secret, code, now.Add(3*totp.Period))
+	require.ErrorIs(t, err, totp.ErrInvalidCode)
+
+	_, err = otp.Verify(secret, "12345", now)
+	require.ErrorIs(t, err, totp.ErrInvalidCode)
+
+	_, err = otp.Verify("not base32!", code, now)
+	require.ErrorIs(t, err, totp.ErrInvalidSecret)
+}
+
+func TestURI(t *testing.T) {
+	otp := totp.New("clean")
+
+	uri := otp.URI("alise@email.com", rfcSecret)
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/clean:alise@email.com?"))
+	assert.Contains(t, uri, "secret="+rfcSecret)
+	assert.Contains(t, uri, "issuer=clean")
+	assert.Contains(t, uri, "digits=6")
+	assert.Contains(t, uri, "period=30")
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/KyKyPy3/clean/internal/modules/user/application/ports"
 	psql "github.com/KyKyPy3/clean/internal/modules/user/infrastructure/gateway/postgres"
 	"github.com/KyKyPy3/clean/pkg/logger"
+	"github.com/KyKyPy3/clean/pkg/tenant"
 )
 
 const (
@@ -57,7 +58,7 @@ func TestMain(m *testing.M) {
 
 	repo = psql.NewUserPgStorage(testDB, trmsqlx.DefaultCtxGetter, logger)
 
-	policy = application.NewUniquenessPolicy(context.Background(), repo, logger)
+	policy = application.NewUniquenessPolicy(tenant.Unscoped(context.Background()), repo, logger)
 
 	// Run the tests
 	code := m.Run()
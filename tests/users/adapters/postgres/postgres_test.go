@@ -11,10 +11,11 @@ import (
 	"github.com/KyKyPy3/clean/internal/domain/core"
 	"github.com/KyKyPy3/clean/internal/modules/user/domain/entity"
 	"github.com/KyKyPy3/clean/internal/modules/user/domain/vo"
+	"github.com/KyKyPy3/clean/pkg/tenant"
 )
 
 func TestFetch(t *testing.T) {
-	users, err := repo.Fetch(context.Background(), 10, 0)
+	users, err := repo.Fetch(tenant.Unscoped(context.Background()), 10, 0)
 	require.NoError(t, err)
 	require.Len(t, users, 2)
 }
@@ -25,21 +26,21 @@ func TestCreate(t *testing.T) {
 
 	user, _ := entity.NewUser(fullName, email, "12345", policy)
 
-	err := repo.Create(context.Background(), user)
+	err := repo.Create(tenant.Unscoped(context.Background()), user)
 	require.NoError(t, err)
 	require.NotNil(t, user.ID())
 }
 
 func TestGetByID(t *testing.T) {
 	user, err := repo.GetByID(
-		context.Background(),
+		tenant.Unscoped(context.Background()),
 		common.NewWithSpecifiedID(uuid.MustParse("2b0c8791-2136-46b6-bc38-b33038ca2e80")),
 	)
 	require.NoError(t, err)
 	require.NotNil(t, user)
 
 	user, err = repo.GetByID(
-		context.Background(),
+		tenant.Unscoped(context.Background()),
 		common.NewWithSpecifiedID(uuid.MustParse("2b0c1111-2136-46b6-bc38-b33038ca2e80")),
 	)
 	require.Error(t, err)
@@ -49,12 +50,12 @@ func TestGetByID(t *testing.T) {
 
 func TestGetByEmail(t *testing.T) {
 	aliceEmail, _ := common.NewEmail("alise@email.com")
-	user, err := repo.GetByEmail(context.Background(), aliceEmail)
+	user, err := repo.GetByEmail(tenant.Unscoped(context.Background()), aliceEmail)
 	require.NoError(t, err)
 	require.NotNil(t, user)
 
 	bobEmail, _ := common.NewEmail("rob@email.com")
-	user, err = repo.GetByEmail(context.Background(), bobEmail)
+	user, err = repo.GetByEmail(tenant.Unscoped(context.Background()), bobEmail)
 	require.Error(t, err)
 	require.ErrorIs(t, err, core.ErrNotFound)
 	require.Equal(t, entity.User{}, user)
@@ -62,7 +63,7 @@ func TestGetByEmail(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	err := repo.Delete(
-		context.Background(),
+		tenant.Unscoped(context.Background()),
 		common.NewWithSpecifiedID(uuid.MustParse("2b0c8791-2136-46b6-bc38-b33038ca2e80")),
 	)
 	require.NoError(t, err)
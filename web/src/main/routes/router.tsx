@@ -5,7 +5,7 @@ import { ProtectedRoute } from './protected'
 
 import { SignUp } from '@registration/infrastructure/controller/http/v1/pages/signup'
 import SignIn from '@session/infrastructure/controller/http/v1/pages/signin'
-import { Profile, UserList } from '@user/infrastructure/controller/http/v1/pages'
+import { ConfirmEmail, Profile, UserList } from '@user/infrastructure/controller/http/v1/pages'
 import { GamesList } from '@game/infrastructure/controller/http/v1/pages'
 
 export const AppRoutes = () => {
@@ -20,6 +20,11 @@ export const AppRoutes = () => {
       element: <SignIn />,
       errorElement: <Error />,
     },
+    {
+      path: "/email/:token",
+      element: <ConfirmEmail />,
+      errorElement: <Error />,
+    },
     {
       element: <ProtectedRoute />,
       children: [
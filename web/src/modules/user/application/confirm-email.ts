@@ -0,0 +1,17 @@
+import { useMutation } from "@tanstack/react-query";
+import { useLogger } from "@/src/main/logger";
+
+import type { UserRepository } from "./ports";
+
+export const useConfirmEmail = (repository: UserRepository) => {
+  const logger = useLogger();
+
+  const confirmEmail = useMutation({
+    mutationFn: (token: string) => repository.confirmEmail(token),
+    onError: (error) => {
+      logger.error(error);
+    },
+  })
+
+  return confirmEmail;
+}
@@ -3,4 +3,5 @@ import type { UserEntity } from "@user/domain/entity/user"
 export interface UserRepository {
   me(): Promise<UserEntity>;
   list(): Promise<UserEntity[]>
+  confirmEmail(token: string): Promise<void>
 }
\ No newline at end of file
@@ -0,0 +1,44 @@
+import { useTranslation } from 'react-i18next'
+import { Link, useParams } from 'react-router-dom'
+
+import Button from '@components/Button'
+import { makeApiUrl, makeHttpClient } from '@/src/main/http'
+
+import { useConfirmEmail } from '@user/application/confirm-email'
+import { UserRepositoryImpl } from '@user/infrastructure/gateway/backendRepository'
+
+// The link of the email only opens the page, the change is applied when the user
+// confirms it, so mail scanners following the link can't apply it.
+export function ConfirmEmail() {
+  const { t } = useTranslation()
+  const { token } = useParams()
+  const confirmEmail = useConfirmEmail(
+    new UserRepositoryImpl(makeApiUrl('/user'), makeHttpClient())
+  )
+
+  return (
+    <div className='w-screen min-h-screen p-2 flex flex-col justify-center items-center default-bg'>
+      <div className='w-3/4 md:w-1/4 h-fit bg-neutral-50 border-none rounded-xl p-10'>
+        <h1 className='text-slate-950 text-5xl font-bold'>{t('confirm_email.title')}</h1>
+        {confirmEmail.isSuccess ? (
+          <div className='mt-5'>{t('confirm_email.confirmed')}</div>
+        ) : (
+          <div className='mt-5'>
+            <Button
+              id='confirm-email-submit'
+              type='button'
+              isLoading={confirmEmail.isPending}
+              onClick={() => token && confirmEmail.mutate(token)}
+              title={<p className='text-lg'>{t('confirm_email.confirm')}</p>}
+            />
+          </div>
+        )}
+        {confirmEmail.isError && <div className='mt-5'>{t('confirm_email.failed')}</div>}
+
+        <div className='flex justify-center mt-5'>
+          <Link to="/signin">{t('signin.signin')}</Link>
+        </div>
+      </div>
+    </div>
+  )
+}
@@ -1,2 +1,3 @@
 export * from './list';
 export * from './profile';
+export * from './confirm-email';
\ No newline at end of file
@@ -49,4 +49,18 @@ export class UserRepositoryImpl implements UserRepository {
         throw new UnexpectedErrorException()
     }
   }
+
+  async confirmEmail(token: string): Promise<void> {
+    const httpResponse = await this.httpClient.request({
+      url: `${this.url}/email/${encodeURIComponent(token)}`,
+      method: 'post',
+    })
+
+    switch (httpResponse.statusCode) {
+      case HttpStatusCode.ok:
+        return
+      default:
+        throw new UnexpectedErrorException()
+    }
+  }
 }